@@ -1,7 +1,32 @@
 package jsonpath
 
 // Accessor represents the accessor to the result nodes of JSONPath.
+// Delete removes the node from its parent object, or splices it out of its parent array.
+// Set and Delete are nil if the node cannot be updated.
+// Set of the Go value ignores the value that cannot be converted into its type without loss.
+// The fields of the Go structs and the elements of the Go slices and arrays cannot be deleted.
 type Accessor struct {
-	Get func() interface{}
-	Set func(interface{})
+	Get    func() interface{}
+	Set    func(interface{})
+	Delete func()
+
+	check        func(interface{}) error
+	setReason    string
+	deleteReason string
+}
+
+// checkValue returns the error if the value cannot be set by the accessor.
+func (a Accessor) checkValue(value interface{}) error {
+	if a.check == nil {
+		return nil
+	}
+	return a.check(value)
+}
+
+// getReason returns the reason why the operation is not available, or the empty string if it is unknown.
+func (a Accessor) getReason(operation string) string {
+	if operation == `delete` {
+		return a.deleteReason
+	}
+	return a.setReason
 }
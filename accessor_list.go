@@ -0,0 +1,71 @@
+package jsonpath
+
+// accessorList holds the list shared by the accessors of its elements.
+// The deleted elements are spliced out of the list, and the accessors of the remaining elements
+// keep referring to the same elements with their original indexes.
+type accessorList struct {
+	list    []interface{}
+	deleted []bool
+	setter  func(interface{})
+	pointer func() string
+}
+
+func (l *accessorList) getPosition(index int) (int, bool) {
+	if l.deleted == nil {
+		return index, true
+	}
+	if l.deleted[index] {
+		return 0, false
+	}
+
+	position := index
+	for deletedIndex := 0; deletedIndex < index; deletedIndex++ {
+		if l.deleted[deletedIndex] {
+			position--
+		}
+	}
+	return position, true
+}
+
+func (l *accessorList) get(index int) interface{} {
+	if position, ok := l.getPosition(index); ok {
+		return l.list[position]
+	}
+	return nil
+}
+
+func (l *accessorList) set(index int, value interface{}) {
+	if position, ok := l.getPosition(index); ok {
+		l.list[position] = value
+	}
+}
+
+func (l *accessorList) delete(index int) {
+	position, ok := l.getPosition(index)
+	if !ok {
+		return
+	}
+
+	if l.deleted == nil {
+		l.deleted = make([]bool, len(l.list))
+	}
+	l.deleted[index] = true
+
+	newList := make([]interface{}, 0, len(l.list)-1)
+	newList = append(newList, l.list[:position]...)
+	newList = append(newList, l.list[position+1:]...)
+	l.list = newList
+	l.setter(newList)
+}
+
+func (l *accessorList) getAccessor(index int) Accessor {
+	var deleter func()
+	if l.setter != nil {
+		deleter = func() { l.delete(index) }
+	}
+	return Accessor{
+		Get:    func() interface{} { return l.get(index) },
+		Set:    func(value interface{}) { l.set(index, value) },
+		Delete: deleter,
+	}
+}
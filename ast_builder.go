@@ -0,0 +1,317 @@
+package jsonpath
+
+import "strings"
+
+// astBuilder builds the ASTNode tree from the syntax node chain and the filter queries.
+// The positions are looked up by the nodes, the queries, the subscripts and the scripts
+// saved by the parser, or made up from the positions of the child nodes.
+type astBuilder struct {
+	jsonPath  []rune
+	positions map[interface{}]astPosition
+}
+
+// newNode returns the node at the position saved for the key.
+// If the position is not saved, the node covers the range of its children.
+func (b *astBuilder) newNode(kind ASTKind, value interface{}, key interface{}, children ...*ASTNode) *ASTNode {
+	node := &ASTNode{
+		kind:     kind,
+		value:    value,
+		position: -1,
+		end:      -1,
+		children: children,
+	}
+
+	if position, ok := b.positions[key]; ok && key != nil {
+		node.position, node.end = b.trimBlank(position.begin, position.end)
+		return node
+	}
+
+	for _, child := range children {
+		if child.position < 0 {
+			continue
+		}
+		if node.position < 0 || child.position < node.position {
+			node.position = child.position
+		}
+		if child.end > node.end {
+			node.end = child.end
+		}
+	}
+	return node
+}
+
+// trimBlank excludes the blanks around the syntax, which are included in the range saved by the parser.
+func (b *astBuilder) trimBlank(begin, end int) (int, int) {
+	for begin < end && strings.ContainsRune(strictBlankCharacters, b.jsonPath[begin]) {
+		begin++
+	}
+	for begin < end && strings.ContainsRune(strictBlankCharacters, b.jsonPath[end-1]) {
+		end--
+	}
+	return begin, end
+}
+
+func (b *astBuilder) buildPath(kind ASTKind, node syntaxNode) *ASTNode {
+	return b.newNode(ASTPath, nil, nil, b.appendSegments([]*ASTNode{b.buildRoot(kind, node)}, node)...)
+}
+
+// buildRoot builds the root identifier, which may be removed from the head of the node chain by the parser.
+func (b *astBuilder) buildRoot(kind ASTKind, node syntaxNode) *ASTNode {
+	for {
+		aggregateFunction, ok := node.(*syntaxAggregateFunction)
+		if !ok {
+			break
+		}
+		node = aggregateFunction.param
+	}
+
+	switch node.(type) {
+	case *syntaxRootIdentifier, *syntaxCurrentRootIdentifier:
+		return b.newNode(kind, nil, node)
+	}
+	return b.newNode(kind, nil, astRemovedRoot{node: node})
+}
+
+func (b *astBuilder) appendSegments(segments []*ASTNode, node syntaxNode) []*ASTNode {
+	for ; node != nil; node = node.getNext() {
+		switch typedNode := node.(type) {
+		case *syntaxRootIdentifier, *syntaxCurrentRootIdentifier:
+			continue
+		case *syntaxAggregateFunction:
+			segments = b.appendSegments(segments, typedNode.param)
+			segments = append(segments,
+				b.newNode(ASTAggregateFunction, b.getFunctionName(typedNode.getText()), typedNode))
+			continue
+		}
+		segments = append(segments, b.buildSelector(node))
+	}
+	return segments
+}
+
+// buildSelector builds the segment of the node without following its next node.
+func (b *astBuilder) buildSelector(node syntaxNode) *ASTNode {
+	switch typedNode := node.(type) {
+	case *syntaxChildSingleIdentifier:
+		return b.newNode(ASTChild, typedNode.identifier, typedNode)
+	case *syntaxChildWildcardIdentifier:
+		return b.newNode(ASTWildcard, nil, typedNode)
+	case *syntaxRecursiveChildIdentifier:
+		return b.newNode(ASTDescendant, nil, typedNode)
+	case *syntaxChildMultiIdentifier:
+		var selectors []*ASTNode
+		for _, identifier := range typedNode.identifiers {
+			selectors = append(selectors, b.buildSelector(identifier))
+		}
+		return b.newNode(ASTUnion, nil, typedNode, selectors...)
+	case *syntaxMultiSelectorQualifier:
+		var selectors []*ASTNode
+		for _, selector := range typedNode.selectors {
+			if unionQualifier, ok := selector.(*syntaxUnionQualifier); ok {
+				for _, subscript := range unionQualifier.subscripts {
+					selectors = append(selectors, b.buildSubscript(subscript, subscript))
+				}
+				continue
+			}
+			selectors = append(selectors, b.buildSelector(selector))
+		}
+		return b.newNode(ASTUnion, nil, typedNode, selectors...)
+	case *syntaxUnionQualifier:
+		if len(typedNode.subscripts) == 1 {
+			return b.buildSubscript(typedNode.subscripts[0], typedNode)
+		}
+		var selectors []*ASTNode
+		for _, subscript := range typedNode.subscripts {
+			selectors = append(selectors, b.buildSubscript(subscript, subscript))
+		}
+		return b.newNode(ASTUnion, nil, typedNode, selectors...)
+	case *syntaxFilterQualifier:
+		return b.newNode(ASTFilter, nil, typedNode, b.buildQuery(typedNode.query))
+	case *syntaxScriptQualifier:
+		return b.newNode(ASTScript, nil, typedNode, b.buildScript(typedNode.script))
+	case *syntaxFilterFunction:
+		return b.newNode(ASTFilterFunction, b.getFunctionName(typedNode.getText()), typedNode)
+	}
+	return nil
+}
+
+func (b *astBuilder) buildSubscript(subscript syntaxSubscript, key interface{}) *ASTNode {
+	switch typedSubscript := subscript.(type) {
+	case *syntaxIndexSubscript:
+		return b.newNode(ASTIndex, b.getIndexValue(typedSubscript), key)
+	case *syntaxWildcardSubscript:
+		return b.newNode(ASTWildcard, nil, key)
+	case *syntaxSlicePositiveStepSubscript:
+		return b.newNode(ASTSlice, nil, key, b.buildSliceIndexes(
+			typedSubscript.start, typedSubscript.end, typedSubscript.step)...)
+	case *syntaxSliceNegativeStepSubscript:
+		return b.newNode(ASTSlice, nil, key, b.buildSliceIndexes(
+			typedSubscript.start, typedSubscript.end, typedSubscript.step)...)
+	}
+	return nil
+}
+
+func (b *astBuilder) buildSliceIndexes(indexes ...*syntaxIndexSubscript) []*ASTNode {
+	nodes := make([]*ASTNode, len(indexes))
+	for index := range indexes {
+		nodes[index] = b.newNode(ASTIndex, b.getIndexValue(indexes[index]), indexes[index])
+	}
+	return nodes
+}
+
+func (b *astBuilder) getIndexValue(subscript *syntaxIndexSubscript) interface{} {
+	if subscript.isOmitted {
+		return nil
+	}
+	return subscript.number
+}
+
+func (b *astBuilder) buildQuery(query syntaxQuery) *ASTNode {
+	switch typedQuery := query.(type) {
+	case *syntaxLogicalOr:
+		return b.newNode(ASTOr, nil, typedQuery,
+			b.buildQuery(typedQuery.leftQuery), b.buildQuery(typedQuery.rightQuery))
+	case *syntaxLogicalAnd:
+		return b.newNode(ASTAnd, nil, typedQuery,
+			b.buildQuery(typedQuery.leftQuery), b.buildQuery(typedQuery.rightQuery))
+	case *syntaxLogicalNot:
+		// The inequality is compiled into the negated equality.
+		operand := b.buildQuery(typedQuery.query)
+		if operand.kind == ASTComparison && operand.value == `==` {
+			inequality := b.newNode(ASTComparison, `!=`, typedQuery, operand.children...)
+			if _, ok := b.positions[typedQuery]; !ok {
+				inequality.position, inequality.end = operand.position, operand.end
+			}
+			return inequality
+		}
+		return b.newNode(ASTNot, nil, typedQuery, operand)
+	case *syntaxBasicCompareQuery:
+		return b.buildComparison(
+			typedQuery, typedQuery.comparator, false, typedQuery.leftParam, typedQuery.rightParam)
+	case *syntaxValueGroupCompareQuery:
+		return b.buildComparison(
+			typedQuery, typedQuery.comparator, typedQuery.isNegated, typedQuery.leftParam, typedQuery.rightParam)
+	case *syntaxStrictCompareQuery:
+		return b.newNode(ASTComparison, b.getStrictComparisonOperator(typedQuery), typedQuery,
+			b.buildQuery(typedQuery.leftParam.param), b.buildQuery(typedQuery.rightParam.param))
+	case *syntaxQueryParamRoot:
+		return b.buildPath(ASTRoot, typedQuery.param)
+	case *syntaxQueryParamCurrentRoot:
+		return b.buildPath(ASTCurrent, typedQuery.param)
+	case *syntaxQueryParamLiteral:
+		return b.newNode(ASTLiteral, typedQuery.literal[0], typedQuery)
+	case *syntaxFunctionLength:
+		return b.buildFunctionExtension(typedQuery, `length`, typedQuery.params)
+	case *syntaxFunctionCount:
+		return b.buildFunctionExtension(typedQuery, `count`, typedQuery.params)
+	case *syntaxFunctionValue:
+		return b.buildFunctionExtension(typedQuery, `value`, typedQuery.params)
+	case *syntaxFunctionRegex:
+		if typedQuery.isFullMatch {
+			return b.buildFunctionExtension(typedQuery, `match`, typedQuery.params)
+		}
+		return b.buildFunctionExtension(typedQuery, `search`, typedQuery.params)
+	}
+	return nil
+}
+
+func (b *astBuilder) buildComparison(query syntaxQuery, comparator syntaxComparator, isNegated bool,
+	leftParam, rightParam *syntaxBasicCompareParameter) *ASTNode {
+
+	rightNode := b.buildQuery(rightParam.param)
+	if regexComparator, ok := comparator.(*syntaxCompareRegex); ok {
+		rightNode = b.newNode(ASTLiteral, regexComparator.regex.String(), rightParam.param)
+	}
+	return b.newNode(ASTComparison, b.getComparisonOperator(comparator, isNegated), query,
+		b.buildQuery(leftParam.param), rightNode)
+}
+
+// getComparisonOperator returns the operator of the comparator.
+// The comparators are named after the operator with the swapped operands.
+func (b *astBuilder) getComparisonOperator(comparator syntaxComparator, isNegated bool) string {
+	switch comparator.(type) {
+	case *syntaxCompareEQ:
+		if isNegated {
+			return `!=`
+		}
+		return `==`
+	case *syntaxCompareGE:
+		return `<=`
+	case *syntaxCompareGT:
+		return `<`
+	case *syntaxCompareLE:
+		return `>=`
+	case *syntaxCompareLT:
+		return `>`
+	}
+	return `=~`
+}
+
+func (b *astBuilder) getStrictComparisonOperator(query *syntaxStrictCompareQuery) string {
+	switch {
+	case query.isLess && query.isEqual:
+		return `<=`
+	case query.isLess:
+		return `<`
+	case query.isGreater && query.isEqual:
+		return `>=`
+	case query.isGreater:
+		return `>`
+	}
+	return `==`
+}
+
+func (b *astBuilder) buildFunctionExtension(
+	query syntaxQuery, name string, params []*syntaxBasicCompareParameter) *ASTNode {
+
+	arguments := make([]*ASTNode, len(params))
+	for index, param := range params {
+		arguments[index] = b.buildQuery(param.param)
+	}
+	return b.newNode(ASTFunctionExtension, name, query, arguments...)
+}
+
+func (b *astBuilder) buildScript(script syntaxScript) *ASTNode {
+	switch typedScript := script.(type) {
+	case *syntaxScriptAdd:
+		return b.buildArithmetic(typedScript, `+`, typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptSubtract:
+		return b.buildArithmetic(typedScript, `-`, typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptMultiply:
+		return b.buildArithmetic(typedScript, `*`, typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptDivide:
+		return b.buildArithmetic(typedScript, `/`, typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptModulo:
+		return b.buildArithmetic(typedScript, `%`, typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptNegate:
+		return b.newNode(ASTNegate, nil, typedScript, b.buildScript(typedScript.script))
+	case *syntaxScriptParamLiteral:
+		return b.newNode(ASTLiteral, typedScript.literal, typedScript)
+	case *syntaxScriptParamJSONPath:
+		kind := ASTCurrent
+		if typedScript.isRoot {
+			kind = ASTRoot
+		}
+		segments := b.appendSegments([]*ASTNode{b.buildRoot(kind, typedScript.param)}, typedScript.param)
+		if typedScript.isLength {
+			length := b.newNode(ASTLength, nil, nil)
+			if position, ok := b.positions[typedScript]; ok {
+				length.position, length.end = position.end, position.end+len(`.length`)
+			}
+			segments = append(segments, length)
+		}
+		return b.newNode(ASTPath, nil, nil, segments...)
+	}
+	return nil
+}
+
+func (b *astBuilder) buildArithmetic(
+	script syntaxScript, operator string, arithmetic *syntaxBasicScriptArithmetic) *ASTNode {
+
+	return b.newNode(ASTArithmetic, operator, script,
+		b.buildScript(arithmetic.leftScript), b.buildScript(arithmetic.rightScript))
+}
+
+// getFunctionName returns the name of the function from its text `.name()`.
+func (b *astBuilder) getFunctionName(text string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(text, `.`), `()`)
+}
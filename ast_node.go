@@ -0,0 +1,87 @@
+package jsonpath
+
+// ASTKind represents the kind of ASTNode.
+type ASTKind int
+
+// The kinds of ASTNode.
+//
+// The segments of the JSONPath are the children of ASTPath, which starts with ASTRoot or ASTCurrent.
+// The values are the name of ASTChild, ASTFilterFunction, ASTAggregateFunction and ASTFunctionExtension,
+// the number of ASTIndex (nil if omitted in the slice), the operator of ASTComparison and ASTArithmetic,
+// and the value of ASTLiteral.
+const (
+	ASTPath              ASTKind = iota // JSONPath: segments...
+	ASTRoot                             // $
+	ASTCurrent                          // @
+	ASTChild                            // .name, ['name']
+	ASTWildcard                         // .*, [*]
+	ASTDescendant                       // ..
+	ASTIndex                            // [0]
+	ASTSlice                            // [start:end:step]: ASTIndex, ASTIndex, ASTIndex
+	ASTUnion                            // [selector, selector...]: selectors...
+	ASTFilter                           // [?(expression)]: expression
+	ASTScript                           // [(expression)]: expression
+	ASTFilterFunction                   // .name()
+	ASTAggregateFunction                // .name()
+	ASTLength                           // .length in the script
+	ASTOr                               // expression || expression
+	ASTAnd                              // expression && expression
+	ASTNot                              // !expression
+	ASTComparison                       // expression operator expression
+	ASTFunctionExtension                // name(arguments...)
+	ASTArithmetic                       // expression operator expression
+	ASTNegate                           // -expression
+	ASTLiteral                          // number, string, true, false, null and regular expression
+)
+
+var astKindNames = []string{
+	`Path`, `Root`, `Current`, `Child`, `Wildcard`, `Descendant`, `Index`, `Slice`, `Union`,
+	`Filter`, `Script`, `FilterFunction`, `AggregateFunction`, `Length`,
+	`Or`, `And`, `Not`, `Comparison`, `FunctionExtension`, `Arithmetic`, `Negate`, `Literal`,
+}
+
+func (k ASTKind) String() string {
+	if k < 0 || int(k) >= len(astKindNames) {
+		return `Unknown`
+	}
+	return astKindNames[k]
+}
+
+// ASTNode represents a node of the abstract syntax tree of the JSONPath.
+// The tree is built from the compiled query, so that the equivalent syntaxes are represented
+// in the same way, such as `$.a` and `$['a']`, or `@.a!=1` and `!(@.a==1)`.
+type ASTNode struct {
+	kind     ASTKind
+	value    interface{}
+	position int
+	end      int
+	children []*ASTNode
+}
+
+// Kind returns the kind of the node.
+func (n *ASTNode) Kind() ASTKind {
+	return n.kind
+}
+
+// Value returns the value of the node, such as the name of ASTChild.
+func (n *ASTNode) Value() interface{} {
+	return n.value
+}
+
+// Position returns the position of the first character of the node in the JSONPath,
+// counted in the same way as the position of ErrorInvalidSyntax.
+// It returns -1 for the node not written in the JSONPath, such as the omitted root identifier
+// and the omitted step of the slice. The omitted start and end of the slice have the empty range.
+func (n *ASTNode) Position() int {
+	return n.position
+}
+
+// End returns the position immediately after the node in the JSONPath, or -1 in the same way as Position.
+func (n *ASTNode) End() int {
+	return n.end
+}
+
+// Children returns the copy of the child nodes.
+func (n *ASTNode) Children() []*ASTNode {
+	return append([]*ASTNode{}, n.children...)
+}
@@ -0,0 +1,19 @@
+package jsonpath
+
+// Visitor represents the visitor of the nodes of the abstract syntax tree.
+// Visit is called for each node by Walk. If the returned visitor w is not nil,
+// the children of the node are visited with w, followed by the call of w.Visit(nil).
+type Visitor interface {
+	Visit(node *ASTNode) (w Visitor)
+}
+
+// Walk traverses the abstract syntax tree in depth-first order in the same way as go/ast.Walk.
+func Walk(node *ASTNode, visitor Visitor) {
+	if visitor = visitor.Visit(node); visitor == nil {
+		return
+	}
+	for _, child := range node.children {
+		Walk(child, visitor)
+	}
+	visitor.Visit(nil)
+}
@@ -2,11 +2,23 @@ package jsonpath
 
 import (
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 )
 
 type bufferContainer struct {
-	result []interface{}
+	result     []interface{}
+	pathMode   bool
+	path       string
+	upsertMode bool
+	setter     func(interface{})
+	lists      map[*interface{}]*accessorList
+	recorder   *PatchRecorder
+	pointer    func() string
+	getRoot    func() interface{}
+	limiter    *executionLimiter
+	failures   *[]error
 }
 
 var bufferContainerSortSliceSyncPool = &sync.Pool{
@@ -36,3 +48,145 @@ func (b *bufferContainer) putSortSlice(sortKeys *sort.StringSlice) {
 		bufferContainerSortSliceSyncPool.Put(sortKeys)
 	}
 }
+
+// getAccessorList returns the list shared by the accessors of the elements of the same list.
+// The setter of the current value is used to replace the list when its elements are deleted.
+func (b *bufferContainer) getAccessorList(srcList []interface{}) *accessorList {
+	key := &srcList[0]
+	if list, ok := b.lists[key]; ok {
+		return list
+	}
+
+	if b.lists == nil {
+		b.lists = make(map[*interface{}]*accessorList)
+	}
+	list := &accessorList{
+		list:    srcList,
+		setter:  b.setter,
+		pointer: b.pointer,
+	}
+	b.lists[key] = list
+	return list
+}
+
+// getMapPointer returns the function building the JSON Pointer of the member of the current value.
+// The pointers are built only when the patch is recorded.
+func (b *bufferContainer) getMapPointer(key string) func() string {
+	if b.recorder == nil {
+		return nil
+	}
+	parentPointer := b.pointer
+	return func() string {
+		return parentPointer() + `/` + escapePointerToken(key)
+	}
+}
+
+// getListPointer returns the function building the JSON Pointer of the element of the list,
+// which follows the position shifted by the deleted elements.
+func (b *bufferContainer) getListPointer(list *accessorList, index int) func() string {
+	if b.recorder == nil {
+		return nil
+	}
+	return func() string {
+		position, _ := list.getPosition(index)
+		return list.pointer() + `/` + strconv.Itoa(position)
+	}
+}
+
+func (b *bufferContainer) getIndexPointer(index int) func() string {
+	if b.recorder == nil {
+		return nil
+	}
+	parentPointer := b.pointer
+	return func() string {
+		return parentPointer() + `/` + strconv.Itoa(index)
+	}
+}
+
+// visit counts the JSON value visited in the retrieval if it is limited.
+func (b *bufferContainer) visit() {
+	if b.limiter != nil {
+		b.limiter.visit()
+	}
+}
+
+// isLenient reports whether the errors of the branches are recorded to the failures.
+func (b *bufferContainer) isLenient() bool {
+	return b.failures != nil
+}
+
+// addFailure records the error of the branch in lenient mode,
+// located by the path of the current value, which is kept in lenient mode.
+func (b *bufferContainer) addFailure(err errorRuntime) {
+	*b.failures = append(*b.failures, addErrorParentPath(err, b.path[len(`$`):]).(error))
+}
+
+func (b *bufferContainer) appendResult(value interface{}, path string) {
+	if b.limiter != nil && b.limiter.resultContainer == b {
+		b.limiter.checkResults(len(b.result) + 1)
+	}
+	if b.pathMode {
+		b.result = append(b.result, PathValue{
+			Path:  path,
+			Value: value,
+		})
+		return
+	}
+	b.result = append(b.result, value)
+}
+
+func (b *bufferContainer) getMapPath(key string) string {
+	if !b.pathMode {
+		return ``
+	}
+	return joinMapPath(b.path, key)
+}
+
+func (b *bufferContainer) getListPath(index int) string {
+	if !b.pathMode {
+		return ``
+	}
+	return joinListPath(b.path, index)
+}
+
+func joinMapPath(path, key string) string {
+	return path + `[` + quoteNormalizedPathKey(key) + `]`
+}
+
+func joinListPath(path string, index int) string {
+	return path + `[` + strconv.Itoa(index) + `]`
+}
+
+func quoteNormalizedPathKey(key string) string {
+	var builder strings.Builder
+	builder.Grow(len(key) + 2)
+	builder.WriteByte('\'')
+	for _, char := range key {
+		switch char {
+		case '\'':
+			builder.WriteString(`\'`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\b':
+			builder.WriteString(`\b`)
+		case '\f':
+			builder.WriteString(`\f`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			if char < 0x20 {
+				builder.WriteString(`\u00`)
+				builder.WriteByte(`0123456789abcdef`[char>>4])
+				builder.WriteByte(`0123456789abcdef`[char&0xF])
+				continue
+			}
+			builder.WriteRune(char)
+		}
+	}
+	builder.WriteByte('\'')
+	return builder.String()
+}
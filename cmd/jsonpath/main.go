@@ -0,0 +1,67 @@
+// Command jsonpath provides the tools for the JSONPaths.
+//
+// Usage:
+//
+//	jsonpath fmt [-strict] [jsonpath ...]
+//
+// The fmt command prints each JSONPath in the canonical form.
+// If no JSONPath is given, the JSONPaths are read from the standard input line by line.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/AsaiYusuke/jsonpath"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != `fmt` {
+		fmt.Fprintln(os.Stderr, `usage: jsonpath fmt [-strict] [jsonpath ...]`)
+		os.Exit(2)
+	}
+	os.Exit(runFormat(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// runFormat formats the JSONPaths and returns the exit code.
+// The invalid JSONPaths are reported and the rest are still formatted.
+func runFormat(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet(`fmt`, flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	strictMode := flagSet.Bool(`strict`, false, `parse the JSONPaths in strict mode (RFC 9535)`)
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+
+	config := jsonpath.Config{}
+	if *strictMode {
+		config.SetStrictMode()
+	}
+
+	jsonPaths := flagSet.Args()
+	if len(jsonPaths) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			jsonPaths = append(jsonPaths, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	exitCode := 0
+	for _, jsonPath := range jsonPaths {
+		formatted, err := jsonpath.Format(jsonPath, config)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", jsonPath, err)
+			exitCode = 1
+			continue
+		}
+		fmt.Fprintln(stdout, formatted)
+	}
+	return exitCode
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunFormat(t *testing.T) {
+	testCases := []struct {
+		name             string
+		args             []string
+		stdin            string
+		expectedCode     int
+		expectedStdout   string
+		expectedStderr   string
+		isStderrContains bool
+	}{
+		{
+			name:           `arguments`,
+			args:           []string{`$.a`, `$["b"][ 0 ]`},
+			expectedStdout: "$['a']\n$['b'][0]\n",
+		},
+		{
+			name:           `stdin`,
+			stdin:          "$.a\n$..b\n",
+			expectedStdout: "$['a']\n$..['b']\n",
+		},
+		{
+			name:           `arguments ignore stdin`,
+			args:           []string{`$.a`},
+			stdin:          "$.b\n",
+			expectedStdout: "$['a']\n",
+		},
+		{
+			name:           `not strict`,
+			args:           []string{`$[?@.a]`, `a.b`},
+			expectedStdout: "$[?(@['a'])]\n$['a']['b']\n",
+		},
+		{
+			name:           `strict`,
+			args:           []string{`-strict`, `$[?@.a]`, `a.b`},
+			expectedCode:   1,
+			expectedStdout: "$[?@['a']]\n",
+			expectedStderr: "a.b: invalid syntax (position=0, reason=unrecognized input, near=a.b)\n",
+		},
+		{
+			name:           `invalid path`,
+			args:           []string{`$.a`, `$[`, `$.b`},
+			expectedCode:   1,
+			expectedStdout: "$['a']\n$['b']\n",
+			expectedStderr: "$[: invalid syntax (position=1, reason=unrecognized input, near=[)\n",
+		},
+		{
+			name:           `invalid path from stdin`,
+			stdin:          "$[\n$.a\n",
+			expectedCode:   1,
+			expectedStdout: "$['a']\n",
+			expectedStderr: "$[: invalid syntax (position=1, reason=unrecognized input, near=[)\n",
+		},
+		{
+			name:             `bad flag`,
+			args:             []string{`-x`, `$.a`},
+			expectedCode:     2,
+			expectedStderr:   `flag provided but not defined: -x`,
+			isStderrContains: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var stdout, stderr bytes.Buffer
+		code := runFormat(testCase.args, strings.NewReader(testCase.stdin), &stdout, &stderr)
+		if code != testCase.expectedCode {
+			t.Errorf("%s: expected code<%d> != actual code<%d>\n", testCase.name, testCase.expectedCode, code)
+		}
+		if stdout.String() != testCase.expectedStdout {
+			t.Errorf("%s: expected stdout<%s> != actual stdout<%s>\n",
+				testCase.name, testCase.expectedStdout, stdout.String())
+		}
+		if testCase.isStderrContains && !strings.Contains(stderr.String(), testCase.expectedStderr) ||
+			!testCase.isStderrContains && stderr.String() != testCase.expectedStderr {
+			t.Errorf("%s: expected stderr<%s> != actual stderr<%s>\n",
+				testCase.name, testCase.expectedStderr, stderr.String())
+		}
+	}
+}
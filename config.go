@@ -5,8 +5,22 @@ type Config struct {
 	filterFunctions    map[string]func(interface{}) (interface{}, error)
 	aggregateFunctions map[string]func([]interface{}) (interface{}, error)
 	accessorMode       bool
+	upsertMode         bool
+	pathMode           bool
+	strictMode         bool
+	valueGroupCompare  valueGroupCompareMode
+	patchRecorder      *PatchRecorder
+	limits             executionLimits
 }
 
+type valueGroupCompareMode int
+
+const (
+	valueGroupCompareNone valueGroupCompareMode = iota
+	valueGroupCompareAny
+	valueGroupCompareAll
+)
+
 // SetFilterFunction sets the custom function.
 func (c *Config) SetFilterFunction(id string, function func(interface{}) (interface{}, error)) {
 	if c.filterFunctions == nil {
@@ -27,3 +41,66 @@ func (c *Config) SetAggregateFunction(id string, function func([]interface{}) (i
 func (c *Config) SetAccessorMode() {
 	c.accessorMode = true
 }
+
+// SetUpsertMode sets a collection of accessors to the result in the same way as SetAccessorMode,
+// and the accessors of the missing members create the intermediate objects and arrays when Set is called.
+// It can be used only with the JSONPath that returns a single value.
+func (c *Config) SetUpsertMode() {
+	c.accessorMode = true
+	c.upsertMode = true
+}
+
+// SetPatchRecorder sets the recorder of the updates through the accessors.
+// The updates are recorded as the operations of JSON Patch in the accessor mode and the upsert mode,
+// and also in Set, Update and Delete.
+func (c *Config) SetPatchRecorder(recorder *PatchRecorder) {
+	c.patchRecorder = recorder
+}
+
+// SetPathMode sets a collection of values paired with their normalized paths to the result.
+func (c *Config) SetPathMode() {
+	c.pathMode = true
+}
+
+// SetStrictMode sets the parser and the evaluator to conform to RFC 9535.
+func (c *Config) SetStrictMode() {
+	c.strictMode = true
+}
+
+// SetValueGroupCompareAny allows JSONPaths that return a value group in comparisons.
+// The comparison is true if any of the values satisfies it.
+func (c *Config) SetValueGroupCompareAny() {
+	c.valueGroupCompare = valueGroupCompareAny
+}
+
+// SetValueGroupCompareAll allows JSONPaths that return a value group in comparisons.
+// The comparison is true if all of the values satisfy it.
+func (c *Config) SetValueGroupCompareAll() {
+	c.valueGroupCompare = valueGroupCompareAll
+}
+
+// SetMaxVisitedNodes sets the maximum number of the JSON values visited in a retrieval,
+// including the ones visited by the filters and the recursive descents.
+// The retrieval exceeding it fails with ErrorVisitedNodesExceeded.
+func (c *Config) SetMaxVisitedNodes(limit int) {
+	c.limits.maxVisitedNodes = limit
+}
+
+// SetMaxResults sets the maximum number of the results of a retrieval.
+// The retrieval exceeding it fails with ErrorResultsExceeded.
+func (c *Config) SetMaxResults(limit int) {
+	c.limits.maxResults = limit
+}
+
+// SetMaxRecursiveDepth sets the maximum depth that a recursive descent `..` descends from its starting value.
+// The retrieval exceeding it fails with ErrorRecursiveDepthExceeded.
+func (c *Config) SetMaxRecursiveDepth(limit int) {
+	c.limits.maxRecursiveDepth = limit
+}
+
+// SetMaxFilterNesting sets the maximum number of the filters evaluated inside one another,
+// such as the two filters of `$[?(@.a[?(@.b)])]`.
+// The retrieval exceeding it fails with ErrorFilterNestingExceeded.
+func (c *Config) SetMaxFilterNesting(limit int) {
+	c.limits.maxFilterNesting = limit
+}
@@ -4,9 +4,16 @@ const (
 	msgErrorInvalidSyntaxUnrecognizedInput string = `unrecognized input`
 	msgErrorInvalidSyntaxTwoCurrentNode    string = `comparison between two current nodes is prohibited`
 	msgErrorInvalidSyntaxFilterValueGroup  string = `JSONPath that returns a value group is prohibited`
+	msgErrorInvalidSyntaxFunctionArgCount  string = `the number of function arguments is unmatched`
+	msgErrorInvalidSyntaxFunctionArgType   string = `the type of function argument is unmatched`
 
-	msgTypeNull          string = `null`
-	msgTypeObject        string = `object`
-	msgTypeArray         string = `array`
-	msgTypeObjectOrArray string = `object/array`
+	maxIJSONInteger       int64  = 1<<53 - 1
+	maxUpsertElements     int    = 1 << 16
+	strictBlankCharacters string = " \t\n\r"
+
+	msgTypeNull           string = `null`
+	msgTypeObject         string = `object`
+	msgTypeArray          string = `array`
+	msgTypeObjectOrArray  string = `object/array`
+	msgTypeNumberOrString string = `number/string`
 )
@@ -1,9 +1,74 @@
 package jsonpath
 
+// errorBasicRuntime is shared by the errors of the same node.
+// The path of the value at which the error occurred is set to its copy while the error is returned
+// to the parent nodes, so that the successful retrievals do not build the paths.
 type errorBasicRuntime struct {
 	node *syntaxBasicNode
+	path string
 }
 
 func (b errorBasicRuntime) getSyntaxNode() *syntaxBasicNode {
 	return b.node
 }
+
+// getNormalizedPath returns the normalized path of the value at which the error occurred.
+func (b errorBasicRuntime) getNormalizedPath() string {
+	return `$` + b.path
+}
+
+func (b *errorBasicRuntime) addParentPath(parentPath string) *errorBasicRuntime {
+	return &errorBasicRuntime{
+		node: b.node,
+		path: parentPath + b.path,
+	}
+}
+
+// errorRuntimeRecorded is returned in lenient mode by the node whose errors of the branches are recorded,
+// so that its parent nodes neither record them again nor return another error.
+type errorRuntimeRecorded struct {
+	*errorBasicRuntime
+}
+
+// addErrorParentPath adds the path of the parent value to the error located by its value.
+func addErrorParentPath(err errorRuntime, parentPath string) errorRuntime {
+	if parentPath == `` {
+		return err
+	}
+	switch typedErr := err.(type) {
+	case ErrorMemberNotExist:
+		typedErr.errorBasicRuntime = typedErr.addParentPath(parentPath)
+		return typedErr
+	case ErrorTypeUnmatched:
+		typedErr.errorBasicRuntime = typedErr.addParentPath(parentPath)
+		return typedErr
+	case ErrorFunctionFailed:
+		typedErr.errorBasicRuntime = typedErr.addParentPath(parentPath)
+		return typedErr
+	}
+	return err
+}
+
+// addErrorMapPath adds the member of the parent value to the path of the error.
+// The path is built in another function, so that the check of the error is inlined into the retrievals.
+func addErrorMapPath(err errorRuntime, key string) errorRuntime {
+	if err == nil {
+		return nil
+	}
+	return addErrorMapSegment(err, key)
+}
+
+func addErrorMapSegment(err errorRuntime, key string) errorRuntime {
+	return addErrorParentPath(err, joinMapPath(``, key))
+}
+
+func addErrorListPath(err errorRuntime, index int) errorRuntime {
+	if err == nil {
+		return nil
+	}
+	return addErrorListSegment(err, index)
+}
+
+func addErrorListSegment(err errorRuntime, index int) errorRuntime {
+	return addErrorParentPath(err, joinListPath(``, index))
+}
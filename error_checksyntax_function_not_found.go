@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFunctionNotFound is the sentinel error matched with ErrorFunctionNotFound by errors.Is.
+var ErrFunctionNotFound = errors.New(`function not found`)
 
 // ErrorFunctionNotFound represents the error that the function specified in the JSONPath is not found.
 type ErrorFunctionNotFound struct {
@@ -10,3 +16,13 @@ type ErrorFunctionNotFound struct {
 func (e ErrorFunctionNotFound) Error() string {
 	return fmt.Sprintf(`function not found (function=%s)`, e.function)
 }
+
+// Is reports whether the target is ErrFunctionNotFound.
+func (e ErrorFunctionNotFound) Is(target error) bool {
+	return target == ErrFunctionNotFound
+}
+
+// Function returns the name of the function, such as `.name()`.
+func (e ErrorFunctionNotFound) Function() string {
+	return e.function
+}
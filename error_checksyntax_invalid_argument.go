@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidArgument is the sentinel error matched with ErrorInvalidArgument by errors.Is.
+var ErrInvalidArgument = errors.New(`invalid argument`)
 
 // ErrorInvalidArgument represents the error that argument specified in the JSONPath is treated as the invalid error in Go syntax.
 type ErrorInvalidArgument struct {
@@ -11,3 +17,18 @@ type ErrorInvalidArgument struct {
 func (e ErrorInvalidArgument) Error() string {
 	return fmt.Sprintf(`invalid argument (argument=%s, error=%s)`, e.argument, e.err)
 }
+
+// Is reports whether the target is ErrInvalidArgument.
+func (e ErrorInvalidArgument) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// Argument returns the invalid argument.
+func (e ErrorInvalidArgument) Argument() string {
+	return e.argument
+}
+
+// Unwrap returns the error of Go syntax.
+func (e ErrorInvalidArgument) Unwrap() error {
+	return e.err
+}
@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSyntax is the sentinel error matched with ErrorInvalidSyntax by errors.Is.
+var ErrInvalidSyntax = errors.New(`invalid syntax`)
 
 // ErrorInvalidSyntax represents the error that have syntax error in the JSONPath.
 type ErrorInvalidSyntax struct {
@@ -12,3 +18,23 @@ type ErrorInvalidSyntax struct {
 func (e ErrorInvalidSyntax) Error() string {
 	return fmt.Sprintf(`invalid syntax (position=%d, reason=%s, near=%s)`, e.position, e.reason, e.near)
 }
+
+// Is reports whether the target is ErrInvalidSyntax.
+func (e ErrorInvalidSyntax) Is(target error) bool {
+	return target == ErrInvalidSyntax
+}
+
+// Position returns the position of the invalid syntax in the JSONPath.
+func (e ErrorInvalidSyntax) Position() int {
+	return e.position
+}
+
+// Reason returns the reason of the error.
+func (e ErrorInvalidSyntax) Reason() string {
+	return e.reason
+}
+
+// Near returns the JSONPath from the position of the invalid syntax.
+func (e ErrorInvalidSyntax) Near() string {
+	return e.near
+}
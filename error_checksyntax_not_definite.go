@@ -0,0 +1,35 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotDefinite is the sentinel error matched with ErrorNotDefinite by errors.Is.
+var ErrNotDefinite = errors.New(`not definite`)
+
+// ErrorNotDefinite represents the error that the JSONPath could select more than one node,
+// so that it could not be converted into a single location such as a JSON Pointer.
+type ErrorNotDefinite struct {
+	path string
+	near string
+}
+
+func (e ErrorNotDefinite) Error() string {
+	return fmt.Sprintf(`not definite (path=%s, near=%s)`, e.path, e.near)
+}
+
+// Is reports whether the target is ErrNotDefinite.
+func (e ErrorNotDefinite) Is(target error) bool {
+	return target == ErrNotDefinite
+}
+
+// Path returns the JSONPath.
+func (e ErrorNotDefinite) Path() string {
+	return e.path
+}
+
+// Near returns the syntax that could select more than one node.
+func (e ErrorNotDefinite) Near() string {
+	return e.near
+}
@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotSupported is the sentinel error matched with ErrorNotSupported by errors.Is.
+var ErrNotSupported = errors.New(`not supported`)
 
 // ErrorNotSupported represents the error that the unsupported syntaxes specified in the JSONPath.
 type ErrorNotSupported struct {
@@ -11,3 +17,18 @@ type ErrorNotSupported struct {
 func (e ErrorNotSupported) Error() string {
 	return fmt.Sprintf(`not supported (feature=%s, path=%s)`, e.feature, e.path)
 }
+
+// Is reports whether the target is ErrNotSupported.
+func (e ErrorNotSupported) Is(target error) bool {
+	return target == ErrNotSupported
+}
+
+// Feature returns the unsupported feature.
+func (e ErrorNotSupported) Feature() string {
+	return e.feature
+}
+
+// Path returns the JSONPath.
+func (e ErrorNotSupported) Path() string {
+	return e.path
+}
@@ -0,0 +1,36 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFilterNestingExceeded is the sentinel error matched with ErrorFilterNestingExceeded by errors.Is.
+var ErrFilterNestingExceeded = errors.New(`filter nesting exceeded`)
+
+// ErrorFilterNestingExceeded represents the error that the filter specified in the JSONPath
+// was evaluated in more nested filters than the limit of Config.
+type ErrorFilterNestingExceeded struct {
+	*errorBasicRuntime
+
+	limit int
+}
+
+func (e ErrorFilterNestingExceeded) Error() string {
+	return fmt.Sprintf(`filter nesting exceeded (limit=%d, path=%s)`, e.limit, e.node.text)
+}
+
+// Is reports whether the target is ErrFilterNestingExceeded.
+func (e ErrorFilterNestingExceeded) Is(target error) bool {
+	return target == ErrFilterNestingExceeded
+}
+
+// Limit returns the limit set to Config.
+func (e ErrorFilterNestingExceeded) Limit() int {
+	return e.limit
+}
+
+// Path returns the filter in the JSONPath.
+func (e ErrorFilterNestingExceeded) Path() string {
+	return e.node.text
+}
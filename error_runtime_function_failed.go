@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFunctionFailed is the sentinel error matched with ErrorFunctionFailed by errors.Is.
+var ErrFunctionFailed = errors.New(`function failed`)
 
 // ErrorFunctionFailed represents the error that the function specified in the JSONPath failed.
 type ErrorFunctionFailed struct {
@@ -12,3 +18,23 @@ type ErrorFunctionFailed struct {
 func (e ErrorFunctionFailed) Error() string {
 	return fmt.Sprintf(`function failed (function=%s, error=%s)`, e.node.text, e.err)
 }
+
+// Is reports whether the target is ErrFunctionFailed.
+func (e ErrorFunctionFailed) Is(target error) bool {
+	return target == ErrFunctionFailed
+}
+
+// Function returns the function in the JSONPath, such as `.name()`.
+func (e ErrorFunctionFailed) Function() string {
+	return e.node.text
+}
+
+// Unwrap returns the error returned by the function.
+func (e ErrorFunctionFailed) Unwrap() error {
+	return e.err
+}
+
+// NormalizedPath returns the normalized path of the value given to the function.
+func (e ErrorFunctionFailed) NormalizedPath() string {
+	return e.getNormalizedPath()
+}
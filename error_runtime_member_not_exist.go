@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMemberNotExist is the sentinel error matched with ErrorMemberNotExist by errors.Is.
+var ErrMemberNotExist = errors.New(`member did not exist`)
 
 // ErrorMemberNotExist represents the error that the member specified in the JSONPath did not exist in the JSON object.
 type ErrorMemberNotExist struct {
@@ -10,3 +16,20 @@ type ErrorMemberNotExist struct {
 func (e ErrorMemberNotExist) Error() string {
 	return fmt.Sprintf(`member did not exist (path=%s)`, e.node.text)
 }
+
+// Is reports whether the target is ErrMemberNotExist.
+func (e ErrorMemberNotExist) Is(target error) bool {
+	return target == ErrMemberNotExist
+}
+
+// Path returns the part of the JSONPath from the missing member.
+func (e ErrorMemberNotExist) Path() string {
+	return e.node.text
+}
+
+// NormalizedPath returns the normalized path of the member that did not exist,
+// such as `$['a'][3]['b']` for the member `b` missing from the fourth element of the wildcard.
+// The other selections selecting nothing, such as the wildcard, are located at the value to which they are applied.
+func (e ErrorMemberNotExist) NormalizedPath() string {
+	return e.getNormalizedPath()
+}
@@ -0,0 +1,44 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotSettable is the sentinel error matched with ErrorNotSettable by errors.Is.
+var ErrNotSettable = errors.New(`not settable`)
+
+// ErrorNotSettable represents the error that the result of the JSONPath could not be updated or deleted,
+// such as the result of the function, or the value could not be set to the Go value.
+type ErrorNotSettable struct {
+	operation string
+	path      string
+	reason    string
+}
+
+func (e ErrorNotSettable) Error() string {
+	if e.reason != `` {
+		return fmt.Sprintf(`not settable (operation=%s, path=%s, reason=%s)`, e.operation, e.path, e.reason)
+	}
+	return fmt.Sprintf(`not settable (operation=%s, path=%s)`, e.operation, e.path)
+}
+
+// Is reports whether the target is ErrNotSettable.
+func (e ErrorNotSettable) Is(target error) bool {
+	return target == ErrNotSettable
+}
+
+// Operation returns the operation, such as `set`, `update` and `delete`.
+func (e ErrorNotSettable) Operation() string {
+	return e.operation
+}
+
+// Path returns the normalized path of the result that could not be updated.
+func (e ErrorNotSettable) Path() string {
+	return e.path
+}
+
+// Reason returns the reason of the error, or the empty string if the result cannot be updated at all.
+func (e ErrorNotSettable) Reason() string {
+	return e.reason
+}
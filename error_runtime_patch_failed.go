@@ -0,0 +1,40 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPatchFailed is the sentinel error matched with ErrorPatchFailed by errors.Is.
+var ErrPatchFailed = errors.New(`patch failed`)
+
+// ErrorPatchFailed represents the error that the operation of JSON Patch could not be applied.
+type ErrorPatchFailed struct {
+	op     string
+	path   string
+	reason string
+}
+
+func (e ErrorPatchFailed) Error() string {
+	return fmt.Sprintf(`patch failed (op=%s, path=%s, reason=%s)`, e.op, e.path, e.reason)
+}
+
+// Is reports whether the target is ErrPatchFailed.
+func (e ErrorPatchFailed) Is(target error) bool {
+	return target == ErrPatchFailed
+}
+
+// Op returns the operation of JSON Patch.
+func (e ErrorPatchFailed) Op() string {
+	return e.op
+}
+
+// Path returns the JSON Pointer of the operation.
+func (e ErrorPatchFailed) Path() string {
+	return e.path
+}
+
+// Reason returns the reason of the error.
+func (e ErrorPatchFailed) Reason() string {
+	return e.reason
+}
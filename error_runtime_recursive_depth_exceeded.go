@@ -0,0 +1,36 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRecursiveDepthExceeded is the sentinel error matched with ErrorRecursiveDepthExceeded by errors.Is.
+var ErrRecursiveDepthExceeded = errors.New(`recursive depth exceeded`)
+
+// ErrorRecursiveDepthExceeded represents the error that the recursive descent specified in the JSONPath
+// descended deeper than the limit of Config.
+type ErrorRecursiveDepthExceeded struct {
+	*errorBasicRuntime
+
+	limit int
+}
+
+func (e ErrorRecursiveDepthExceeded) Error() string {
+	return fmt.Sprintf(`recursive depth exceeded (limit=%d, path=%s)`, e.limit, e.node.text)
+}
+
+// Is reports whether the target is ErrRecursiveDepthExceeded.
+func (e ErrorRecursiveDepthExceeded) Is(target error) bool {
+	return target == ErrRecursiveDepthExceeded
+}
+
+// Limit returns the limit set to Config.
+func (e ErrorRecursiveDepthExceeded) Limit() int {
+	return e.limit
+}
+
+// Path returns the part of the JSONPath from the recursive descent.
+func (e ErrorRecursiveDepthExceeded) Path() string {
+	return e.node.text
+}
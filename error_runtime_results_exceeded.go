@@ -0,0 +1,28 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrResultsExceeded is the sentinel error matched with ErrorResultsExceeded by errors.Is.
+var ErrResultsExceeded = errors.New(`results exceeded`)
+
+// ErrorResultsExceeded represents the error that the retrieval returned more results than the limit of Config.
+type ErrorResultsExceeded struct {
+	limit int
+}
+
+func (e ErrorResultsExceeded) Error() string {
+	return fmt.Sprintf(`results exceeded (limit=%d)`, e.limit)
+}
+
+// Is reports whether the target is ErrResultsExceeded.
+func (e ErrorResultsExceeded) Is(target error) bool {
+	return target == ErrResultsExceeded
+}
+
+// Limit returns the limit set to Config.
+func (e ErrorResultsExceeded) Limit() int {
+	return e.limit
+}
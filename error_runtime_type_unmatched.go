@@ -1,6 +1,12 @@
 package jsonpath
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTypeUnmatched is the sentinel error matched with ErrorTypeUnmatched by errors.Is.
+var ErrTypeUnmatched = errors.New(`type unmatched`)
 
 // ErrorTypeUnmatched represents the error that the node type specified in the JSONPath did not exist in the JSON object.
 type ErrorTypeUnmatched struct {
@@ -13,3 +19,29 @@ type ErrorTypeUnmatched struct {
 func (e ErrorTypeUnmatched) Error() string {
 	return fmt.Sprintf(`type unmatched (expected=%s, found=%s, path=%s)`, e.expectedType, e.foundType, e.node.text)
 }
+
+// Is reports whether the target is ErrTypeUnmatched.
+func (e ErrorTypeUnmatched) Is(target error) bool {
+	return target == ErrTypeUnmatched
+}
+
+// ExpectedType returns the expected type.
+func (e ErrorTypeUnmatched) ExpectedType() string {
+	return e.expectedType
+}
+
+// FoundType returns the type found in the JSON.
+func (e ErrorTypeUnmatched) FoundType() string {
+	return e.foundType
+}
+
+// Path returns the part of the JSONPath from the node of the unmatched type.
+func (e ErrorTypeUnmatched) Path() string {
+	return e.node.text
+}
+
+// NormalizedPath returns the normalized path of the value of the unmatched type,
+// such as `$['a'][3]['b']` for the value found by the wildcard.
+func (e ErrorTypeUnmatched) NormalizedPath() string {
+	return e.getNormalizedPath()
+}
@@ -0,0 +1,36 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUpsertIndexExceeded is the sentinel error matched with ErrorUpsertIndexExceeded by errors.Is.
+var ErrUpsertIndexExceeded = errors.New(`upsert index exceeded`)
+
+// ErrorUpsertIndexExceeded represents the error that the index specified in the JSONPath
+// would create more elements of the array than the limit in the upsert mode.
+type ErrorUpsertIndexExceeded struct {
+	*errorBasicRuntime
+
+	limit int
+}
+
+func (e ErrorUpsertIndexExceeded) Error() string {
+	return fmt.Sprintf(`upsert index exceeded (limit=%d, path=%s)`, e.limit, e.node.text)
+}
+
+// Is reports whether the target is ErrUpsertIndexExceeded.
+func (e ErrorUpsertIndexExceeded) Is(target error) bool {
+	return target == ErrUpsertIndexExceeded
+}
+
+// Limit returns the maximum number of the elements created by an index.
+func (e ErrorUpsertIndexExceeded) Limit() int {
+	return e.limit
+}
+
+// Path returns the part of the JSONPath from the index.
+func (e ErrorUpsertIndexExceeded) Path() string {
+	return e.node.text
+}
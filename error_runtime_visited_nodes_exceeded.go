@@ -0,0 +1,28 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVisitedNodesExceeded is the sentinel error matched with ErrorVisitedNodesExceeded by errors.Is.
+var ErrVisitedNodesExceeded = errors.New(`visited nodes exceeded`)
+
+// ErrorVisitedNodesExceeded represents the error that the retrieval visited more JSON values than the limit of Config.
+type ErrorVisitedNodesExceeded struct {
+	limit int
+}
+
+func (e ErrorVisitedNodesExceeded) Error() string {
+	return fmt.Sprintf(`visited nodes exceeded (limit=%d)`, e.limit)
+}
+
+// Is reports whether the target is ErrVisitedNodesExceeded.
+func (e ErrorVisitedNodesExceeded) Is(target error) bool {
+	return target == ErrVisitedNodesExceeded
+}
+
+// Limit returns the limit set to Config.
+func (e ErrorVisitedNodesExceeded) Limit() int {
+	return e.limit
+}
@@ -0,0 +1,122 @@
+package jsonpath
+
+import "context"
+
+// executionLimits are the limits of the retrieval set to Config. The zero value means no limit.
+type executionLimits struct {
+	maxVisitedNodes   int
+	maxResults        int
+	maxRecursiveDepth int
+	maxFilterNesting  int
+}
+
+func (l executionLimits) isLimited() bool {
+	return l != executionLimits{}
+}
+
+// executionLimiter checks the cancellation of the context and the limits during the retrieval.
+// The retrieval is aborted by the panic of executionAborted, since the runtime errors of the nodes
+// are discarded when the other values are found.
+type executionLimiter struct {
+	executionLimits
+
+	ctx             context.Context
+	done            <-chan struct{}
+	resultContainer *bufferContainer
+	visitedNodes    int
+	filterNesting   int
+}
+
+type executionAborted struct {
+	err error
+}
+
+// newExecutionLimiter returns the limiter, or nil if neither the context nor the limits can stop the retrieval.
+func newExecutionLimiter(ctx context.Context, limits executionLimits) *executionLimiter {
+	if ctx.Done() == nil && !limits.isLimited() {
+		return nil
+	}
+	return &executionLimiter{
+		executionLimits: limits,
+		ctx:             ctx,
+		done:            ctx.Done(),
+	}
+}
+
+// retrieveWithLimits retrieves the JSON from the given node,
+// with the limiter if the context or the limits can stop the retrieval.
+func retrieveWithLimits(ctx context.Context, limits executionLimits, node syntaxNode, src interface{},
+	container *bufferContainer) (err errorRuntime, abortErr error) {
+
+	limiter := newExecutionLimiter(ctx, limits)
+	if limiter == nil {
+		return node.retrieve(src, src, container), nil
+	}
+	return limiter.retrieve(node, src, container)
+}
+
+// retrieve retrieves the JSON with the limiter.
+// The error of the context or the limit is returned as abortErr if the retrieval is aborted.
+func (l *executionLimiter) retrieve(
+	node syntaxNode, src interface{}, container *bufferContainer) (err errorRuntime, abortErr error) {
+
+	defer func() {
+		if exception := recover(); exception != nil {
+			aborted, ok := exception.(executionAborted)
+			if !ok {
+				panic(exception)
+			}
+			abortErr = aborted.err
+		}
+	}()
+
+	if err := l.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	container.limiter = l
+	l.resultContainer = container
+	return node.retrieve(src, src, container), nil
+}
+
+func (l *executionLimiter) visit() {
+	select {
+	case <-l.done:
+		panic(executionAborted{err: l.ctx.Err()})
+	default:
+	}
+
+	l.visitedNodes++
+	if l.maxVisitedNodes > 0 && l.visitedNodes > l.maxVisitedNodes {
+		panic(executionAborted{err: ErrorVisitedNodesExceeded{limit: l.maxVisitedNodes}})
+	}
+}
+
+func (l *executionLimiter) checkResults(count int) {
+	if l.maxResults > 0 && count > l.maxResults {
+		panic(executionAborted{err: ErrorResultsExceeded{limit: l.maxResults}})
+	}
+}
+
+func (l *executionLimiter) checkRecursiveDepth(depth int, errorRuntime *errorBasicRuntime) {
+	if l.maxRecursiveDepth > 0 && depth > l.maxRecursiveDepth {
+		panic(executionAborted{err: ErrorRecursiveDepthExceeded{
+			errorBasicRuntime: errorRuntime,
+			limit:             l.maxRecursiveDepth,
+		}})
+	}
+}
+
+func (l *executionLimiter) enterFilter(errorRuntime *errorBasicRuntime) {
+	l.filterNesting++
+	if l.maxFilterNesting > 0 && l.filterNesting > l.maxFilterNesting {
+		panic(executionAborted{err: ErrorFilterNestingExceeded{
+			errorBasicRuntime: errorRuntime,
+			limit:             l.maxFilterNesting,
+		}})
+	}
+}
+
+func (l *executionLimiter) leaveFilter() {
+	l.filterNesting--
+}
@@ -0,0 +1,154 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var pointerTokenEscaper = strings.NewReplacer(`~`, `~0`, `/`, `~1`)
+var pointerTokenUnescaper = strings.NewReplacer(`~1`, `/`, `~0`, `~`)
+
+// ToPointer converts the definite JSONPath into the JSON Pointer (RFC 6901).
+// The JSONPath has to consist of only the child identifiers of a single name
+// and the non-negative indexes of a single element.
+func ToPointer(jsonPath string, config ...Config) (string, error) {
+	parsed, err := parse(jsonPath, config...)
+	if err != nil {
+		return ``, err
+	}
+
+	var builder strings.Builder
+	for node := parsed.root; node != nil; node = node.getNext() {
+		switch typedNode := node.(type) {
+		case *syntaxRootIdentifier:
+			continue
+		case *syntaxChildSingleIdentifier:
+			builder.WriteByte('/')
+			builder.WriteString(escapePointerToken(typedNode.identifier))
+			continue
+		case *syntaxUnionQualifier:
+			if len(typedNode.subscripts) == 1 {
+				if subscript, ok := typedNode.subscripts[0].(*syntaxIndexSubscript); ok && subscript.number >= 0 {
+					builder.WriteByte('/')
+					builder.WriteString(strconv.Itoa(subscript.number))
+					continue
+				}
+			}
+		}
+		return ``, ErrorNotDefinite{
+			path: jsonPath,
+			near: node.getText(),
+		}
+	}
+	return builder.String(), nil
+}
+
+// FromPointer converts the JSON Pointer (RFC 6901) into the JSONPath in the bracket notation.
+// The reference tokens in the form of the array index are converted into the indexes,
+// and the other tokens are converted into the quoted child identifiers.
+func FromPointer(pointer string) (string, error) {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return ``, ErrorInvalidArgument{
+			argument: pointer,
+			err:      err,
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteByte('$')
+	for _, token := range tokens {
+		builder.WriteByte('[')
+		if _, ok := getPointerIndex(token); ok {
+			builder.WriteString(token)
+		} else {
+			builder.WriteString(quoteNormalizedPathKey(token))
+		}
+		builder.WriteByte(']')
+	}
+	return builder.String(), nil
+}
+
+func escapePointerToken(token string) string {
+	return pointerTokenEscaper.Replace(token)
+}
+
+// splitPointer returns the unescaped reference tokens of the JSON Pointer (RFC 6901).
+func splitPointer(pointer string) ([]string, error) {
+	if len(pointer) == 0 {
+		return []string{}, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf(`JSON Pointer must start with '/'`)
+	}
+
+	tokens := strings.Split(pointer[1:], `/`)
+	for index := range tokens {
+		if !isValidPointerToken(tokens[index]) {
+			return nil, fmt.Errorf(`'~' must be followed by '0' or '1'`)
+		}
+		tokens[index] = pointerTokenUnescaper.Replace(tokens[index])
+	}
+	return tokens, nil
+}
+
+// isValidPointerToken returns whether all '~' of the reference token are escape sequences.
+func isValidPointerToken(token string) bool {
+	for index := 0; index < len(token); index++ {
+		if token[index] != '~' {
+			continue
+		}
+		if index+1 == len(token) || (token[index+1] != '0' && token[index+1] != '1') {
+			return false
+		}
+	}
+	return true
+}
+
+func joinPointer(tokens []string) string {
+	var builder strings.Builder
+	for _, token := range tokens {
+		builder.WriteByte('/')
+		builder.WriteString(escapePointerToken(token))
+	}
+	return builder.String()
+}
+
+// getPointerIndex returns the array index of the reference token.
+// The leading zeros and the signs are not allowed.
+func getPointerIndex(token string) (int, bool) {
+	if len(token) == 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	for _, char := range token {
+		if char < '0' || char > '9' {
+			return 0, false
+		}
+	}
+	index, err := strconv.Atoi(token)
+	return index, err == nil
+}
+
+func getPointerValue(src interface{}, tokens []string) (interface{}, bool) {
+	current := src
+	for _, token := range tokens {
+		switch typedCurrent := current.(type) {
+		case map[string]interface{}:
+			value, ok := typedCurrent[token]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []interface{}:
+			index, ok := getPointerIndex(token)
+			if !ok || index >= len(typedCurrent) {
+				return nil, false
+			}
+			current = typedCurrent[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
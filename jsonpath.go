@@ -1,12 +1,20 @@
 package jsonpath
 
 import (
+	"encoding/json"
+	"io"
 	"regexp"
+	"strings"
 	"sync"
 )
 
-var parseMutex sync.Mutex
-var parser = pegJSONPathParser{}
+var parserSyncPool = &sync.Pool{
+	New: func() interface{} {
+		parser := &pegJSONPathParser{}
+		parser.Init()
+		return parser
+	},
+}
 var unescapeRegex = regexp.MustCompile(`\\(.)`)
 
 // Retrieve returns the retrieved JSON using the given JSONPath.
@@ -20,7 +28,205 @@ func Retrieve(jsonPath string, src interface{}, config ...Config) ([]interface{}
 
 // Parse returns the parser function using the given JSONPath.
 func Parse(jsonPath string, config ...Config) (f func(src interface{}) ([]interface{}, error), err error) {
-	parseMutex.Lock()
+	query, err := ParseQuery(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+	return query.Execute, nil
+}
+
+// RetrieveReader retrieves the JSON read from the reader using the given JSONPath,
+// and passes each result to the callback in the document order.
+func RetrieveReader(
+	jsonPath string, reader io.Reader, callback func(interface{}) error, config ...Config) error {
+
+	jsonPathFunc, err := ParseReader(jsonPath, config...)
+	if err != nil {
+		return err
+	}
+	return jsonPathFunc(reader, callback)
+}
+
+// ParseReader returns the parser function that evaluates the JSON read from the reader
+// without unmarshalling the whole document.
+// The subtrees that cannot match are skipped, and the selectors that need the whole value,
+// such as negative indexes, evaluate their subtree after unmarshalling it.
+// The filters unmarshal the elements one at a time to evaluate them.
+// The data following the JSON value is rejected.
+func ParseReader(jsonPath string, config ...Config) (
+	f func(reader io.Reader, callback func(interface{}) error) error, err error) {
+
+	parsed, err := parse(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathMode bool
+	if len(config) > 0 {
+		if config[0].accessorMode {
+			return nil, ErrorNotSupported{
+				feature: `accessor mode with reader`,
+				path:    jsonPath,
+			}
+		}
+		if config[0].limits.isLimited() {
+			return nil, ErrorNotSupported{
+				feature: `execution limits with reader`,
+				path:    jsonPath,
+			}
+		}
+		pathMode = config[0].pathMode
+	}
+
+	if parsed.hasRootReference {
+		return nil, ErrorNotSupported{
+			feature: `root reference with reader`,
+			path:    jsonPath,
+		}
+	}
+
+	root := parsed.root
+	return func(reader io.Reader, callback func(interface{}) error) error {
+		evaluator := streamEvaluator{
+			source:   &decoderStreamSource{decoder: json.NewDecoder(reader)},
+			callback: callback,
+			pathMode: pathMode,
+		}
+
+		var path string
+		if pathMode {
+			path = `$`
+		}
+
+		if err := evaluator.evaluate([]syntaxNode{root}, path); err != nil {
+			return err
+		}
+		return evaluator.source.end()
+	}, nil
+}
+
+// RetrieveBytes returns the retrieved JSON using the given JSONPath
+// by scanning the raw JSON bytes and unmarshalling only the matched values.
+func RetrieveBytes(jsonPath string, data []byte, config ...Config) ([]interface{}, error) {
+	jsonPathFunc, err := ParseBytes(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+	return jsonPathFunc(data)
+}
+
+// RetrieveRawBytes returns the retrieved JSON using the given JSONPath
+// as json.RawMessage referring to the given raw JSON bytes.
+func RetrieveRawBytes(jsonPath string, data []byte, config ...Config) ([]interface{}, error) {
+	jsonPathFunc, err := ParseRawBytes(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+	return jsonPathFunc(data)
+}
+
+// ParseBytes returns the parser function that evaluates the raw JSON bytes
+// in the same way as ParseReader, and returns the results in the document order.
+// The filters referring to the root unmarshal the whole document only when they are evaluated.
+// The numbers and the literals of the skipped values are not validated.
+func ParseBytes(jsonPath string, config ...Config) (f func(data []byte) ([]interface{}, error), err error) {
+	return parseBytes(jsonPath, false, config...)
+}
+
+// ParseRawBytes returns the parser function in the same way as ParseBytes,
+// but the results are returned as json.RawMessage without unmarshalling them.
+// The results computed from the unmarshalled values, such as the negative indexes and the aggregate functions,
+// are re-encoded.
+func ParseRawBytes(jsonPath string, config ...Config) (f func(data []byte) ([]interface{}, error), err error) {
+	return parseBytes(jsonPath, true, config...)
+}
+
+func parseBytes(jsonPath string, rawMode bool, config ...Config) (
+	f func(data []byte) ([]interface{}, error), err error) {
+
+	parsed, err := parse(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathMode bool
+	if len(config) > 0 {
+		if config[0].accessorMode {
+			return nil, ErrorNotSupported{
+				feature: `accessor mode with bytes`,
+				path:    jsonPath,
+			}
+		}
+		if config[0].limits.isLimited() {
+			return nil, ErrorNotSupported{
+				feature: `execution limits with bytes`,
+				path:    jsonPath,
+			}
+		}
+		pathMode = config[0].pathMode
+	}
+
+	root := parsed.root
+	hasRootReference := parsed.hasRootReference
+	return func(data []byte) ([]interface{}, error) {
+		result := make([]interface{}, 0)
+		source := &bytesStreamSource{data: data}
+		evaluator := streamEvaluator{
+			source: source,
+			callback: func(value interface{}) error {
+				result = append(result, value)
+				return nil
+			},
+			pathMode: pathMode,
+			rawMode:  rawMode,
+		}
+
+		if hasRootReference {
+			var document interface{}
+			var isLoaded bool
+			evaluator.rootLoader = func() (interface{}, error) {
+				if !isLoaded {
+					if err := json.Unmarshal(data, &document); err != nil {
+						return nil, err
+					}
+					isLoaded = true
+				}
+				return document, nil
+			}
+		}
+
+		var path string
+		if pathMode {
+			path = `$`
+		}
+
+		if err := evaluator.evaluate([]syntaxNode{root}, path); err != nil {
+			return nil, err
+		}
+
+		if err := source.end(); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}, nil
+}
+
+func getRootPointer() string {
+	return ``
+}
+
+func isSingleValueNode(node syntaxNode) bool {
+	for ; node != nil; node = node.getNext() {
+		if node.isValueGroup() {
+			return false
+		}
+	}
+	return true
+}
+
+func parse(jsonPath string, config ...Config) (parsed jsonPathParser, err error) {
+	parser := parserSyncPool.Get().(*pegJSONPathParser)
 	defer func() {
 		if exception := recover(); exception != nil {
 			if _err, ok := exception.(error); ok {
@@ -28,16 +234,11 @@ func Parse(jsonPath string, config ...Config) (f func(src interface{}) ([]interf
 			}
 		}
 		parser.jsonPathParser = jsonPathParser{}
-		parseMutex.Unlock()
+		parserSyncPool.Put(parser)
 	}()
 
 	parser.Buffer = jsonPath
-
-	if parser.parse == nil {
-		parser.Init()
-	} else {
-		parser.Reset()
-	}
+	parser.Reset()
 
 	parser.jsonPathParser.unescapeRegex = unescapeRegex
 
@@ -45,20 +246,36 @@ func Parse(jsonPath string, config ...Config) (f func(src interface{}) ([]interf
 		parser.jsonPathParser.filterFunctions = config[0].filterFunctions
 		parser.jsonPathParser.aggregateFunctions = config[0].aggregateFunctions
 		parser.jsonPathParser.accessorMode = config[0].accessorMode
+		parser.jsonPathParser.strictMode = config[0].strictMode
+		parser.jsonPathParser.valueGroupCompare = config[0].valueGroupCompare
+
+		if config[0].strictMode {
+			checkStrictOuterBlank(jsonPath)
+		}
 	}
 
 	parser.Parse()
 	parser.Execute()
 
-	root := parser.jsonPathParser.root
-	return func(src interface{}) ([]interface{}, error) {
-		container := bufferContainer{}
-
-		err := root.retrieve(src, src, &container)
-		if err != nil {
-			return container.result, err.(error)
-		}
-		return container.result, nil
+	return parser.jsonPathParser, nil
+}
 
-	}, nil
+func checkStrictOuterBlank(jsonPath string) {
+	if len(jsonPath) == 0 {
+		return
+	}
+	if strings.ContainsRune(strictBlankCharacters, rune(jsonPath[0])) {
+		panic(ErrorInvalidSyntax{
+			position: 0,
+			reason:   msgErrorInvalidSyntaxUnrecognizedInput,
+			near:     jsonPath,
+		})
+	}
+	if lastIndex := len(jsonPath) - 1; strings.ContainsRune(strictBlankCharacters, rune(jsonPath[lastIndex])) {
+		panic(ErrorInvalidSyntax{
+			position: lastIndex,
+			reason:   msgErrorInvalidSyntaxUnrecognizedInput,
+			near:     jsonPath[lastIndex:],
+		})
+	}
 }
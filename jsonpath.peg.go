@@ -38,9 +38,13 @@ const (
 	rulewildcardIdentifier
 	rulesingleQuotedNodeIdentifier
 	ruledoubleQuotedNodeIdentifier
+	rulesingleQuotedString
+	ruledoubleQuotedString
 	rulehexDigits
 	rulehexDigit
 	rulequalifier
+	ruleselectors
+	ruleselector
 	ruleunion
 	ruleindex
 	ruleslice
@@ -50,6 +54,15 @@ const (
 	rulesepSlice
 	rulescript
 	rulecommand
+	rulescriptExpression
+	rulescriptTerm
+	rulescriptFactor
+	rulescriptNumber
+	rulescriptJsonpath
+	rulescriptJsonpathParameter
+	rulescriptChildNode
+	rulescriptDotChildIdentifier
+	rulescriptLength
 	rulefilter
 	rulequery
 	ruleandQuery
@@ -58,11 +71,18 @@ const (
 	rulelogicAnd
 	rulelogicNot
 	rulecomparator
+	rulestrictComparator
 	ruleqParam
 	ruleqNumericParam
 	ruleqLiteral
 	rulesingleJsonpathFilter
 	rulejsonpathFilter
+	rulevalueFunction
+	rulelogicalFunction
+	rulevalueFunctionName
+	rulelogicalFunctionName
+	rulefunctionArguments
+	rulefunctionArgument
 	rulelNumber
 	rulelBool
 	rulelString
@@ -72,11 +92,10 @@ const (
 	rulesquareBracketEnd
 	rulescriptStart
 	rulescriptEnd
-	rulefilterStart
-	rulefilterEnd
 	rulesubQueryStart
 	rulesubQueryEnd
 	rulespace
+	rulesegmentSpace
 	ruleAction0
 	rulePegText
 	ruleAction1
@@ -124,6 +143,46 @@ const (
 	ruleAction43
 	ruleAction44
 	ruleAction45
+	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
+	ruleAction73
+	ruleAction74
+	ruleAction75
+	ruleAction76
+	ruleAction77
+	ruleAction78
+	ruleAction79
+	ruleAction80
+	ruleAction81
+	ruleAction82
+	ruleAction83
+	ruleAction84
+	ruleAction85
 )
 
 var rul3s = [...]string{
@@ -148,9 +207,13 @@ var rul3s = [...]string{
 	"wildcardIdentifier",
 	"singleQuotedNodeIdentifier",
 	"doubleQuotedNodeIdentifier",
+	"singleQuotedString",
+	"doubleQuotedString",
 	"hexDigits",
 	"hexDigit",
 	"qualifier",
+	"selectors",
+	"selector",
 	"union",
 	"index",
 	"slice",
@@ -160,6 +223,15 @@ var rul3s = [...]string{
 	"sepSlice",
 	"script",
 	"command",
+	"scriptExpression",
+	"scriptTerm",
+	"scriptFactor",
+	"scriptNumber",
+	"scriptJsonpath",
+	"scriptJsonpathParameter",
+	"scriptChildNode",
+	"scriptDotChildIdentifier",
+	"scriptLength",
 	"filter",
 	"query",
 	"andQuery",
@@ -168,11 +240,18 @@ var rul3s = [...]string{
 	"logicAnd",
 	"logicNot",
 	"comparator",
+	"strictComparator",
 	"qParam",
 	"qNumericParam",
 	"qLiteral",
 	"singleJsonpathFilter",
 	"jsonpathFilter",
+	"valueFunction",
+	"logicalFunction",
+	"valueFunctionName",
+	"logicalFunctionName",
+	"functionArguments",
+	"functionArgument",
 	"lNumber",
 	"lBool",
 	"lString",
@@ -182,11 +261,10 @@ var rul3s = [...]string{
 	"squareBracketEnd",
 	"scriptStart",
 	"scriptEnd",
-	"filterStart",
-	"filterEnd",
 	"subQueryStart",
 	"subQueryEnd",
 	"space",
+	"segmentSpace",
 	"Action0",
 	"PegText",
 	"Action1",
@@ -234,6 +312,46 @@ var rul3s = [...]string{
 	"Action43",
 	"Action44",
 	"Action45",
+	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
+	"Action73",
+	"Action74",
+	"Action75",
+	"Action76",
+	"Action77",
+	"Action78",
+	"Action79",
+	"Action80",
+	"Action81",
+	"Action82",
+	"Action83",
+	"Action84",
+	"Action85",
 }
 
 type token32 struct {
@@ -350,7 +468,7 @@ type pegJSONPathParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [107]func() bool
+	rules  [166]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -469,55 +587,80 @@ func (p *pegJSONPathParser) Execute() {
 
 		case ruleAction3:
 
-			p.pushRecursiveChildIdentifier(p.pop().(syntaxNode))
+			p.setLastPosition(begin, end)
 
 		case ruleAction4:
 
-			p.setLastNodeText(text)
+			p.setLastPosition(begin+2, end)
+			p.pushRecursiveChildIdentifier(p.pop().(syntaxNode))
+			p.setLastPosition(begin, begin+2)
 
 		case ruleAction5:
 
-			p.pushFunction(text, p.pop().(string))
+			p.setLastNodeText(text)
+			p.setLastPosition(begin, end)
 
 		case ruleAction6:
 
-			p.push(text)
+			p.pushFunction(text, p.pop().(string))
+			p.setLastPosition(begin, end)
 
 		case ruleAction7:
 
-			p.setLastNodeText(text)
+			p.push(text)
 
 		case ruleAction8:
 
-			p.pushRootIdentifier()
+			p.setLastNodeText(text)
+			p.setLastPosition(begin, end)
 
 		case ruleAction9:
 
-			p.pushCurrentRootIdentifier()
+			p.pushRootIdentifier()
+			p.setLastPosition(begin, end)
 
 		case ruleAction10:
 
-			p.pushChildSingleIdentifier(p.unescape(text))
+			p.pushCurrentRootIdentifier()
+			p.setLastPosition(begin, end)
 
 		case ruleAction11:
 
+			p.pushChildSingleIdentifier(text)
+
+		case ruleAction12:
+
+			p.pushChildSingleIdentifier(p.unescape(text))
+
+		case ruleAction13:
+
 			identifier2 := p.pop().(syntaxNode)
 			identifier1 := p.pop().(syntaxNode)
 			p.pushChildMultiIdentifier(identifier1, identifier2)
 
-		case ruleAction12:
+		case ruleAction14:
+
+			p.setLastPosition(begin, end)
+
+		case ruleAction15:
 
 			p.pushChildWildcardIdentifier()
 
-		case ruleAction13:
+		case ruleAction16:
 
 			p.pushChildSingleIdentifier(p.unescapeSingleQuotedString(text))
 
-		case ruleAction14:
+		case ruleAction17:
 
 			p.pushChildSingleIdentifier(p.unescapeDoubleQuotedString(text))
 
-		case ruleAction15:
+		case ruleAction18:
+
+			appendNode := p.pop().(syntaxNode)
+			node := p.pop().(syntaxNode)
+			p.pushMultiSelector(node, appendNode)
+
+		case ruleAction19:
 
 			childIndexUnion := p.pop().(*syntaxUnionQualifier)
 			parentIndexUnion := p.pop().(*syntaxUnionQualifier)
@@ -525,7 +668,7 @@ func (p *pegJSONPathParser) Execute() {
 			parentIndexUnion.setValueGroup()
 			p.push(parentIndexUnion)
 
-		case ruleAction16:
+		case ruleAction20:
 
 			step := p.pop().(*syntaxIndexSubscript)
 			end := p.pop().(*syntaxIndexSubscript)
@@ -541,68 +684,186 @@ func (p *pegJSONPathParser) Execute() {
 				p.pushSliceNegativeStepSubscript(start, end, step)
 			}
 
-		case ruleAction17:
+		case ruleAction21:
 
 			p.pushIndexSubscript(text)
 
-		case ruleAction18:
+		case ruleAction22:
 
 			p.pushWildcardSubscript()
 
-		case ruleAction19:
+		case ruleAction23:
 
+			p.setLastPosition(begin, end)
 			p.pushUnionQualifier(p.pop().(syntaxSubscript))
 
-		case ruleAction20:
+		case ruleAction24:
 
 			p.pushIndexSubscript(`1`)
 
-		case ruleAction21:
+		case ruleAction25:
 
 			if len(text) > 0 {
 				p.pushIndexSubscript(text)
 			} else {
 				p.pushOmittedIndexSubscript(`0`)
 			}
+			p.setLastPosition(begin, end)
 
-		case ruleAction22:
+		case ruleAction26:
 
-			p.pushScriptQualifier(text)
+			p.pushScriptQualifier(p.pop().(syntaxScript))
 
-		case ruleAction23:
+		case ruleAction27:
+
+			p.pushNotSupportedScript(text)
+
+		case ruleAction28:
+
+			rightScript := p.pop().(syntaxScript)
+			leftScript := p.pop().(syntaxScript)
+			p.pushScriptAdd(leftScript, rightScript)
+
+		case ruleAction29:
+
+			rightScript := p.pop().(syntaxScript)
+			leftScript := p.pop().(syntaxScript)
+			p.pushScriptSubtract(leftScript, rightScript)
+
+		case ruleAction30:
+
+			rightScript := p.pop().(syntaxScript)
+			leftScript := p.pop().(syntaxScript)
+			p.pushScriptMultiply(leftScript, rightScript)
+
+		case ruleAction31:
+
+			rightScript := p.pop().(syntaxScript)
+			leftScript := p.pop().(syntaxScript)
+			p.pushScriptDivide(leftScript, rightScript)
+
+		case ruleAction32:
+
+			rightScript := p.pop().(syntaxScript)
+			leftScript := p.pop().(syntaxScript)
+			p.pushScriptModulo(leftScript, rightScript)
+
+		case ruleAction33:
+
+			p.pushScriptNegate(p.pop().(syntaxScript))
+			p.setLastPosition(begin, end)
+
+		case ruleAction34:
+
+			p.pushScriptParameterLiteral(p.pop())
+			p.setLastPosition(begin, end)
+
+		case ruleAction35:
+
+			p.pushScriptParameterLiteral(p.pop())
+			p.setLastPosition(begin, end)
+
+		case ruleAction36:
+
+			p.push(p.toFloat(text))
+
+		case ruleAction37:
+
+			p.push(true)
+
+		case ruleAction38:
+
+			p.push(false)
+
+		case ruleAction39:
+
+			isLength := p.pop().(bool)
+			node := p.pop().(syntaxNode)
+			if node.isValueGroup() {
+				panic(p.syntaxErr(
+					begin, msgErrorInvalidSyntaxFilterValueGroup, buffer))
+			}
+			p.pushScriptParameterJSONPath(node, isLength)
+			p.setLastPosition(begin, end)
+
+		case ruleAction40:
+
+			p.saveParams()
+
+		case ruleAction41:
+
+			p.setNodeChain()
+			p.updateRootValueGroup()
+			p.loadParams()
+
+		case ruleAction42:
+
+			p.setLastPosition(begin+2, end)
+			p.pushRecursiveChildIdentifier(p.pop().(syntaxNode))
+			p.setLastPosition(begin, begin+2)
+
+		case ruleAction43:
+
+			p.setLastNodeText(text)
+			p.setLastPosition(begin, end)
+
+		case ruleAction44:
+
+			p.pushChildSingleIdentifier(text)
+
+		case ruleAction45:
 
 			p.pushFilterQualifier(p.pop().(syntaxQuery))
+			p.setLastPosition(begin, end)
 
-		case ruleAction24:
+		case ruleAction46:
 
 			rightQuery := p.pop().(syntaxQuery)
 			leftQuery := p.pop().(syntaxQuery)
 			p.pushLogicalOr(leftQuery, rightQuery)
 
-		case ruleAction25:
+		case ruleAction47:
 
 			rightQuery := p.pop().(syntaxQuery)
 			leftQuery := p.pop().(syntaxQuery)
 			p.pushLogicalAnd(leftQuery, rightQuery)
 
-		case ruleAction26:
+		case ruleAction48:
+
+			p.pushLogicalNot(p.pop().(syntaxQuery))
+			p.setLastPosition(begin, end)
+
+		case ruleAction49:
+
+			p.setLastPosition(begin, end)
+
+		case ruleAction50:
 
 			query := p.pop()
 			p.push(query)
+			p.setLastPosition(begin, end)
 
 			if logicalNot, ok := query.(*syntaxLogicalNot); ok {
 				query = (*logicalNot).query
 			}
 			if checkQuery, ok := query.(*syntaxBasicCompareQuery); ok {
-				_, leftIsCurrentRoot := checkQuery.leftParam.param.(*syntaxQueryParamCurrentRoot)
-				_, rigthIsCurrentRoot := checkQuery.rightParam.param.(*syntaxQueryParamCurrentRoot)
-				if leftIsCurrentRoot && rigthIsCurrentRoot {
+				if !checkQuery.leftParam.isLiteral && !checkQuery.rightParam.isLiteral {
 					panic(p.syntaxErr(
 						begin, msgErrorInvalidSyntaxTwoCurrentNode, buffer))
 				}
 			}
 
-		case ruleAction27:
+		case ruleAction51:
+
+			logicalFunction := p.pop().(syntaxQuery)
+			if text[0:1] == `!` {
+				p.pushLogicalNot(logicalFunction)
+			} else {
+				p.push(logicalFunction)
+			}
+			p.setLastPosition(begin, end)
+
+		case ruleAction52:
 
 			_ = p.pop()
 			jsonpathFilter := p.pop().(syntaxQuery)
@@ -611,71 +872,112 @@ func (p *pegJSONPathParser) Execute() {
 			} else {
 				p.push(jsonpathFilter)
 			}
+			p.setLastPosition(begin, end)
 
-		case ruleAction28:
+		case ruleAction53:
 
 			rightParam := p.pop().(*syntaxBasicCompareParameter)
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareEQ(leftParam, rightParam)
 
-		case ruleAction29:
+		case ruleAction54:
 
 			rightParam := p.pop().(*syntaxBasicCompareParameter)
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareNE(leftParam, rightParam)
 
-		case ruleAction30:
+		case ruleAction55:
 
 			rightParam := p.pop().(*syntaxBasicCompareParameter)
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareGE(leftParam, rightParam)
 
-		case ruleAction31:
+		case ruleAction56:
 
 			rightParam := p.pop().(*syntaxBasicCompareParameter)
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareGT(leftParam, rightParam)
 
-		case ruleAction32:
+		case ruleAction57:
 
 			rightParam := p.pop().(*syntaxBasicCompareParameter)
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareLE(leftParam, rightParam)
 
-		case ruleAction33:
+		case ruleAction58:
 
 			rightParam := p.pop().(*syntaxBasicCompareParameter)
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareLT(leftParam, rightParam)
 
-		case ruleAction34:
+		case ruleAction59:
 
 			leftParam := p.pop().(*syntaxBasicCompareParameter)
 			p.pushCompareRegex(leftParam, text)
+			p.setLastRegexPosition(begin-1, end+1)
 
-		case ruleAction35:
+		case ruleAction60:
+
+			rightParam := p.pop().(*syntaxBasicCompareParameter)
+			leftParam := p.pop().(*syntaxBasicCompareParameter)
+			p.pushStrictCompareEQ(leftParam, rightParam)
+
+		case ruleAction61:
+
+			rightParam := p.pop().(*syntaxBasicCompareParameter)
+			leftParam := p.pop().(*syntaxBasicCompareParameter)
+			p.pushStrictCompareNE(leftParam, rightParam)
+
+		case ruleAction62:
+
+			rightParam := p.pop().(*syntaxBasicCompareParameter)
+			leftParam := p.pop().(*syntaxBasicCompareParameter)
+			p.pushStrictCompareLE(leftParam, rightParam)
+
+		case ruleAction63:
+
+			rightParam := p.pop().(*syntaxBasicCompareParameter)
+			leftParam := p.pop().(*syntaxBasicCompareParameter)
+			p.pushStrictCompareLT(leftParam, rightParam)
+
+		case ruleAction64:
+
+			rightParam := p.pop().(*syntaxBasicCompareParameter)
+			leftParam := p.pop().(*syntaxBasicCompareParameter)
+			p.pushStrictCompareGE(leftParam, rightParam)
+
+		case ruleAction65:
+
+			rightParam := p.pop().(*syntaxBasicCompareParameter)
+			leftParam := p.pop().(*syntaxBasicCompareParameter)
+			p.pushStrictCompareGT(leftParam, rightParam)
+
+		case ruleAction66:
 
 			p.pushCompareParameterLiteral(p.pop())
+			p.setLastPosition(begin, end)
 
-		case ruleAction36:
+		case ruleAction67:
 
 			p.pushCompareParameterLiteral(p.pop())
+			p.setLastPosition(begin, end)
 
-		case ruleAction37:
+		case ruleAction68:
 
 			isLiteral := p.pop().(bool)
 			param := p.pop().(syntaxQueryJSONPathParameter)
-			if param.isValueGroupParameter() {
+			if param.isValueGroupParameter() && !p.isValueGroupCompareAllowed() {
 				panic(p.syntaxErr(
 					begin, msgErrorInvalidSyntaxFilterValueGroup, buffer))
 			}
 			p.pushBasicCompareParameter(param.(syntaxQuery), isLiteral)
+			p.setLastPosition(begin, end)
 
-		case ruleAction38:
+		case ruleAction69:
 
 			p.saveParams()
 
-		case ruleAction39:
+		case ruleAction70:
 
 			p.loadParams()
 
@@ -694,27 +996,68 @@ func (p *pegJSONPathParser) Execute() {
 				p.push(false)
 			}
 
-		case ruleAction40:
+		case ruleAction71:
+
+			p.pushFunctionExtension(begin, buffer)
+			p.setLastPosition(begin, end)
+
+		case ruleAction72:
+
+			p.pushFunctionExtension(begin, buffer)
+			p.setLastPosition(begin, end)
+
+		case ruleAction73:
+
+			p.push(text)
+
+		case ruleAction74:
+
+			p.push(text)
+
+		case ruleAction75:
+
+			p.pushCompareParameterLiteral(p.pop())
+			p.setLastPosition(begin, end)
+
+		case ruleAction76:
+
+			isLiteral := p.pop().(bool)
+			p.pushBasicCompareParameter(p.pop().(syntaxQuery), isLiteral)
+			p.setLastPosition(begin, end)
+
+		case ruleAction77:
 
 			p.push(p.toFloat(text))
 
-		case ruleAction41:
+		case ruleAction78:
+
+			p.push(p.toFloat(text))
+
+		case ruleAction79:
 
 			p.push(true)
 
-		case ruleAction42:
+		case ruleAction80:
 
 			p.push(false)
 
-		case ruleAction43:
+		case ruleAction81:
+
+			p.push(p.unescapeSingleQuotedString(text))
+
+		case ruleAction82:
+
+			p.push(p.unescapeDoubleQuotedString(text))
+
+		case ruleAction83:
 
 			p.push(p.unescape(text))
 
-		case ruleAction44:
+		case ruleAction84:
 
 			p.push(p.unescape(text))
 
-		case ruleAction45:
+		case ruleAction85:
 
 			p.push(nil)
 
@@ -931,7 +1274,7 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 			position, tokenIndex = position14, tokenIndex14
 			return false
 		},
-		/* 4 continuedJsonpath <- <(childNode* function* space Action2)> */
+		/* 4 continuedJsonpath <- <((segmentSpace childNode)* (&{!p.strictMode} function)* space Action2)> */
 		func() bool {
 			position16, tokenIndex16 := position, tokenIndex
 			{
@@ -939,6 +1282,9 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 			l18:
 				{
 					position19, tokenIndex19 := position, tokenIndex
+					if !_rules[rulesegmentSpace]() {
+						goto l19
+					}
 					if !_rules[rulechildNode]() {
 						goto l19
 					}
@@ -949,6 +1295,9 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 			l20:
 				{
 					position21, tokenIndex21 := position, tokenIndex
+					if !(!p.strictMode) {
+						goto l21
+					}
 					if !_rules[rulefunction]() {
 						goto l21
 					}
@@ -969,7 +1318,7 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 			position, tokenIndex = position16, tokenIndex16
 			return false
 		},
-		/* 5 rootNode <- <(rootIdentifier / bracketNode / dotChildIdentifier)> */
+		/* 5 rootNode <- <(rootIdentifier / (&{!p.strictMode} <(bracketNode / dotChildIdentifier)> Action3))> */
 		func() bool {
 			position22, tokenIndex22 := position, tokenIndex
 			{
@@ -982,13 +1331,27 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 					goto l24
 				l25:
 					position, tokenIndex = position24, tokenIndex24
-					if !_rules[rulebracketNode]() {
-						goto l26
+					if !(!p.strictMode) {
+						goto l22
 					}
-					goto l24
-				l26:
-					position, tokenIndex = position24, tokenIndex24
-					if !_rules[ruledotChildIdentifier]() {
+					{
+						position26 := position
+						{
+							position27, tokenIndex27 := position, tokenIndex
+							if !_rules[rulebracketNode]() {
+								goto l28
+							}
+							goto l27
+						l28:
+							position, tokenIndex = position27, tokenIndex27
+							if !_rules[ruledotChildIdentifier]() {
+								goto l22
+							}
+						}
+					l27:
+						add(rulePegText, position26)
+					}
+					if !_rules[ruleAction3]() {
 						goto l22
 					}
 				}
@@ -1002,2505 +1365,4278 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 		},
 		/* 6 parameterRootNode <- <(rootIdentifier / currentRootIdentifier)> */
 		func() bool {
-			position27, tokenIndex27 := position, tokenIndex
+			position29, tokenIndex29 := position, tokenIndex
 			{
-				position28 := position
+				position30 := position
 				{
-					position29, tokenIndex29 := position, tokenIndex
+					position31, tokenIndex31 := position, tokenIndex
 					if !_rules[rulerootIdentifier]() {
-						goto l30
+						goto l32
 					}
-					goto l29
-				l30:
-					position, tokenIndex = position29, tokenIndex29
+					goto l31
+				l32:
+					position, tokenIndex = position31, tokenIndex31
 					if !_rules[rulecurrentRootIdentifier]() {
-						goto l27
+						goto l29
 					}
 				}
-			l29:
-				add(ruleparameterRootNode, position28)
+			l31:
+				add(ruleparameterRootNode, position30)
 			}
 			return true
-		l27:
-			position, tokenIndex = position27, tokenIndex27
+		l29:
+			position, tokenIndex = position29, tokenIndex29
 			return false
 		},
-		/* 7 childNode <- <(('.' '.' (bracketNode / dotChildIdentifier) Action3) / (<('.' dotChildIdentifier)> Action4) / bracketNode)> */
+		/* 7 childNode <- <((<('.' '.' (bracketNode / dotChildIdentifier))> Action4) / (<('.' dotChildIdentifier)> Action5) / bracketNode)> */
 		func() bool {
-			position31, tokenIndex31 := position, tokenIndex
+			position33, tokenIndex33 := position, tokenIndex
 			{
-				position32 := position
+				position34 := position
 				{
-					position33, tokenIndex33 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l34
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l34
-					}
-					position++
+					position35, tokenIndex35 := position, tokenIndex
 					{
-						position35, tokenIndex35 := position, tokenIndex
-						if !_rules[rulebracketNode]() {
+						position37 := position
+						if buffer[position] != rune('.') {
 							goto l36
 						}
-						goto l35
-					l36:
-						position, tokenIndex = position35, tokenIndex35
-						if !_rules[ruledotChildIdentifier]() {
-							goto l34
+						position++
+						if buffer[position] != rune('.') {
+							goto l36
 						}
+						position++
+						{
+							position38, tokenIndex38 := position, tokenIndex
+							if !_rules[rulebracketNode]() {
+								goto l39
+							}
+							goto l38
+						l39:
+							position, tokenIndex = position38, tokenIndex38
+							if !_rules[ruledotChildIdentifier]() {
+								goto l36
+							}
+						}
+					l38:
+						add(rulePegText, position37)
 					}
-				l35:
-					if !_rules[ruleAction3]() {
-						goto l34
+					if !_rules[ruleAction4]() {
+						goto l36
 					}
-					goto l33
-				l34:
-					position, tokenIndex = position33, tokenIndex33
+					goto l35
+				l36:
+					position, tokenIndex = position35, tokenIndex35
 					{
-						position38 := position
+						position41 := position
 						if buffer[position] != rune('.') {
-							goto l37
+							goto l40
 						}
 						position++
 						if !_rules[ruledotChildIdentifier]() {
-							goto l37
+							goto l40
 						}
-						add(rulePegText, position38)
+						add(rulePegText, position41)
 					}
-					if !_rules[ruleAction4]() {
-						goto l37
+					if !_rules[ruleAction5]() {
+						goto l40
 					}
-					goto l33
-				l37:
-					position, tokenIndex = position33, tokenIndex33
+					goto l35
+				l40:
+					position, tokenIndex = position35, tokenIndex35
 					if !_rules[rulebracketNode]() {
-						goto l31
+						goto l33
 					}
 				}
-			l33:
-				add(rulechildNode, position32)
+			l35:
+				add(rulechildNode, position34)
 			}
 			return true
-		l31:
-			position, tokenIndex = position31, tokenIndex31
+		l33:
+			position, tokenIndex = position33, tokenIndex33
 			return false
 		},
-		/* 8 function <- <(<('.' functionName ('(' ')'))> Action5)> */
+		/* 8 function <- <(<('.' functionName ('(' ')'))> Action6)> */
 		func() bool {
-			position39, tokenIndex39 := position, tokenIndex
+			position42, tokenIndex42 := position, tokenIndex
 			{
-				position40 := position
+				position43 := position
 				{
-					position41 := position
+					position44 := position
 					if buffer[position] != rune('.') {
-						goto l39
+						goto l42
 					}
 					position++
 					if !_rules[rulefunctionName]() {
-						goto l39
+						goto l42
 					}
 					if buffer[position] != rune('(') {
-						goto l39
+						goto l42
 					}
 					position++
 					if buffer[position] != rune(')') {
-						goto l39
+						goto l42
 					}
 					position++
-					add(rulePegText, position41)
+					add(rulePegText, position44)
 				}
-				if !_rules[ruleAction5]() {
-					goto l39
+				if !_rules[ruleAction6]() {
+					goto l42
 				}
-				add(rulefunction, position40)
+				add(rulefunction, position43)
 			}
 			return true
-		l39:
-			position, tokenIndex = position39, tokenIndex39
+		l42:
+			position, tokenIndex = position42, tokenIndex42
 			return false
 		},
-		/* 9 functionName <- <(<('-' / '_' / [a-z] / [A-Z] / [0-9])+> Action6)> */
+		/* 9 functionName <- <(<('-' / '_' / [a-z] / [A-Z] / [0-9])+> Action7)> */
 		func() bool {
-			position42, tokenIndex42 := position, tokenIndex
+			position45, tokenIndex45 := position, tokenIndex
 			{
-				position43 := position
+				position46 := position
 				{
-					position44 := position
+					position47 := position
 					{
-						position47, tokenIndex47 := position, tokenIndex
+						position50, tokenIndex50 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l48
+							goto l51
 						}
 						position++
-						goto l47
-					l48:
-						position, tokenIndex = position47, tokenIndex47
+						goto l50
+					l51:
+						position, tokenIndex = position50, tokenIndex50
 						if buffer[position] != rune('_') {
-							goto l49
+							goto l52
 						}
 						position++
-						goto l47
-					l49:
-						position, tokenIndex = position47, tokenIndex47
+						goto l50
+					l52:
+						position, tokenIndex = position50, tokenIndex50
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l50
+							goto l53
 						}
 						position++
-						goto l47
-					l50:
-						position, tokenIndex = position47, tokenIndex47
+						goto l50
+					l53:
+						position, tokenIndex = position50, tokenIndex50
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l51
+							goto l54
 						}
 						position++
-						goto l47
-					l51:
-						position, tokenIndex = position47, tokenIndex47
+						goto l50
+					l54:
+						position, tokenIndex = position50, tokenIndex50
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l42
+							goto l45
 						}
 						position++
 					}
-				l47:
-				l45:
+				l50:
+				l48:
 					{
-						position46, tokenIndex46 := position, tokenIndex
+						position49, tokenIndex49 := position, tokenIndex
 						{
-							position52, tokenIndex52 := position, tokenIndex
+							position55, tokenIndex55 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l53
+								goto l56
 							}
 							position++
-							goto l52
-						l53:
-							position, tokenIndex = position52, tokenIndex52
+							goto l55
+						l56:
+							position, tokenIndex = position55, tokenIndex55
 							if buffer[position] != rune('_') {
-								goto l54
+								goto l57
 							}
 							position++
-							goto l52
-						l54:
-							position, tokenIndex = position52, tokenIndex52
+							goto l55
+						l57:
+							position, tokenIndex = position55, tokenIndex55
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l55
+								goto l58
 							}
 							position++
-							goto l52
-						l55:
-							position, tokenIndex = position52, tokenIndex52
+							goto l55
+						l58:
+							position, tokenIndex = position55, tokenIndex55
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l56
+								goto l59
 							}
 							position++
-							goto l52
-						l56:
-							position, tokenIndex = position52, tokenIndex52
+							goto l55
+						l59:
+							position, tokenIndex = position55, tokenIndex55
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l46
+								goto l49
 							}
 							position++
 						}
-					l52:
-						goto l45
-					l46:
-						position, tokenIndex = position46, tokenIndex46
+					l55:
+						goto l48
+					l49:
+						position, tokenIndex = position49, tokenIndex49
 					}
-					add(rulePegText, position44)
+					add(rulePegText, position47)
 				}
-				if !_rules[ruleAction6]() {
-					goto l42
+				if !_rules[ruleAction7]() {
+					goto l45
 				}
-				add(rulefunctionName, position43)
+				add(rulefunctionName, position46)
 			}
 			return true
-		l42:
-			position, tokenIndex = position42, tokenIndex42
+		l45:
+			position, tokenIndex = position45, tokenIndex45
 			return false
 		},
-		/* 10 bracketNode <- <(<(squareBracketStart (bracketChildIdentifier / qualifier) squareBracketEnd)> Action7)> */
+		/* 10 bracketNode <- <(<(squareBracketStart ((&{p.strictMode} selectors) / (&{!p.strictMode} (bracketChildIdentifier / qualifier))) squareBracketEnd)> Action8)> */
 		func() bool {
-			position57, tokenIndex57 := position, tokenIndex
+			position60, tokenIndex60 := position, tokenIndex
 			{
-				position58 := position
+				position61 := position
 				{
-					position59 := position
+					position62 := position
 					if !_rules[rulesquareBracketStart]() {
-						goto l57
+						goto l60
 					}
 					{
-						position60, tokenIndex60 := position, tokenIndex
-						if !_rules[rulebracketChildIdentifier]() {
-							goto l61
+						position63, tokenIndex63 := position, tokenIndex
+						if !(p.strictMode) {
+							goto l64
 						}
-						goto l60
-					l61:
-						position, tokenIndex = position60, tokenIndex60
-						if !_rules[rulequalifier]() {
-							goto l57
+						if !_rules[ruleselectors]() {
+							goto l64
+						}
+						goto l63
+					l64:
+						position, tokenIndex = position63, tokenIndex63
+						if !(!p.strictMode) {
+							goto l60
+						}
+						{
+							position65, tokenIndex65 := position, tokenIndex
+							if !_rules[rulebracketChildIdentifier]() {
+								goto l66
+							}
+							goto l65
+						l66:
+							position, tokenIndex = position65, tokenIndex65
+							if !_rules[rulequalifier]() {
+								goto l60
+							}
 						}
+					l65:
 					}
-				l60:
+				l63:
 					if !_rules[rulesquareBracketEnd]() {
-						goto l57
+						goto l60
 					}
-					add(rulePegText, position59)
+					add(rulePegText, position62)
 				}
-				if !_rules[ruleAction7]() {
-					goto l57
+				if !_rules[ruleAction8]() {
+					goto l60
 				}
-				add(rulebracketNode, position58)
+				add(rulebracketNode, position61)
 			}
 			return true
-		l57:
-			position, tokenIndex = position57, tokenIndex57
+		l60:
+			position, tokenIndex = position60, tokenIndex60
 			return false
 		},
-		/* 11 rootIdentifier <- <('$' Action8)> */
+		/* 11 rootIdentifier <- <(<'$'> Action9)> */
 		func() bool {
-			position62, tokenIndex62 := position, tokenIndex
+			position67, tokenIndex67 := position, tokenIndex
 			{
-				position63 := position
-				if buffer[position] != rune('$') {
-					goto l62
+				position68 := position
+				{
+					position69 := position
+					if buffer[position] != rune('$') {
+						goto l67
+					}
+					position++
+					add(rulePegText, position69)
 				}
-				position++
-				if !_rules[ruleAction8]() {
-					goto l62
+				if !_rules[ruleAction9]() {
+					goto l67
 				}
-				add(rulerootIdentifier, position63)
+				add(rulerootIdentifier, position68)
 			}
 			return true
-		l62:
-			position, tokenIndex = position62, tokenIndex62
+		l67:
+			position, tokenIndex = position67, tokenIndex67
 			return false
 		},
-		/* 12 currentRootIdentifier <- <('@' Action9)> */
+		/* 12 currentRootIdentifier <- <(<'@'> Action10)> */
 		func() bool {
-			position64, tokenIndex64 := position, tokenIndex
+			position70, tokenIndex70 := position, tokenIndex
 			{
-				position65 := position
-				if buffer[position] != rune('@') {
-					goto l64
+				position71 := position
+				{
+					position72 := position
+					if buffer[position] != rune('@') {
+						goto l70
+					}
+					position++
+					add(rulePegText, position72)
 				}
-				position++
-				if !_rules[ruleAction9]() {
-					goto l64
+				if !_rules[ruleAction10]() {
+					goto l70
 				}
-				add(rulecurrentRootIdentifier, position65)
+				add(rulecurrentRootIdentifier, position71)
 			}
 			return true
-		l64:
-			position, tokenIndex = position64, tokenIndex64
+		l70:
+			position, tokenIndex = position70, tokenIndex70
 			return false
 		},
-		/* 13 dotChildIdentifier <- <(wildcardIdentifier / (<(('\\' signsWithoutHyphenUnderscore) / (!([\x00-\x1f] / '\u007f') !signsWithoutHyphenUnderscore .))+> !('(' ')') Action10))> */
+		/* 13 dotChildIdentifier <- <(wildcardIdentifier / (&{p.strictMode} <(([a-z] / [A-Z] / '_' / (![\x00-\u007f] .)) ([a-z] / [A-Z] / [0-9] / '_' / (![\x00-\u007f] .))*)> Action11) / (&{!p.strictMode} <(('\\' signsWithoutHyphenUnderscore) / (!([\x00-\x1f] / '\u007f') !signsWithoutHyphenUnderscore .))+> !('(' ')') Action12))> */
 		func() bool {
-			position66, tokenIndex66 := position, tokenIndex
+			position73, tokenIndex73 := position, tokenIndex
 			{
-				position67 := position
+				position74 := position
 				{
-					position68, tokenIndex68 := position, tokenIndex
+					position75, tokenIndex75 := position, tokenIndex
 					if !_rules[rulewildcardIdentifier]() {
-						goto l69
+						goto l76
+					}
+					goto l75
+				l76:
+					position, tokenIndex = position75, tokenIndex75
+					if !(p.strictMode) {
+						goto l77
+					}
+					{
+						position78 := position
+						{
+							position79, tokenIndex79 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l80
+							}
+							position++
+							goto l79
+						l80:
+							position, tokenIndex = position79, tokenIndex79
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l81
+							}
+							position++
+							goto l79
+						l81:
+							position, tokenIndex = position79, tokenIndex79
+							if buffer[position] != rune('_') {
+								goto l82
+							}
+							position++
+							goto l79
+						l82:
+							position, tokenIndex = position79, tokenIndex79
+							{
+								position83, tokenIndex83 := position, tokenIndex
+								if c := buffer[position]; c < rune('\x00') || c > rune('\u007f') {
+									goto l83
+								}
+								position++
+								goto l77
+							l83:
+								position, tokenIndex = position83, tokenIndex83
+							}
+							if !matchDot() {
+								goto l77
+							}
+						}
+					l79:
+					l84:
+						{
+							position85, tokenIndex85 := position, tokenIndex
+							{
+								position86, tokenIndex86 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l87
+								}
+								position++
+								goto l86
+							l87:
+								position, tokenIndex = position86, tokenIndex86
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l88
+								}
+								position++
+								goto l86
+							l88:
+								position, tokenIndex = position86, tokenIndex86
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l89
+								}
+								position++
+								goto l86
+							l89:
+								position, tokenIndex = position86, tokenIndex86
+								if buffer[position] != rune('_') {
+									goto l90
+								}
+								position++
+								goto l86
+							l90:
+								position, tokenIndex = position86, tokenIndex86
+								{
+									position91, tokenIndex91 := position, tokenIndex
+									if c := buffer[position]; c < rune('\x00') || c > rune('\u007f') {
+										goto l91
+									}
+									position++
+									goto l85
+								l91:
+									position, tokenIndex = position91, tokenIndex91
+								}
+								if !matchDot() {
+									goto l85
+								}
+							}
+						l86:
+							goto l84
+						l85:
+							position, tokenIndex = position85, tokenIndex85
+						}
+						add(rulePegText, position78)
+					}
+					if !_rules[ruleAction11]() {
+						goto l77
+					}
+					goto l75
+				l77:
+					position, tokenIndex = position75, tokenIndex75
+					if !(!p.strictMode) {
+						goto l73
 					}
-					goto l68
-				l69:
-					position, tokenIndex = position68, tokenIndex68
 					{
-						position70 := position
+						position92 := position
 						{
-							position73, tokenIndex73 := position, tokenIndex
+							position95, tokenIndex95 := position, tokenIndex
 							if buffer[position] != rune('\\') {
-								goto l74
+								goto l96
 							}
 							position++
 							if !_rules[rulesignsWithoutHyphenUnderscore]() {
-								goto l74
+								goto l96
 							}
-							goto l73
-						l74:
-							position, tokenIndex = position73, tokenIndex73
+							goto l95
+						l96:
+							position, tokenIndex = position95, tokenIndex95
 							{
-								position75, tokenIndex75 := position, tokenIndex
+								position97, tokenIndex97 := position, tokenIndex
 								{
-									position76, tokenIndex76 := position, tokenIndex
+									position98, tokenIndex98 := position, tokenIndex
 									if c := buffer[position]; c < rune('\x00') || c > rune('\x1f') {
-										goto l77
+										goto l99
 									}
 									position++
-									goto l76
-								l77:
-									position, tokenIndex = position76, tokenIndex76
+									goto l98
+								l99:
+									position, tokenIndex = position98, tokenIndex98
 									if buffer[position] != rune('\u007f') {
-										goto l75
+										goto l97
 									}
 									position++
 								}
-							l76:
-								goto l66
-							l75:
-								position, tokenIndex = position75, tokenIndex75
+							l98:
+								goto l73
+							l97:
+								position, tokenIndex = position97, tokenIndex97
 							}
 							{
-								position78, tokenIndex78 := position, tokenIndex
+								position100, tokenIndex100 := position, tokenIndex
 								if !_rules[rulesignsWithoutHyphenUnderscore]() {
-									goto l78
+									goto l100
 								}
-								goto l66
-							l78:
-								position, tokenIndex = position78, tokenIndex78
+								goto l73
+							l100:
+								position, tokenIndex = position100, tokenIndex100
 							}
 							if !matchDot() {
-								goto l66
+								goto l73
 							}
 						}
-					l73:
-					l71:
+					l95:
+					l93:
 						{
-							position72, tokenIndex72 := position, tokenIndex
+							position94, tokenIndex94 := position, tokenIndex
 							{
-								position79, tokenIndex79 := position, tokenIndex
+								position101, tokenIndex101 := position, tokenIndex
 								if buffer[position] != rune('\\') {
-									goto l80
+									goto l102
 								}
 								position++
 								if !_rules[rulesignsWithoutHyphenUnderscore]() {
-									goto l80
+									goto l102
 								}
-								goto l79
-							l80:
-								position, tokenIndex = position79, tokenIndex79
+								goto l101
+							l102:
+								position, tokenIndex = position101, tokenIndex101
 								{
-									position81, tokenIndex81 := position, tokenIndex
+									position103, tokenIndex103 := position, tokenIndex
 									{
-										position82, tokenIndex82 := position, tokenIndex
+										position104, tokenIndex104 := position, tokenIndex
 										if c := buffer[position]; c < rune('\x00') || c > rune('\x1f') {
-											goto l83
+											goto l105
 										}
 										position++
-										goto l82
-									l83:
-										position, tokenIndex = position82, tokenIndex82
+										goto l104
+									l105:
+										position, tokenIndex = position104, tokenIndex104
 										if buffer[position] != rune('\u007f') {
-											goto l81
+											goto l103
 										}
 										position++
 									}
-								l82:
-									goto l72
-								l81:
-									position, tokenIndex = position81, tokenIndex81
+								l104:
+									goto l94
+								l103:
+									position, tokenIndex = position103, tokenIndex103
 								}
 								{
-									position84, tokenIndex84 := position, tokenIndex
+									position106, tokenIndex106 := position, tokenIndex
 									if !_rules[rulesignsWithoutHyphenUnderscore]() {
-										goto l84
+										goto l106
 									}
-									goto l72
-								l84:
-									position, tokenIndex = position84, tokenIndex84
+									goto l94
+								l106:
+									position, tokenIndex = position106, tokenIndex106
 								}
 								if !matchDot() {
-									goto l72
+									goto l94
 								}
 							}
-						l79:
-							goto l71
-						l72:
-							position, tokenIndex = position72, tokenIndex72
+						l101:
+							goto l93
+						l94:
+							position, tokenIndex = position94, tokenIndex94
 						}
-						add(rulePegText, position70)
+						add(rulePegText, position92)
 					}
 					{
-						position85, tokenIndex85 := position, tokenIndex
+						position107, tokenIndex107 := position, tokenIndex
 						if buffer[position] != rune('(') {
-							goto l85
+							goto l107
 						}
 						position++
 						if buffer[position] != rune(')') {
-							goto l85
+							goto l107
 						}
 						position++
-						goto l66
-					l85:
-						position, tokenIndex = position85, tokenIndex85
+						goto l73
+					l107:
+						position, tokenIndex = position107, tokenIndex107
 					}
-					if !_rules[ruleAction10]() {
-						goto l66
+					if !_rules[ruleAction12]() {
+						goto l73
 					}
 				}
-			l68:
-				add(ruledotChildIdentifier, position67)
+			l75:
+				add(ruledotChildIdentifier, position74)
 			}
 			return true
-		l66:
-			position, tokenIndex = position66, tokenIndex66
+		l73:
+			position, tokenIndex = position73, tokenIndex73
 			return false
 		},
 		/* 14 signsWithoutHyphenUnderscore <- <([ -,] / '.' / '/' / [:-@] / [[-^] / '`' / [{-~])> */
 		func() bool {
-			position86, tokenIndex86 := position, tokenIndex
+			position108, tokenIndex108 := position, tokenIndex
 			{
-				position87 := position
+				position109 := position
 				{
-					position88, tokenIndex88 := position, tokenIndex
+					position110, tokenIndex110 := position, tokenIndex
 					if c := buffer[position]; c < rune(' ') || c > rune(',') {
-						goto l89
+						goto l111
 					}
 					position++
-					goto l88
-				l89:
-					position, tokenIndex = position88, tokenIndex88
+					goto l110
+				l111:
+					position, tokenIndex = position110, tokenIndex110
 					if buffer[position] != rune('.') {
-						goto l90
+						goto l112
 					}
 					position++
-					goto l88
-				l90:
-					position, tokenIndex = position88, tokenIndex88
+					goto l110
+				l112:
+					position, tokenIndex = position110, tokenIndex110
 					if buffer[position] != rune('/') {
-						goto l91
+						goto l113
 					}
 					position++
-					goto l88
-				l91:
-					position, tokenIndex = position88, tokenIndex88
+					goto l110
+				l113:
+					position, tokenIndex = position110, tokenIndex110
 					if c := buffer[position]; c < rune(':') || c > rune('@') {
-						goto l92
+						goto l114
 					}
 					position++
-					goto l88
-				l92:
-					position, tokenIndex = position88, tokenIndex88
+					goto l110
+				l114:
+					position, tokenIndex = position110, tokenIndex110
 					if c := buffer[position]; c < rune('[') || c > rune('^') {
-						goto l93
+						goto l115
 					}
 					position++
-					goto l88
-				l93:
-					position, tokenIndex = position88, tokenIndex88
+					goto l110
+				l115:
+					position, tokenIndex = position110, tokenIndex110
 					if buffer[position] != rune('`') {
-						goto l94
+						goto l116
 					}
 					position++
-					goto l88
-				l94:
-					position, tokenIndex = position88, tokenIndex88
+					goto l110
+				l116:
+					position, tokenIndex = position110, tokenIndex110
 					if c := buffer[position]; c < rune('{') || c > rune('~') {
-						goto l86
+						goto l108
 					}
 					position++
 				}
-			l88:
-				add(rulesignsWithoutHyphenUnderscore, position87)
+			l110:
+				add(rulesignsWithoutHyphenUnderscore, position109)
 			}
 			return true
-		l86:
-			position, tokenIndex = position86, tokenIndex86
+		l108:
+			position, tokenIndex = position108, tokenIndex108
 			return false
 		},
-		/* 15 bracketChildIdentifier <- <(bracketNodeIdentifier (sep bracketNodeIdentifier Action11)* !sep)> */
+		/* 15 bracketChildIdentifier <- <(bracketNodeIdentifier (sep bracketNodeIdentifier Action13)* !sep)> */
 		func() bool {
-			position95, tokenIndex95 := position, tokenIndex
+			position117, tokenIndex117 := position, tokenIndex
 			{
-				position96 := position
+				position118 := position
 				if !_rules[rulebracketNodeIdentifier]() {
-					goto l95
+					goto l117
 				}
-			l97:
+			l119:
 				{
-					position98, tokenIndex98 := position, tokenIndex
+					position120, tokenIndex120 := position, tokenIndex
 					if !_rules[rulesep]() {
-						goto l98
+						goto l120
 					}
 					if !_rules[rulebracketNodeIdentifier]() {
-						goto l98
+						goto l120
 					}
-					if !_rules[ruleAction11]() {
-						goto l98
+					if !_rules[ruleAction13]() {
+						goto l120
 					}
-					goto l97
-				l98:
-					position, tokenIndex = position98, tokenIndex98
+					goto l119
+				l120:
+					position, tokenIndex = position120, tokenIndex120
 				}
 				{
-					position99, tokenIndex99 := position, tokenIndex
+					position121, tokenIndex121 := position, tokenIndex
 					if !_rules[rulesep]() {
-						goto l99
+						goto l121
 					}
-					goto l95
-				l99:
-					position, tokenIndex = position99, tokenIndex99
+					goto l117
+				l121:
+					position, tokenIndex = position121, tokenIndex121
 				}
-				add(rulebracketChildIdentifier, position96)
+				add(rulebracketChildIdentifier, position118)
 			}
 			return true
-		l95:
-			position, tokenIndex = position95, tokenIndex95
+		l117:
+			position, tokenIndex = position117, tokenIndex117
 			return false
 		},
-		/* 16 bracketNodeIdentifier <- <(wildcardIdentifier / singleQuotedNodeIdentifier / doubleQuotedNodeIdentifier)> */
+		/* 16 bracketNodeIdentifier <- <(<(wildcardIdentifier / singleQuotedNodeIdentifier / doubleQuotedNodeIdentifier)> Action14)> */
 		func() bool {
-			position100, tokenIndex100 := position, tokenIndex
+			position122, tokenIndex122 := position, tokenIndex
 			{
-				position101 := position
+				position123 := position
 				{
-					position102, tokenIndex102 := position, tokenIndex
-					if !_rules[rulewildcardIdentifier]() {
-						goto l103
-					}
-					goto l102
-				l103:
-					position, tokenIndex = position102, tokenIndex102
-					if !_rules[rulesingleQuotedNodeIdentifier]() {
-						goto l104
-					}
-					goto l102
-				l104:
-					position, tokenIndex = position102, tokenIndex102
-					if !_rules[ruledoubleQuotedNodeIdentifier]() {
-						goto l100
+					position124 := position
+					{
+						position125, tokenIndex125 := position, tokenIndex
+						if !_rules[rulewildcardIdentifier]() {
+							goto l126
+						}
+						goto l125
+					l126:
+						position, tokenIndex = position125, tokenIndex125
+						if !_rules[rulesingleQuotedNodeIdentifier]() {
+							goto l127
+						}
+						goto l125
+					l127:
+						position, tokenIndex = position125, tokenIndex125
+						if !_rules[ruledoubleQuotedNodeIdentifier]() {
+							goto l122
+						}
 					}
+				l125:
+					add(rulePegText, position124)
 				}
-			l102:
-				add(rulebracketNodeIdentifier, position101)
+				if !_rules[ruleAction14]() {
+					goto l122
+				}
+				add(rulebracketNodeIdentifier, position123)
 			}
 			return true
-		l100:
-			position, tokenIndex = position100, tokenIndex100
+		l122:
+			position, tokenIndex = position122, tokenIndex122
 			return false
 		},
-		/* 17 wildcardIdentifier <- <('*' Action12)> */
+		/* 17 wildcardIdentifier <- <('*' Action15)> */
 		func() bool {
-			position105, tokenIndex105 := position, tokenIndex
+			position128, tokenIndex128 := position, tokenIndex
 			{
-				position106 := position
+				position129 := position
 				if buffer[position] != rune('*') {
-					goto l105
+					goto l128
 				}
 				position++
-				if !_rules[ruleAction12]() {
-					goto l105
+				if !_rules[ruleAction15]() {
+					goto l128
 				}
-				add(rulewildcardIdentifier, position106)
+				add(rulewildcardIdentifier, position129)
 			}
 			return true
-		l105:
-			position, tokenIndex = position105, tokenIndex105
+		l128:
+			position, tokenIndex = position128, tokenIndex128
 			return false
 		},
-		/* 18 singleQuotedNodeIdentifier <- <('\'' <(('\\' ('\'' / '/' / '\\' / 'b' / 'f' / 'n' / 'r' / 't' / hexDigits)) / (!('\'' / '\\') .))*> '\'' Action13)> */
+		/* 18 singleQuotedNodeIdentifier <- <('\'' <singleQuotedString> '\'' Action16)> */
 		func() bool {
-			position107, tokenIndex107 := position, tokenIndex
+			position130, tokenIndex130 := position, tokenIndex
 			{
-				position108 := position
+				position131 := position
+				if buffer[position] != rune('\'') {
+					goto l130
+				}
+				position++
+				{
+					position132 := position
+					if !_rules[rulesingleQuotedString]() {
+						goto l130
+					}
+					add(rulePegText, position132)
+				}
 				if buffer[position] != rune('\'') {
-					goto l107
+					goto l130
+				}
+				position++
+				if !_rules[ruleAction16]() {
+					goto l130
+				}
+				add(rulesingleQuotedNodeIdentifier, position131)
+			}
+			return true
+		l130:
+			position, tokenIndex = position130, tokenIndex130
+			return false
+		},
+		/* 19 doubleQuotedNodeIdentifier <- <('"' <doubleQuotedString> '"' Action17)> */
+		func() bool {
+			position133, tokenIndex133 := position, tokenIndex
+			{
+				position134 := position
+				if buffer[position] != rune('"') {
+					goto l133
+				}
+				position++
+				{
+					position135 := position
+					if !_rules[ruledoubleQuotedString]() {
+						goto l133
+					}
+					add(rulePegText, position135)
+				}
+				if buffer[position] != rune('"') {
+					goto l133
 				}
 				position++
+				if !_rules[ruleAction17]() {
+					goto l133
+				}
+				add(ruledoubleQuotedNodeIdentifier, position134)
+			}
+			return true
+		l133:
+			position, tokenIndex = position133, tokenIndex133
+			return false
+		},
+		/* 20 singleQuotedString <- <(('\\' ('\'' / '/' / '\\' / 'b' / 'f' / 'n' / 'r' / 't' / hexDigits)) / ((&{!p.strictMode} / ![\x00-\x1f]) (!('\'' / '\\') .)))*> */
+		func() bool {
+			{
+				position137 := position
+			l138:
 				{
-					position109 := position
-				l110:
+					position139, tokenIndex139 := position, tokenIndex
 					{
-						position111, tokenIndex111 := position, tokenIndex
+						position140, tokenIndex140 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							goto l141
+						}
+						position++
 						{
-							position112, tokenIndex112 := position, tokenIndex
+							position142, tokenIndex142 := position, tokenIndex
+							if buffer[position] != rune('\'') {
+								goto l143
+							}
+							position++
+							goto l142
+						l143:
+							position, tokenIndex = position142, tokenIndex142
+							if buffer[position] != rune('/') {
+								goto l144
+							}
+							position++
+							goto l142
+						l144:
+							position, tokenIndex = position142, tokenIndex142
 							if buffer[position] != rune('\\') {
-								goto l113
+								goto l145
+							}
+							position++
+							goto l142
+						l145:
+							position, tokenIndex = position142, tokenIndex142
+							if buffer[position] != rune('b') {
+								goto l146
+							}
+							position++
+							goto l142
+						l146:
+							position, tokenIndex = position142, tokenIndex142
+							if buffer[position] != rune('f') {
+								goto l147
+							}
+							position++
+							goto l142
+						l147:
+							position, tokenIndex = position142, tokenIndex142
+							if buffer[position] != rune('n') {
+								goto l148
+							}
+							position++
+							goto l142
+						l148:
+							position, tokenIndex = position142, tokenIndex142
+							if buffer[position] != rune('r') {
+								goto l149
+							}
+							position++
+							goto l142
+						l149:
+							position, tokenIndex = position142, tokenIndex142
+							if buffer[position] != rune('t') {
+								goto l150
 							}
 							position++
+							goto l142
+						l150:
+							position, tokenIndex = position142, tokenIndex142
+							if !_rules[rulehexDigits]() {
+								goto l141
+							}
+						}
+					l142:
+						goto l140
+					l141:
+						position, tokenIndex = position140, tokenIndex140
+						{
+							position151, tokenIndex151 := position, tokenIndex
+							if !(!p.strictMode) {
+								goto l152
+							}
+							goto l151
+						l152:
+							position, tokenIndex = position151, tokenIndex151
 							{
-								position114, tokenIndex114 := position, tokenIndex
-								if buffer[position] != rune('\'') {
-									goto l115
+								position153, tokenIndex153 := position, tokenIndex
+								if c := buffer[position]; c < rune('\x00') || c > rune('\x1f') {
+									goto l153
 								}
 								position++
-								goto l114
-							l115:
-								position, tokenIndex = position114, tokenIndex114
-								if buffer[position] != rune('/') {
-									goto l116
+								goto l139
+							l153:
+								position, tokenIndex = position153, tokenIndex153
+							}
+						}
+					l151:
+						{
+							position154, tokenIndex154 := position, tokenIndex
+							{
+								position155, tokenIndex155 := position, tokenIndex
+								if buffer[position] != rune('\'') {
+									goto l156
 								}
 								position++
-								goto l114
-							l116:
-								position, tokenIndex = position114, tokenIndex114
+								goto l155
+							l156:
+								position, tokenIndex = position155, tokenIndex155
 								if buffer[position] != rune('\\') {
-									goto l117
-								}
-								position++
-								goto l114
-							l117:
-								position, tokenIndex = position114, tokenIndex114
-								if buffer[position] != rune('b') {
-									goto l118
-								}
-								position++
-								goto l114
-							l118:
-								position, tokenIndex = position114, tokenIndex114
-								if buffer[position] != rune('f') {
-									goto l119
-								}
-								position++
-								goto l114
-							l119:
-								position, tokenIndex = position114, tokenIndex114
-								if buffer[position] != rune('n') {
-									goto l120
-								}
-								position++
-								goto l114
-							l120:
-								position, tokenIndex = position114, tokenIndex114
-								if buffer[position] != rune('r') {
-									goto l121
-								}
-								position++
-								goto l114
-							l121:
-								position, tokenIndex = position114, tokenIndex114
-								if buffer[position] != rune('t') {
-									goto l122
+									goto l154
 								}
 								position++
-								goto l114
-							l122:
-								position, tokenIndex = position114, tokenIndex114
-								if !_rules[rulehexDigits]() {
-									goto l113
-								}
-							}
-						l114:
-							goto l112
-						l113:
-							position, tokenIndex = position112, tokenIndex112
-							{
-								position123, tokenIndex123 := position, tokenIndex
-								{
-									position124, tokenIndex124 := position, tokenIndex
-									if buffer[position] != rune('\'') {
-										goto l125
-									}
-									position++
-									goto l124
-								l125:
-									position, tokenIndex = position124, tokenIndex124
-									if buffer[position] != rune('\\') {
-										goto l123
-									}
-									position++
-								}
-							l124:
-								goto l111
-							l123:
-								position, tokenIndex = position123, tokenIndex123
-							}
-							if !matchDot() {
-								goto l111
 							}
+						l155:
+							goto l139
+						l154:
+							position, tokenIndex = position154, tokenIndex154
+						}
+						if !matchDot() {
+							goto l139
 						}
-					l112:
-						goto l110
-					l111:
-						position, tokenIndex = position111, tokenIndex111
 					}
-					add(rulePegText, position109)
+				l140:
+					goto l138
+				l139:
+					position, tokenIndex = position139, tokenIndex139
 				}
-				if buffer[position] != rune('\'') {
-					goto l107
-				}
-				position++
-				if !_rules[ruleAction13]() {
-					goto l107
-				}
-				add(rulesingleQuotedNodeIdentifier, position108)
+				add(rulesingleQuotedString, position137)
 			}
 			return true
-		l107:
-			position, tokenIndex = position107, tokenIndex107
-			return false
 		},
-		/* 19 doubleQuotedNodeIdentifier <- <('"' <(('\\' ('"' / '/' / '\\' / 'b' / 'f' / 'n' / 'r' / 't' / hexDigits)) / (!('"' / '\\') .))*> '"' Action14)> */
+		/* 21 doubleQuotedString <- <(('\\' ('"' / '/' / '\\' / 'b' / 'f' / 'n' / 'r' / 't' / hexDigits)) / ((&{!p.strictMode} / ![\x00-\x1f]) (!('"' / '\\') .)))*> */
 		func() bool {
-			position126, tokenIndex126 := position, tokenIndex
 			{
-				position127 := position
-				if buffer[position] != rune('"') {
-					goto l126
-				}
-				position++
+				position158 := position
+			l159:
 				{
-					position128 := position
-				l129:
+					position160, tokenIndex160 := position, tokenIndex
 					{
-						position130, tokenIndex130 := position, tokenIndex
+						position161, tokenIndex161 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							goto l162
+						}
+						position++
 						{
-							position131, tokenIndex131 := position, tokenIndex
+							position163, tokenIndex163 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l164
+							}
+							position++
+							goto l163
+						l164:
+							position, tokenIndex = position163, tokenIndex163
+							if buffer[position] != rune('/') {
+								goto l165
+							}
+							position++
+							goto l163
+						l165:
+							position, tokenIndex = position163, tokenIndex163
 							if buffer[position] != rune('\\') {
-								goto l132
+								goto l166
+							}
+							position++
+							goto l163
+						l166:
+							position, tokenIndex = position163, tokenIndex163
+							if buffer[position] != rune('b') {
+								goto l167
+							}
+							position++
+							goto l163
+						l167:
+							position, tokenIndex = position163, tokenIndex163
+							if buffer[position] != rune('f') {
+								goto l168
+							}
+							position++
+							goto l163
+						l168:
+							position, tokenIndex = position163, tokenIndex163
+							if buffer[position] != rune('n') {
+								goto l169
+							}
+							position++
+							goto l163
+						l169:
+							position, tokenIndex = position163, tokenIndex163
+							if buffer[position] != rune('r') {
+								goto l170
+							}
+							position++
+							goto l163
+						l170:
+							position, tokenIndex = position163, tokenIndex163
+							if buffer[position] != rune('t') {
+								goto l171
 							}
 							position++
+							goto l163
+						l171:
+							position, tokenIndex = position163, tokenIndex163
+							if !_rules[rulehexDigits]() {
+								goto l162
+							}
+						}
+					l163:
+						goto l161
+					l162:
+						position, tokenIndex = position161, tokenIndex161
+						{
+							position172, tokenIndex172 := position, tokenIndex
+							if !(!p.strictMode) {
+								goto l173
+							}
+							goto l172
+						l173:
+							position, tokenIndex = position172, tokenIndex172
 							{
-								position133, tokenIndex133 := position, tokenIndex
-								if buffer[position] != rune('"') {
-									goto l134
+								position174, tokenIndex174 := position, tokenIndex
+								if c := buffer[position]; c < rune('\x00') || c > rune('\x1f') {
+									goto l174
 								}
 								position++
-								goto l133
-							l134:
-								position, tokenIndex = position133, tokenIndex133
-								if buffer[position] != rune('/') {
-									goto l135
+								goto l160
+							l174:
+								position, tokenIndex = position174, tokenIndex174
+							}
+						}
+					l172:
+						{
+							position175, tokenIndex175 := position, tokenIndex
+							{
+								position176, tokenIndex176 := position, tokenIndex
+								if buffer[position] != rune('"') {
+									goto l177
 								}
 								position++
-								goto l133
-							l135:
-								position, tokenIndex = position133, tokenIndex133
+								goto l176
+							l177:
+								position, tokenIndex = position176, tokenIndex176
 								if buffer[position] != rune('\\') {
-									goto l136
+									goto l175
 								}
 								position++
-								goto l133
-							l136:
-								position, tokenIndex = position133, tokenIndex133
-								if buffer[position] != rune('b') {
-									goto l137
-								}
-								position++
-								goto l133
-							l137:
-								position, tokenIndex = position133, tokenIndex133
-								if buffer[position] != rune('f') {
-									goto l138
-								}
-								position++
-								goto l133
-							l138:
-								position, tokenIndex = position133, tokenIndex133
-								if buffer[position] != rune('n') {
-									goto l139
-								}
-								position++
-								goto l133
-							l139:
-								position, tokenIndex = position133, tokenIndex133
-								if buffer[position] != rune('r') {
-									goto l140
-								}
-								position++
-								goto l133
-							l140:
-								position, tokenIndex = position133, tokenIndex133
-								if buffer[position] != rune('t') {
-									goto l141
-								}
-								position++
-								goto l133
-							l141:
-								position, tokenIndex = position133, tokenIndex133
-								if !_rules[rulehexDigits]() {
-									goto l132
-								}
-							}
-						l133:
-							goto l131
-						l132:
-							position, tokenIndex = position131, tokenIndex131
-							{
-								position142, tokenIndex142 := position, tokenIndex
-								{
-									position143, tokenIndex143 := position, tokenIndex
-									if buffer[position] != rune('"') {
-										goto l144
-									}
-									position++
-									goto l143
-								l144:
-									position, tokenIndex = position143, tokenIndex143
-									if buffer[position] != rune('\\') {
-										goto l142
-									}
-									position++
-								}
-							l143:
-								goto l130
-							l142:
-								position, tokenIndex = position142, tokenIndex142
-							}
-							if !matchDot() {
-								goto l130
 							}
+						l176:
+							goto l160
+						l175:
+							position, tokenIndex = position175, tokenIndex175
+						}
+						if !matchDot() {
+							goto l160
 						}
-					l131:
-						goto l129
-					l130:
-						position, tokenIndex = position130, tokenIndex130
 					}
-					add(rulePegText, position128)
-				}
-				if buffer[position] != rune('"') {
-					goto l126
-				}
-				position++
-				if !_rules[ruleAction14]() {
-					goto l126
+				l161:
+					goto l159
+				l160:
+					position, tokenIndex = position160, tokenIndex160
 				}
-				add(ruledoubleQuotedNodeIdentifier, position127)
+				add(ruledoubleQuotedString, position158)
 			}
 			return true
-		l126:
-			position, tokenIndex = position126, tokenIndex126
-			return false
 		},
-		/* 20 hexDigits <- <('u' hexDigit hexDigit hexDigit hexDigit)> */
+		/* 22 hexDigits <- <('u' hexDigit hexDigit hexDigit hexDigit)> */
 		func() bool {
-			position145, tokenIndex145 := position, tokenIndex
+			position178, tokenIndex178 := position, tokenIndex
 			{
-				position146 := position
+				position179 := position
 				if buffer[position] != rune('u') {
-					goto l145
+					goto l178
 				}
 				position++
 				if !_rules[rulehexDigit]() {
-					goto l145
+					goto l178
 				}
 				if !_rules[rulehexDigit]() {
-					goto l145
+					goto l178
 				}
 				if !_rules[rulehexDigit]() {
-					goto l145
+					goto l178
 				}
 				if !_rules[rulehexDigit]() {
-					goto l145
+					goto l178
 				}
-				add(rulehexDigits, position146)
+				add(rulehexDigits, position179)
 			}
 			return true
-		l145:
-			position, tokenIndex = position145, tokenIndex145
+		l178:
+			position, tokenIndex = position178, tokenIndex178
 			return false
 		},
-		/* 21 hexDigit <- <([a-f] / [A-F] / [0-9])> */
+		/* 23 hexDigit <- <([a-f] / [A-F] / [0-9])> */
 		func() bool {
-			position147, tokenIndex147 := position, tokenIndex
+			position180, tokenIndex180 := position, tokenIndex
 			{
-				position148 := position
+				position181 := position
 				{
-					position149, tokenIndex149 := position, tokenIndex
+					position182, tokenIndex182 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('f') {
-						goto l150
+						goto l183
 					}
 					position++
-					goto l149
-				l150:
-					position, tokenIndex = position149, tokenIndex149
+					goto l182
+				l183:
+					position, tokenIndex = position182, tokenIndex182
 					if c := buffer[position]; c < rune('A') || c > rune('F') {
-						goto l151
+						goto l184
 					}
 					position++
-					goto l149
-				l151:
-					position, tokenIndex = position149, tokenIndex149
+					goto l182
+				l184:
+					position, tokenIndex = position182, tokenIndex182
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l147
+						goto l180
 					}
 					position++
 				}
-			l149:
-				add(rulehexDigit, position148)
+			l182:
+				add(rulehexDigit, position181)
 			}
 			return true
-		l147:
-			position, tokenIndex = position147, tokenIndex147
+		l180:
+			position, tokenIndex = position180, tokenIndex180
 			return false
 		},
-		/* 22 qualifier <- <(union / script / filter)> */
+		/* 24 qualifier <- <(union / script / filter)> */
 		func() bool {
-			position152, tokenIndex152 := position, tokenIndex
+			position185, tokenIndex185 := position, tokenIndex
 			{
-				position153 := position
+				position186 := position
 				{
-					position154, tokenIndex154 := position, tokenIndex
+					position187, tokenIndex187 := position, tokenIndex
 					if !_rules[ruleunion]() {
-						goto l155
+						goto l188
 					}
-					goto l154
-				l155:
-					position, tokenIndex = position154, tokenIndex154
+					goto l187
+				l188:
+					position, tokenIndex = position187, tokenIndex187
 					if !_rules[rulescript]() {
-						goto l156
+						goto l189
 					}
-					goto l154
-				l156:
-					position, tokenIndex = position154, tokenIndex154
+					goto l187
+				l189:
+					position, tokenIndex = position187, tokenIndex187
 					if !_rules[rulefilter]() {
-						goto l152
+						goto l185
 					}
 				}
-			l154:
-				add(rulequalifier, position153)
+			l187:
+				add(rulequalifier, position186)
 			}
 			return true
-		l152:
-			position, tokenIndex = position152, tokenIndex152
+		l185:
+			position, tokenIndex = position185, tokenIndex185
 			return false
 		},
-		/* 23 union <- <(index (sep index Action15)* !sep)> */
+		/* 25 selectors <- <(selector (sep selector Action18)*)> */
 		func() bool {
-			position157, tokenIndex157 := position, tokenIndex
+			position190, tokenIndex190 := position, tokenIndex
 			{
-				position158 := position
-				if !_rules[ruleindex]() {
-					goto l157
+				position191 := position
+				if !_rules[ruleselector]() {
+					goto l190
 				}
-			l159:
+			l192:
 				{
-					position160, tokenIndex160 := position, tokenIndex
+					position193, tokenIndex193 := position, tokenIndex
 					if !_rules[rulesep]() {
-						goto l160
+						goto l193
 					}
-					if !_rules[ruleindex]() {
-						goto l160
+					if !_rules[ruleselector]() {
+						goto l193
 					}
-					if !_rules[ruleAction15]() {
-						goto l160
+					if !_rules[ruleAction18]() {
+						goto l193
 					}
-					goto l159
-				l160:
-					position, tokenIndex = position160, tokenIndex160
+					goto l192
+				l193:
+					position, tokenIndex = position193, tokenIndex193
 				}
+				add(ruleselectors, position191)
+			}
+			return true
+		l190:
+			position, tokenIndex = position190, tokenIndex190
+			return false
+		},
+		/* 26 selector <- <(bracketNodeIdentifier / index / filter)> */
+		func() bool {
+			position194, tokenIndex194 := position, tokenIndex
+			{
+				position195 := position
 				{
-					position161, tokenIndex161 := position, tokenIndex
-					if !_rules[rulesep]() {
-						goto l161
+					position196, tokenIndex196 := position, tokenIndex
+					if !_rules[rulebracketNodeIdentifier]() {
+						goto l197
+					}
+					goto l196
+				l197:
+					position, tokenIndex = position196, tokenIndex196
+					if !_rules[ruleindex]() {
+						goto l198
+					}
+					goto l196
+				l198:
+					position, tokenIndex = position196, tokenIndex196
+					if !_rules[rulefilter]() {
+						goto l194
 					}
-					goto l157
-				l161:
-					position, tokenIndex = position161, tokenIndex161
 				}
-				add(ruleunion, position158)
+			l196:
+				add(ruleselector, position195)
 			}
 			return true
-		l157:
-			position, tokenIndex = position157, tokenIndex157
+		l194:
+			position, tokenIndex = position194, tokenIndex194
 			return false
 		},
-		/* 24 index <- <(((slice Action16) / (<indexNumber> Action17) / ('*' Action18)) Action19)> */
+		/* 27 union <- <(index (sep index Action19)* !sep)> */
 		func() bool {
-			position162, tokenIndex162 := position, tokenIndex
+			position199, tokenIndex199 := position, tokenIndex
 			{
-				position163 := position
+				position200 := position
+				if !_rules[ruleindex]() {
+					goto l199
+				}
+			l201:
 				{
-					position164, tokenIndex164 := position, tokenIndex
-					if !_rules[ruleslice]() {
-						goto l165
-					}
-					if !_rules[ruleAction16]() {
-						goto l165
+					position202, tokenIndex202 := position, tokenIndex
+					if !_rules[rulesep]() {
+						goto l202
 					}
-					goto l164
-				l165:
-					position, tokenIndex = position164, tokenIndex164
-					{
-						position167 := position
-						if !_rules[ruleindexNumber]() {
-							goto l166
-						}
-						add(rulePegText, position167)
+					if !_rules[ruleindex]() {
+						goto l202
 					}
-					if !_rules[ruleAction17]() {
-						goto l166
+					if !_rules[ruleAction19]() {
+						goto l202
 					}
-					goto l164
-				l166:
-					position, tokenIndex = position164, tokenIndex164
-					if buffer[position] != rune('*') {
-						goto l162
+					goto l201
+				l202:
+					position, tokenIndex = position202, tokenIndex202
+				}
+				{
+					position203, tokenIndex203 := position, tokenIndex
+					if !_rules[rulesep]() {
+						goto l203
 					}
-					position++
-					if !_rules[ruleAction18]() {
-						goto l162
+					goto l199
+				l203:
+					position, tokenIndex = position203, tokenIndex203
+				}
+				add(ruleunion, position200)
+			}
+			return true
+		l199:
+			position, tokenIndex = position199, tokenIndex199
+			return false
+		},
+		/* 28 index <- <(<((slice Action20) / (<indexNumber> Action21) / ('*' Action22))> Action23)> */
+		func() bool {
+			position204, tokenIndex204 := position, tokenIndex
+			{
+				position205 := position
+				{
+					position206 := position
+					{
+						position207, tokenIndex207 := position, tokenIndex
+						if !_rules[ruleslice]() {
+							goto l208
+						}
+						if !_rules[ruleAction20]() {
+							goto l208
+						}
+						goto l207
+					l208:
+						position, tokenIndex = position207, tokenIndex207
+						{
+							position210 := position
+							if !_rules[ruleindexNumber]() {
+								goto l209
+							}
+							add(rulePegText, position210)
+						}
+						if !_rules[ruleAction21]() {
+							goto l209
+						}
+						goto l207
+					l209:
+						position, tokenIndex = position207, tokenIndex207
+						if buffer[position] != rune('*') {
+							goto l204
+						}
+						position++
+						if !_rules[ruleAction22]() {
+							goto l204
+						}
 					}
+				l207:
+					add(rulePegText, position206)
 				}
-			l164:
-				if !_rules[ruleAction19]() {
-					goto l162
+				if !_rules[ruleAction23]() {
+					goto l204
 				}
-				add(ruleindex, position163)
+				add(ruleindex, position205)
 			}
 			return true
-		l162:
-			position, tokenIndex = position162, tokenIndex162
+		l204:
+			position, tokenIndex = position204, tokenIndex204
 			return false
 		},
-		/* 25 slice <- <(anyIndex sepSlice anyIndex ((sepSlice anyIndex) / (space Action20)))> */
+		/* 29 slice <- <(anyIndex sepSlice anyIndex ((sepSlice anyIndex) / (space Action24)))> */
 		func() bool {
-			position168, tokenIndex168 := position, tokenIndex
+			position211, tokenIndex211 := position, tokenIndex
 			{
-				position169 := position
+				position212 := position
 				if !_rules[ruleanyIndex]() {
-					goto l168
+					goto l211
 				}
 				if !_rules[rulesepSlice]() {
-					goto l168
+					goto l211
 				}
 				if !_rules[ruleanyIndex]() {
-					goto l168
+					goto l211
 				}
 				{
-					position170, tokenIndex170 := position, tokenIndex
+					position213, tokenIndex213 := position, tokenIndex
 					if !_rules[rulesepSlice]() {
-						goto l171
+						goto l214
 					}
 					if !_rules[ruleanyIndex]() {
-						goto l171
+						goto l214
 					}
-					goto l170
-				l171:
-					position, tokenIndex = position170, tokenIndex170
+					goto l213
+				l214:
+					position, tokenIndex = position213, tokenIndex213
 					if !_rules[rulespace]() {
-						goto l168
+						goto l211
 					}
-					if !_rules[ruleAction20]() {
-						goto l168
+					if !_rules[ruleAction24]() {
+						goto l211
 					}
 				}
-			l170:
-				add(ruleslice, position169)
+			l213:
+				add(ruleslice, position212)
 			}
 			return true
-		l168:
-			position, tokenIndex = position168, tokenIndex168
+		l211:
+			position, tokenIndex = position211, tokenIndex211
 			return false
 		},
-		/* 26 anyIndex <- <(<indexNumber?> Action21)> */
+		/* 30 anyIndex <- <(<indexNumber?> Action25)> */
 		func() bool {
-			position172, tokenIndex172 := position, tokenIndex
+			position215, tokenIndex215 := position, tokenIndex
 			{
-				position173 := position
+				position216 := position
 				{
-					position174 := position
+					position217 := position
 					{
-						position175, tokenIndex175 := position, tokenIndex
+						position218, tokenIndex218 := position, tokenIndex
 						if !_rules[ruleindexNumber]() {
-							goto l175
+							goto l218
 						}
-						goto l176
-					l175:
-						position, tokenIndex = position175, tokenIndex175
+						goto l219
+					l218:
+						position, tokenIndex = position218, tokenIndex218
 					}
-				l176:
-					add(rulePegText, position174)
+				l219:
+					add(rulePegText, position217)
 				}
-				if !_rules[ruleAction21]() {
-					goto l172
+				if !_rules[ruleAction25]() {
+					goto l215
 				}
-				add(ruleanyIndex, position173)
+				add(ruleanyIndex, position216)
 			}
 			return true
-		l172:
-			position, tokenIndex = position172, tokenIndex172
+		l215:
+			position, tokenIndex = position215, tokenIndex215
 			return false
 		},
-		/* 27 indexNumber <- <(('-' / '+')? [0-9]+)> */
+		/* 31 indexNumber <- <((&{p.strictMode} ('0' / ('-'? [1-9] [0-9]*))) / (&{!p.strictMode} ('-' / '+')? [0-9]+))> */
 		func() bool {
-			position177, tokenIndex177 := position, tokenIndex
+			position220, tokenIndex220 := position, tokenIndex
 			{
-				position178 := position
+				position221 := position
 				{
-					position179, tokenIndex179 := position, tokenIndex
+					position222, tokenIndex222 := position, tokenIndex
+					if !(p.strictMode) {
+						goto l223
+					}
 					{
-						position181, tokenIndex181 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l182
+						position224, tokenIndex224 := position, tokenIndex
+						if buffer[position] != rune('0') {
+							goto l225
 						}
 						position++
-						goto l181
-					l182:
-						position, tokenIndex = position181, tokenIndex181
-						if buffer[position] != rune('+') {
-							goto l179
+						goto l224
+					l225:
+						position, tokenIndex = position224, tokenIndex224
+						{
+							position226, tokenIndex226 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l226
+							}
+							position++
+							goto l227
+						l226:
+							position, tokenIndex = position226, tokenIndex226
+						}
+					l227:
+						if c := buffer[position]; c < rune('1') || c > rune('9') {
+							goto l223
 						}
 						position++
+					l228:
+						{
+							position229, tokenIndex229 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l229
+							}
+							position++
+							goto l228
+						l229:
+							position, tokenIndex = position229, tokenIndex229
+						}
 					}
-				l181:
-					goto l180
-				l179:
-					position, tokenIndex = position179, tokenIndex179
-				}
-			l180:
-				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l177
-				}
-				position++
-			l183:
-				{
-					position184, tokenIndex184 := position, tokenIndex
+				l224:
+					goto l222
+				l223:
+					position, tokenIndex = position222, tokenIndex222
+					if !(!p.strictMode) {
+						goto l220
+					}
+					{
+						position230, tokenIndex230 := position, tokenIndex
+						{
+							position232, tokenIndex232 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l233
+							}
+							position++
+							goto l232
+						l233:
+							position, tokenIndex = position232, tokenIndex232
+							if buffer[position] != rune('+') {
+								goto l230
+							}
+							position++
+						}
+					l232:
+						goto l231
+					l230:
+						position, tokenIndex = position230, tokenIndex230
+					}
+				l231:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l184
+						goto l220
 					}
 					position++
-					goto l183
-				l184:
-					position, tokenIndex = position184, tokenIndex184
+				l234:
+					{
+						position235, tokenIndex235 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l235
+						}
+						position++
+						goto l234
+					l235:
+						position, tokenIndex = position235, tokenIndex235
+					}
 				}
-				add(ruleindexNumber, position178)
+			l222:
+				add(ruleindexNumber, position221)
 			}
 			return true
-		l177:
-			position, tokenIndex = position177, tokenIndex177
+		l220:
+			position, tokenIndex = position220, tokenIndex220
 			return false
 		},
-		/* 28 sep <- <(space ',' space)> */
+		/* 32 sep <- <(space ',' space)> */
 		func() bool {
-			position185, tokenIndex185 := position, tokenIndex
+			position236, tokenIndex236 := position, tokenIndex
 			{
-				position186 := position
+				position237 := position
 				if !_rules[rulespace]() {
-					goto l185
+					goto l236
 				}
 				if buffer[position] != rune(',') {
-					goto l185
+					goto l236
 				}
 				position++
 				if !_rules[rulespace]() {
-					goto l185
+					goto l236
 				}
-				add(rulesep, position186)
+				add(rulesep, position237)
 			}
 			return true
-		l185:
-			position, tokenIndex = position185, tokenIndex185
+		l236:
+			position, tokenIndex = position236, tokenIndex236
 			return false
 		},
-		/* 29 sepSlice <- <(space ':' space)> */
+		/* 33 sepSlice <- <(space ':' space)> */
 		func() bool {
-			position187, tokenIndex187 := position, tokenIndex
+			position238, tokenIndex238 := position, tokenIndex
 			{
-				position188 := position
+				position239 := position
 				if !_rules[rulespace]() {
-					goto l187
+					goto l238
 				}
 				if buffer[position] != rune(':') {
-					goto l187
+					goto l238
 				}
 				position++
 				if !_rules[rulespace]() {
-					goto l187
+					goto l238
 				}
-				add(rulesepSlice, position188)
+				add(rulesepSlice, position239)
 			}
 			return true
-		l187:
-			position, tokenIndex = position187, tokenIndex187
+		l238:
+			position, tokenIndex = position238, tokenIndex238
 			return false
 		},
-		/* 30 script <- <(scriptStart <command> scriptEnd Action22)> */
+		/* 34 script <- <((scriptStart scriptExpression scriptEnd Action26) / (scriptStart <command> scriptEnd Action27))> */
 		func() bool {
-			position189, tokenIndex189 := position, tokenIndex
+			position240, tokenIndex240 := position, tokenIndex
 			{
-				position190 := position
-				if !_rules[rulescriptStart]() {
-					goto l189
-				}
+				position241 := position
 				{
-					position191 := position
-					if !_rules[rulecommand]() {
-						goto l189
+					position242, tokenIndex242 := position, tokenIndex
+					if !_rules[rulescriptStart]() {
+						goto l243
+					}
+					if !_rules[rulescriptExpression]() {
+						goto l243
+					}
+					if !_rules[rulescriptEnd]() {
+						goto l243
+					}
+					if !_rules[ruleAction26]() {
+						goto l243
+					}
+					goto l242
+				l243:
+					position, tokenIndex = position242, tokenIndex242
+					if !_rules[rulescriptStart]() {
+						goto l240
+					}
+					{
+						position244 := position
+						if !_rules[rulecommand]() {
+							goto l240
+						}
+						add(rulePegText, position244)
+					}
+					if !_rules[rulescriptEnd]() {
+						goto l240
+					}
+					if !_rules[ruleAction27]() {
+						goto l240
 					}
-					add(rulePegText, position191)
-				}
-				if !_rules[rulescriptEnd]() {
-					goto l189
-				}
-				if !_rules[ruleAction22]() {
-					goto l189
 				}
-				add(rulescript, position190)
+			l242:
+				add(rulescript, position241)
 			}
 			return true
-		l189:
-			position, tokenIndex = position189, tokenIndex189
+		l240:
+			position, tokenIndex = position240, tokenIndex240
 			return false
 		},
-		/* 31 command <- <(!')' .)+> */
+		/* 35 command <- <(('(' command? ')') / (!('(' / ')') .))+> */
 		func() bool {
-			position192, tokenIndex192 := position, tokenIndex
+			position245, tokenIndex245 := position, tokenIndex
 			{
-				position193 := position
+				position246 := position
 				{
-					position196, tokenIndex196 := position, tokenIndex
+					position249, tokenIndex249 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l250
+					}
+					position++
+					{
+						position251, tokenIndex251 := position, tokenIndex
+						if !_rules[rulecommand]() {
+							goto l251
+						}
+						goto l252
+					l251:
+						position, tokenIndex = position251, tokenIndex251
+					}
+				l252:
 					if buffer[position] != rune(')') {
-						goto l196
+						goto l250
 					}
 					position++
-					goto l192
-				l196:
-					position, tokenIndex = position196, tokenIndex196
-				}
-				if !matchDot() {
-					goto l192
-				}
-			l194:
-				{
-					position195, tokenIndex195 := position, tokenIndex
+					goto l249
+				l250:
+					position, tokenIndex = position249, tokenIndex249
 					{
-						position197, tokenIndex197 := position, tokenIndex
-						if buffer[position] != rune(')') {
-							goto l197
+						position253, tokenIndex253 := position, tokenIndex
+						{
+							position254, tokenIndex254 := position, tokenIndex
+							if buffer[position] != rune('(') {
+								goto l255
+							}
+							position++
+							goto l254
+						l255:
+							position, tokenIndex = position254, tokenIndex254
+							if buffer[position] != rune(')') {
+								goto l253
+							}
+							position++
 						}
-						position++
-						goto l195
-					l197:
-						position, tokenIndex = position197, tokenIndex197
+					l254:
+						goto l245
+					l253:
+						position, tokenIndex = position253, tokenIndex253
 					}
 					if !matchDot() {
-						goto l195
+						goto l245
 					}
-					goto l194
-				l195:
-					position, tokenIndex = position195, tokenIndex195
-				}
-				add(rulecommand, position193)
-			}
-			return true
-		l192:
-			position, tokenIndex = position192, tokenIndex192
-			return false
-		},
-		/* 32 filter <- <(filterStart query filterEnd Action23)> */
-		func() bool {
-			position198, tokenIndex198 := position, tokenIndex
-			{
-				position199 := position
-				if !_rules[rulefilterStart]() {
-					goto l198
-				}
-				if !_rules[rulequery]() {
-					goto l198
-				}
-				if !_rules[rulefilterEnd]() {
-					goto l198
-				}
-				if !_rules[ruleAction23]() {
-					goto l198
-				}
-				add(rulefilter, position199)
-			}
-			return true
-		l198:
-			position, tokenIndex = position198, tokenIndex198
-			return false
-		},
-		/* 33 query <- <(andQuery (logicOr andQuery Action24)*)> */
-		func() bool {
-			position200, tokenIndex200 := position, tokenIndex
-			{
-				position201 := position
-				if !_rules[ruleandQuery]() {
-					goto l200
 				}
-			l202:
+			l249:
+			l247:
 				{
-					position203, tokenIndex203 := position, tokenIndex
-					if !_rules[rulelogicOr]() {
-						goto l203
-					}
-					if !_rules[ruleandQuery]() {
-						goto l203
-					}
-					if !_rules[ruleAction24]() {
-						goto l203
+					position248, tokenIndex248 := position, tokenIndex
+					{
+						position256, tokenIndex256 := position, tokenIndex
+						if buffer[position] != rune('(') {
+							goto l257
+						}
+						position++
+						{
+							position258, tokenIndex258 := position, tokenIndex
+							if !_rules[rulecommand]() {
+								goto l258
+							}
+							goto l259
+						l258:
+							position, tokenIndex = position258, tokenIndex258
+						}
+					l259:
+						if buffer[position] != rune(')') {
+							goto l257
+						}
+						position++
+						goto l256
+					l257:
+						position, tokenIndex = position256, tokenIndex256
+						{
+							position260, tokenIndex260 := position, tokenIndex
+							{
+								position261, tokenIndex261 := position, tokenIndex
+								if buffer[position] != rune('(') {
+									goto l262
+								}
+								position++
+								goto l261
+							l262:
+								position, tokenIndex = position261, tokenIndex261
+								if buffer[position] != rune(')') {
+									goto l260
+								}
+								position++
+							}
+						l261:
+							goto l248
+						l260:
+							position, tokenIndex = position260, tokenIndex260
+						}
+						if !matchDot() {
+							goto l248
+						}
 					}
-					goto l202
-				l203:
-					position, tokenIndex = position203, tokenIndex203
+				l256:
+					goto l247
+				l248:
+					position, tokenIndex = position248, tokenIndex248
 				}
-				add(rulequery, position201)
+				add(rulecommand, position246)
 			}
 			return true
-		l200:
-			position, tokenIndex = position200, tokenIndex200
+		l245:
+			position, tokenIndex = position245, tokenIndex245
 			return false
 		},
-		/* 34 andQuery <- <(basicQuery (logicAnd basicQuery Action25)*)> */
+		/* 36 scriptExpression <- <(scriptTerm ((space '+' space scriptTerm Action28) / (space '-' space scriptTerm Action29))*)> */
 		func() bool {
-			position204, tokenIndex204 := position, tokenIndex
+			position263, tokenIndex263 := position, tokenIndex
 			{
-				position205 := position
-				if !_rules[rulebasicQuery]() {
-					goto l204
+				position264 := position
+				if !_rules[rulescriptTerm]() {
+					goto l263
 				}
-			l206:
+			l265:
 				{
-					position207, tokenIndex207 := position, tokenIndex
-					if !_rules[rulelogicAnd]() {
-						goto l207
-					}
-					if !_rules[rulebasicQuery]() {
-						goto l207
-					}
-					if !_rules[ruleAction25]() {
-						goto l207
+					position266, tokenIndex266 := position, tokenIndex
+					{
+						position267, tokenIndex267 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l268
+						}
+						if buffer[position] != rune('+') {
+							goto l268
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l268
+						}
+						if !_rules[rulescriptTerm]() {
+							goto l268
+						}
+						if !_rules[ruleAction28]() {
+							goto l268
+						}
+						goto l267
+					l268:
+						position, tokenIndex = position267, tokenIndex267
+						if !_rules[rulespace]() {
+							goto l266
+						}
+						if buffer[position] != rune('-') {
+							goto l266
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l266
+						}
+						if !_rules[rulescriptTerm]() {
+							goto l266
+						}
+						if !_rules[ruleAction29]() {
+							goto l266
+						}
 					}
-					goto l206
-				l207:
-					position, tokenIndex = position207, tokenIndex207
+				l267:
+					goto l265
+				l266:
+					position, tokenIndex = position266, tokenIndex266
 				}
-				add(ruleandQuery, position205)
+				add(rulescriptExpression, position264)
 			}
 			return true
-		l204:
-			position, tokenIndex = position204, tokenIndex204
+		l263:
+			position, tokenIndex = position263, tokenIndex263
 			return false
 		},
-		/* 35 basicQuery <- <((subQueryStart query subQueryEnd) / (<comparator> Action26) / (<(logicNot? jsonpathFilter)> Action27))> */
+		/* 37 scriptTerm <- <(scriptFactor ((space '*' space scriptFactor Action30) / (space '/' space scriptFactor Action31) / (space '%' space scriptFactor Action32))*)> */
 		func() bool {
-			position208, tokenIndex208 := position, tokenIndex
+			position269, tokenIndex269 := position, tokenIndex
 			{
-				position209 := position
+				position270 := position
+				if !_rules[rulescriptFactor]() {
+					goto l269
+				}
+			l271:
 				{
-					position210, tokenIndex210 := position, tokenIndex
+					position272, tokenIndex272 := position, tokenIndex
+					{
+						position273, tokenIndex273 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l274
+						}
+						if buffer[position] != rune('*') {
+							goto l274
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l274
+						}
+						if !_rules[rulescriptFactor]() {
+							goto l274
+						}
+						if !_rules[ruleAction30]() {
+							goto l274
+						}
+						goto l273
+					l274:
+						position, tokenIndex = position273, tokenIndex273
+						if !_rules[rulespace]() {
+							goto l275
+						}
+						if buffer[position] != rune('/') {
+							goto l275
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l275
+						}
+						if !_rules[rulescriptFactor]() {
+							goto l275
+						}
+						if !_rules[ruleAction31]() {
+							goto l275
+						}
+						goto l273
+					l275:
+						position, tokenIndex = position273, tokenIndex273
+						if !_rules[rulespace]() {
+							goto l272
+						}
+						if buffer[position] != rune('%') {
+							goto l272
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l272
+						}
+						if !_rules[rulescriptFactor]() {
+							goto l272
+						}
+						if !_rules[ruleAction32]() {
+							goto l272
+						}
+					}
+				l273:
+					goto l271
+				l272:
+					position, tokenIndex = position272, tokenIndex272
+				}
+				add(rulescriptTerm, position270)
+			}
+			return true
+		l269:
+			position, tokenIndex = position269, tokenIndex269
+			return false
+		},
+		/* 38 scriptFactor <- <((scriptStart scriptExpression scriptEnd) / (<('-' space scriptFactor)> Action33) / (<scriptNumber> Action34) / (<lString> Action35) / scriptJsonpath)> */
+		func() bool {
+			position276, tokenIndex276 := position, tokenIndex
+			{
+				position277 := position
+				{
+					position278, tokenIndex278 := position, tokenIndex
+					if !_rules[rulescriptStart]() {
+						goto l279
+					}
+					if !_rules[rulescriptExpression]() {
+						goto l279
+					}
+					if !_rules[rulescriptEnd]() {
+						goto l279
+					}
+					goto l278
+				l279:
+					position, tokenIndex = position278, tokenIndex278
+					{
+						position281 := position
+						if buffer[position] != rune('-') {
+							goto l280
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l280
+						}
+						if !_rules[rulescriptFactor]() {
+							goto l280
+						}
+						add(rulePegText, position281)
+					}
+					if !_rules[ruleAction33]() {
+						goto l280
+					}
+					goto l278
+				l280:
+					position, tokenIndex = position278, tokenIndex278
+					{
+						position283 := position
+						if !_rules[rulescriptNumber]() {
+							goto l282
+						}
+						add(rulePegText, position283)
+					}
+					if !_rules[ruleAction34]() {
+						goto l282
+					}
+					goto l278
+				l282:
+					position, tokenIndex = position278, tokenIndex278
+					{
+						position285 := position
+						if !_rules[rulelString]() {
+							goto l284
+						}
+						add(rulePegText, position285)
+					}
+					if !_rules[ruleAction35]() {
+						goto l284
+					}
+					goto l278
+				l284:
+					position, tokenIndex = position278, tokenIndex278
+					if !_rules[rulescriptJsonpath]() {
+						goto l276
+					}
+				}
+			l278:
+				add(rulescriptFactor, position277)
+			}
+			return true
+		l276:
+			position, tokenIndex = position276, tokenIndex276
+			return false
+		},
+		/* 39 scriptNumber <- <(<([0-9]+ ('.' [0-9]+)?)> Action36)> */
+		func() bool {
+			position286, tokenIndex286 := position, tokenIndex
+			{
+				position287 := position
+				{
+					position288 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l286
+					}
+					position++
+				l289:
+					{
+						position290, tokenIndex290 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l290
+						}
+						position++
+						goto l289
+					l290:
+						position, tokenIndex = position290, tokenIndex290
+					}
+					{
+						position291, tokenIndex291 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l291
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l291
+						}
+						position++
+					l293:
+						{
+							position294, tokenIndex294 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l294
+							}
+							position++
+							goto l293
+						l294:
+							position, tokenIndex = position294, tokenIndex294
+						}
+						goto l292
+					l291:
+						position, tokenIndex = position291, tokenIndex291
+					}
+				l292:
+					add(rulePegText, position288)
+				}
+				if !_rules[ruleAction36]() {
+					goto l286
+				}
+				add(rulescriptNumber, position287)
+			}
+			return true
+		l286:
+			position, tokenIndex = position286, tokenIndex286
+			return false
+		},
+		/* 40 scriptJsonpath <- <(<scriptJsonpathParameter> ((scriptLength Action37) / Action38) Action39)> */
+		func() bool {
+			position295, tokenIndex295 := position, tokenIndex
+			{
+				position296 := position
+				{
+					position297 := position
+					if !_rules[rulescriptJsonpathParameter]() {
+						goto l295
+					}
+					add(rulePegText, position297)
+				}
+				{
+					position298, tokenIndex298 := position, tokenIndex
+					if !_rules[rulescriptLength]() {
+						goto l299
+					}
+					if !_rules[ruleAction37]() {
+						goto l299
+					}
+					goto l298
+				l299:
+					position, tokenIndex = position298, tokenIndex298
+					if !_rules[ruleAction38]() {
+						goto l295
+					}
+				}
+			l298:
+				if !_rules[ruleAction39]() {
+					goto l295
+				}
+				add(rulescriptJsonpath, position296)
+			}
+			return true
+		l295:
+			position, tokenIndex = position295, tokenIndex295
+			return false
+		},
+		/* 41 scriptJsonpathParameter <- <(Action40 parameterRootNode (!scriptLength scriptChildNode)* Action41)> */
+		func() bool {
+			position300, tokenIndex300 := position, tokenIndex
+			{
+				position301 := position
+				if !_rules[ruleAction40]() {
+					goto l300
+				}
+				if !_rules[ruleparameterRootNode]() {
+					goto l300
+				}
+			l302:
+				{
+					position303, tokenIndex303 := position, tokenIndex
+					{
+						position304, tokenIndex304 := position, tokenIndex
+						if !_rules[rulescriptLength]() {
+							goto l304
+						}
+						goto l303
+					l304:
+						position, tokenIndex = position304, tokenIndex304
+					}
+					if !_rules[rulescriptChildNode]() {
+						goto l303
+					}
+					goto l302
+				l303:
+					position, tokenIndex = position303, tokenIndex303
+				}
+				if !_rules[ruleAction41]() {
+					goto l300
+				}
+				add(rulescriptJsonpathParameter, position301)
+			}
+			return true
+		l300:
+			position, tokenIndex = position300, tokenIndex300
+			return false
+		},
+		/* 42 scriptChildNode <- <((<('.' '.' (bracketNode / scriptDotChildIdentifier / wildcardIdentifier))> Action42) / (<('.' (scriptDotChildIdentifier / wildcardIdentifier))> Action43) / bracketNode)> */
+		func() bool {
+			position305, tokenIndex305 := position, tokenIndex
+			{
+				position306 := position
+				{
+					position307, tokenIndex307 := position, tokenIndex
+					{
+						position309 := position
+						if buffer[position] != rune('.') {
+							goto l308
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l308
+						}
+						position++
+						{
+							position310, tokenIndex310 := position, tokenIndex
+							if !_rules[rulebracketNode]() {
+								goto l311
+							}
+							goto l310
+						l311:
+							position, tokenIndex = position310, tokenIndex310
+							if !_rules[rulescriptDotChildIdentifier]() {
+								goto l312
+							}
+							goto l310
+						l312:
+							position, tokenIndex = position310, tokenIndex310
+							if !_rules[rulewildcardIdentifier]() {
+								goto l308
+							}
+						}
+					l310:
+						add(rulePegText, position309)
+					}
+					if !_rules[ruleAction42]() {
+						goto l308
+					}
+					goto l307
+				l308:
+					position, tokenIndex = position307, tokenIndex307
+					{
+						position314 := position
+						if buffer[position] != rune('.') {
+							goto l313
+						}
+						position++
+						{
+							position315, tokenIndex315 := position, tokenIndex
+							if !_rules[rulescriptDotChildIdentifier]() {
+								goto l316
+							}
+							goto l315
+						l316:
+							position, tokenIndex = position315, tokenIndex315
+							if !_rules[rulewildcardIdentifier]() {
+								goto l313
+							}
+						}
+					l315:
+						add(rulePegText, position314)
+					}
+					if !_rules[ruleAction43]() {
+						goto l313
+					}
+					goto l307
+				l313:
+					position, tokenIndex = position307, tokenIndex307
+					if !_rules[rulebracketNode]() {
+						goto l305
+					}
+				}
+			l307:
+				add(rulescriptChildNode, position306)
+			}
+			return true
+		l305:
+			position, tokenIndex = position305, tokenIndex305
+			return false
+		},
+		/* 43 scriptDotChildIdentifier <- <(<(('_' / [a-z] / [A-Z]) ('_' / [a-z] / [A-Z] / [0-9])*)> Action44)> */
+		func() bool {
+			position317, tokenIndex317 := position, tokenIndex
+			{
+				position318 := position
+				{
+					position319 := position
+					{
+						position320, tokenIndex320 := position, tokenIndex
+						if buffer[position] != rune('_') {
+							goto l321
+						}
+						position++
+						goto l320
+					l321:
+						position, tokenIndex = position320, tokenIndex320
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l322
+						}
+						position++
+						goto l320
+					l322:
+						position, tokenIndex = position320, tokenIndex320
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l317
+						}
+						position++
+					}
+				l320:
+				l323:
+					{
+						position324, tokenIndex324 := position, tokenIndex
+						{
+							position325, tokenIndex325 := position, tokenIndex
+							if buffer[position] != rune('_') {
+								goto l326
+							}
+							position++
+							goto l325
+						l326:
+							position, tokenIndex = position325, tokenIndex325
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l327
+							}
+							position++
+							goto l325
+						l327:
+							position, tokenIndex = position325, tokenIndex325
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l328
+							}
+							position++
+							goto l325
+						l328:
+							position, tokenIndex = position325, tokenIndex325
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l324
+							}
+							position++
+						}
+					l325:
+						goto l323
+					l324:
+						position, tokenIndex = position324, tokenIndex324
+					}
+					add(rulePegText, position319)
+				}
+				if !_rules[ruleAction44]() {
+					goto l317
+				}
+				add(rulescriptDotChildIdentifier, position318)
+			}
+			return true
+		l317:
+			position, tokenIndex = position317, tokenIndex317
+			return false
+		},
+		/* 44 scriptLength <- <('.' 'l' 'e' 'n' 'g' 't' 'h' !('_' / [a-z] / [A-Z] / [0-9] / '.' / '['))> */
+		func() bool {
+			position329, tokenIndex329 := position, tokenIndex
+			{
+				position330 := position
+				if buffer[position] != rune('.') {
+					goto l329
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l329
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l329
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l329
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l329
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l329
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l329
+				}
+				position++
+				{
+					position331, tokenIndex331 := position, tokenIndex
+					{
+						position332, tokenIndex332 := position, tokenIndex
+						if buffer[position] != rune('_') {
+							goto l333
+						}
+						position++
+						goto l332
+					l333:
+						position, tokenIndex = position332, tokenIndex332
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l334
+						}
+						position++
+						goto l332
+					l334:
+						position, tokenIndex = position332, tokenIndex332
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l335
+						}
+						position++
+						goto l332
+					l335:
+						position, tokenIndex = position332, tokenIndex332
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l336
+						}
+						position++
+						goto l332
+					l336:
+						position, tokenIndex = position332, tokenIndex332
+						if buffer[position] != rune('.') {
+							goto l337
+						}
+						position++
+						goto l332
+					l337:
+						position, tokenIndex = position332, tokenIndex332
+						if buffer[position] != rune('[') {
+							goto l331
+						}
+						position++
+					}
+				l332:
+					goto l329
+				l331:
+					position, tokenIndex = position331, tokenIndex331
+				}
+				add(rulescriptLength, position330)
+			}
+			return true
+		l329:
+			position, tokenIndex = position329, tokenIndex329
+			return false
+		},
+		/* 45 filter <- <(<('?' space query)> Action45)> */
+		func() bool {
+			position338, tokenIndex338 := position, tokenIndex
+			{
+				position339 := position
+				{
+					position340 := position
+					if buffer[position] != rune('?') {
+						goto l338
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l338
+					}
+					if !_rules[rulequery]() {
+						goto l338
+					}
+					add(rulePegText, position340)
+				}
+				if !_rules[ruleAction45]() {
+					goto l338
+				}
+				add(rulefilter, position339)
+			}
+			return true
+		l338:
+			position, tokenIndex = position338, tokenIndex338
+			return false
+		},
+		/* 46 query <- <(andQuery (logicOr andQuery Action46)*)> */
+		func() bool {
+			position341, tokenIndex341 := position, tokenIndex
+			{
+				position342 := position
+				if !_rules[ruleandQuery]() {
+					goto l341
+				}
+			l343:
+				{
+					position344, tokenIndex344 := position, tokenIndex
+					if !_rules[rulelogicOr]() {
+						goto l344
+					}
+					if !_rules[ruleandQuery]() {
+						goto l344
+					}
+					if !_rules[ruleAction46]() {
+						goto l344
+					}
+					goto l343
+				l344:
+					position, tokenIndex = position344, tokenIndex344
+				}
+				add(rulequery, position342)
+			}
+			return true
+		l341:
+			position, tokenIndex = position341, tokenIndex341
+			return false
+		},
+		/* 47 andQuery <- <(basicQuery (logicAnd basicQuery Action47)*)> */
+		func() bool {
+			position345, tokenIndex345 := position, tokenIndex
+			{
+				position346 := position
+				if !_rules[rulebasicQuery]() {
+					goto l345
+				}
+			l347:
+				{
+					position348, tokenIndex348 := position, tokenIndex
+					if !_rules[rulelogicAnd]() {
+						goto l348
+					}
+					if !_rules[rulebasicQuery]() {
+						goto l348
+					}
+					if !_rules[ruleAction47]() {
+						goto l348
+					}
+					goto l347
+				l348:
+					position, tokenIndex = position348, tokenIndex348
+				}
+				add(ruleandQuery, position346)
+			}
+			return true
+		l345:
+			position, tokenIndex = position345, tokenIndex345
+			return false
+		},
+		/* 48 basicQuery <- <((subQueryStart query subQueryEnd) / (&{p.strictMode} <(logicNot subQueryStart query subQueryEnd)> Action48) / (&{p.strictMode} <strictComparator> Action49) / (&{!p.strictMode} <comparator> Action50) / (<(logicNot? logicalFunction)> Action51) / (<(logicNot? jsonpathFilter)> Action52))> */
+		func() bool {
+			position349, tokenIndex349 := position, tokenIndex
+			{
+				position350 := position
+				{
+					position351, tokenIndex351 := position, tokenIndex
 					if !_rules[rulesubQueryStart]() {
-						goto l211
+						goto l352
+					}
+					if !_rules[rulequery]() {
+						goto l352
+					}
+					if !_rules[rulesubQueryEnd]() {
+						goto l352
+					}
+					goto l351
+				l352:
+					position, tokenIndex = position351, tokenIndex351
+					if !(p.strictMode) {
+						goto l353
+					}
+					{
+						position354 := position
+						if !_rules[rulelogicNot]() {
+							goto l353
+						}
+						if !_rules[rulesubQueryStart]() {
+							goto l353
+						}
+						if !_rules[rulequery]() {
+							goto l353
+						}
+						if !_rules[rulesubQueryEnd]() {
+							goto l353
+						}
+						add(rulePegText, position354)
+					}
+					if !_rules[ruleAction48]() {
+						goto l353
 					}
-					if !_rules[rulequery]() {
-						goto l211
+					goto l351
+				l353:
+					position, tokenIndex = position351, tokenIndex351
+					if !(p.strictMode) {
+						goto l355
 					}
-					if !_rules[rulesubQueryEnd]() {
-						goto l211
+					{
+						position356 := position
+						if !_rules[rulestrictComparator]() {
+							goto l355
+						}
+						add(rulePegText, position356)
+					}
+					if !_rules[ruleAction49]() {
+						goto l355
+					}
+					goto l351
+				l355:
+					position, tokenIndex = position351, tokenIndex351
+					if !(!p.strictMode) {
+						goto l357
 					}
-					goto l210
-				l211:
-					position, tokenIndex = position210, tokenIndex210
 					{
-						position213 := position
+						position358 := position
 						if !_rules[rulecomparator]() {
-							goto l212
+							goto l357
 						}
-						add(rulePegText, position213)
+						add(rulePegText, position358)
 					}
-					if !_rules[ruleAction26]() {
-						goto l212
+					if !_rules[ruleAction50]() {
+						goto l357
+					}
+					goto l351
+				l357:
+					position, tokenIndex = position351, tokenIndex351
+					{
+						position360 := position
+						{
+							position361, tokenIndex361 := position, tokenIndex
+							if !_rules[rulelogicNot]() {
+								goto l361
+							}
+							goto l362
+						l361:
+							position, tokenIndex = position361, tokenIndex361
+						}
+					l362:
+						if !_rules[rulelogicalFunction]() {
+							goto l359
+						}
+						add(rulePegText, position360)
 					}
-					goto l210
-				l212:
-					position, tokenIndex = position210, tokenIndex210
+					if !_rules[ruleAction51]() {
+						goto l359
+					}
+					goto l351
+				l359:
+					position, tokenIndex = position351, tokenIndex351
 					{
-						position214 := position
+						position363 := position
 						{
-							position215, tokenIndex215 := position, tokenIndex
+							position364, tokenIndex364 := position, tokenIndex
 							if !_rules[rulelogicNot]() {
-								goto l215
+								goto l364
 							}
-							goto l216
-						l215:
-							position, tokenIndex = position215, tokenIndex215
+							goto l365
+						l364:
+							position, tokenIndex = position364, tokenIndex364
 						}
-					l216:
+					l365:
 						if !_rules[rulejsonpathFilter]() {
-							goto l208
+							goto l349
+						}
+						add(rulePegText, position363)
+					}
+					if !_rules[ruleAction52]() {
+						goto l349
+					}
+				}
+			l351:
+				add(rulebasicQuery, position350)
+			}
+			return true
+		l349:
+			position, tokenIndex = position349, tokenIndex349
+			return false
+		},
+		/* 49 logicOr <- <(space ('|' '|') space)> */
+		func() bool {
+			position366, tokenIndex366 := position, tokenIndex
+			{
+				position367 := position
+				if !_rules[rulespace]() {
+					goto l366
+				}
+				if buffer[position] != rune('|') {
+					goto l366
+				}
+				position++
+				if buffer[position] != rune('|') {
+					goto l366
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l366
+				}
+				add(rulelogicOr, position367)
+			}
+			return true
+		l366:
+			position, tokenIndex = position366, tokenIndex366
+			return false
+		},
+		/* 50 logicAnd <- <(space ('&' '&') space)> */
+		func() bool {
+			position368, tokenIndex368 := position, tokenIndex
+			{
+				position369 := position
+				if !_rules[rulespace]() {
+					goto l368
+				}
+				if buffer[position] != rune('&') {
+					goto l368
+				}
+				position++
+				if buffer[position] != rune('&') {
+					goto l368
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l368
+				}
+				add(rulelogicAnd, position369)
+			}
+			return true
+		l368:
+			position, tokenIndex = position368, tokenIndex368
+			return false
+		},
+		/* 51 logicNot <- <('!' space)> */
+		func() bool {
+			position370, tokenIndex370 := position, tokenIndex
+			{
+				position371 := position
+				if buffer[position] != rune('!') {
+					goto l370
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l370
+				}
+				add(rulelogicNot, position371)
+			}
+			return true
+		l370:
+			position, tokenIndex = position370, tokenIndex370
+			return false
+		},
+		/* 52 comparator <- <((qParam space (('=' '=' space qParam Action53) / ('!' '=' space qParam Action54))) / (qNumericParam space (('<' '=' space qNumericParam Action55) / ('<' space qNumericParam Action56) / ('>' '=' space qNumericParam Action57) / ('>' space qNumericParam Action58))) / (singleJsonpathFilter space ('=' '~') space '/' <regex> '/' Action59))> */
+		func() bool {
+			position372, tokenIndex372 := position, tokenIndex
+			{
+				position373 := position
+				{
+					position374, tokenIndex374 := position, tokenIndex
+					if !_rules[ruleqParam]() {
+						goto l375
+					}
+					if !_rules[rulespace]() {
+						goto l375
+					}
+					{
+						position376, tokenIndex376 := position, tokenIndex
+						if buffer[position] != rune('=') {
+							goto l377
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l377
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l377
+						}
+						if !_rules[ruleqParam]() {
+							goto l377
+						}
+						if !_rules[ruleAction53]() {
+							goto l377
+						}
+						goto l376
+					l377:
+						position, tokenIndex = position376, tokenIndex376
+						if buffer[position] != rune('!') {
+							goto l375
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l375
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l375
+						}
+						if !_rules[ruleqParam]() {
+							goto l375
+						}
+						if !_rules[ruleAction54]() {
+							goto l375
+						}
+					}
+				l376:
+					goto l374
+				l375:
+					position, tokenIndex = position374, tokenIndex374
+					if !_rules[ruleqNumericParam]() {
+						goto l378
+					}
+					if !_rules[rulespace]() {
+						goto l378
+					}
+					{
+						position379, tokenIndex379 := position, tokenIndex
+						if buffer[position] != rune('<') {
+							goto l380
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l380
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l380
+						}
+						if !_rules[ruleqNumericParam]() {
+							goto l380
+						}
+						if !_rules[ruleAction55]() {
+							goto l380
+						}
+						goto l379
+					l380:
+						position, tokenIndex = position379, tokenIndex379
+						if buffer[position] != rune('<') {
+							goto l381
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l381
+						}
+						if !_rules[ruleqNumericParam]() {
+							goto l381
+						}
+						if !_rules[ruleAction56]() {
+							goto l381
+						}
+						goto l379
+					l381:
+						position, tokenIndex = position379, tokenIndex379
+						if buffer[position] != rune('>') {
+							goto l382
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l382
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l382
+						}
+						if !_rules[ruleqNumericParam]() {
+							goto l382
+						}
+						if !_rules[ruleAction57]() {
+							goto l382
+						}
+						goto l379
+					l382:
+						position, tokenIndex = position379, tokenIndex379
+						if buffer[position] != rune('>') {
+							goto l378
+						}
+						position++
+						if !_rules[rulespace]() {
+							goto l378
+						}
+						if !_rules[ruleqNumericParam]() {
+							goto l378
+						}
+						if !_rules[ruleAction58]() {
+							goto l378
+						}
+					}
+				l379:
+					goto l374
+				l378:
+					position, tokenIndex = position374, tokenIndex374
+					if !_rules[rulesingleJsonpathFilter]() {
+						goto l372
+					}
+					if !_rules[rulespace]() {
+						goto l372
+					}
+					if buffer[position] != rune('=') {
+						goto l372
+					}
+					position++
+					if buffer[position] != rune('~') {
+						goto l372
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l372
+					}
+					if buffer[position] != rune('/') {
+						goto l372
+					}
+					position++
+					{
+						position383 := position
+						if !_rules[ruleregex]() {
+							goto l372
+						}
+						add(rulePegText, position383)
+					}
+					if buffer[position] != rune('/') {
+						goto l372
+					}
+					position++
+					if !_rules[ruleAction59]() {
+						goto l372
+					}
+				}
+			l374:
+				add(rulecomparator, position373)
+			}
+			return true
+		l372:
+			position, tokenIndex = position372, tokenIndex372
+			return false
+		},
+		/* 53 strictComparator <- <(qParam space (('=' '=' space qParam Action60) / ('!' '=' space qParam Action61) / ('<' '=' space qParam Action62) / ('<' space qParam Action63) / ('>' '=' space qParam Action64) / ('>' space qParam Action65)))> */
+		func() bool {
+			position384, tokenIndex384 := position, tokenIndex
+			{
+				position385 := position
+				if !_rules[ruleqParam]() {
+					goto l384
+				}
+				if !_rules[rulespace]() {
+					goto l384
+				}
+				{
+					position386, tokenIndex386 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l387
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l387
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l387
+					}
+					if !_rules[ruleqParam]() {
+						goto l387
+					}
+					if !_rules[ruleAction60]() {
+						goto l387
+					}
+					goto l386
+				l387:
+					position, tokenIndex = position386, tokenIndex386
+					if buffer[position] != rune('!') {
+						goto l388
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l388
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l388
+					}
+					if !_rules[ruleqParam]() {
+						goto l388
+					}
+					if !_rules[ruleAction61]() {
+						goto l388
+					}
+					goto l386
+				l388:
+					position, tokenIndex = position386, tokenIndex386
+					if buffer[position] != rune('<') {
+						goto l389
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l389
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l389
+					}
+					if !_rules[ruleqParam]() {
+						goto l389
+					}
+					if !_rules[ruleAction62]() {
+						goto l389
+					}
+					goto l386
+				l389:
+					position, tokenIndex = position386, tokenIndex386
+					if buffer[position] != rune('<') {
+						goto l390
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l390
+					}
+					if !_rules[ruleqParam]() {
+						goto l390
+					}
+					if !_rules[ruleAction63]() {
+						goto l390
+					}
+					goto l386
+				l390:
+					position, tokenIndex = position386, tokenIndex386
+					if buffer[position] != rune('>') {
+						goto l391
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l391
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l391
+					}
+					if !_rules[ruleqParam]() {
+						goto l391
+					}
+					if !_rules[ruleAction64]() {
+						goto l391
+					}
+					goto l386
+				l391:
+					position, tokenIndex = position386, tokenIndex386
+					if buffer[position] != rune('>') {
+						goto l384
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l384
+					}
+					if !_rules[ruleqParam]() {
+						goto l384
+					}
+					if !_rules[ruleAction65]() {
+						goto l384
+					}
+				}
+			l386:
+				add(rulestrictComparator, position385)
+			}
+			return true
+		l384:
+			position, tokenIndex = position384, tokenIndex384
+			return false
+		},
+		/* 54 qParam <- <((<qLiteral> Action66) / singleJsonpathFilter / valueFunction)> */
+		func() bool {
+			position392, tokenIndex392 := position, tokenIndex
+			{
+				position393 := position
+				{
+					position394, tokenIndex394 := position, tokenIndex
+					{
+						position396 := position
+						if !_rules[ruleqLiteral]() {
+							goto l395
+						}
+						add(rulePegText, position396)
+					}
+					if !_rules[ruleAction66]() {
+						goto l395
+					}
+					goto l394
+				l395:
+					position, tokenIndex = position394, tokenIndex394
+					if !_rules[rulesingleJsonpathFilter]() {
+						goto l397
+					}
+					goto l394
+				l397:
+					position, tokenIndex = position394, tokenIndex394
+					if !_rules[rulevalueFunction]() {
+						goto l392
+					}
+				}
+			l394:
+				add(ruleqParam, position393)
+			}
+			return true
+		l392:
+			position, tokenIndex = position392, tokenIndex392
+			return false
+		},
+		/* 55 qNumericParam <- <((<lNumber> Action67) / singleJsonpathFilter / valueFunction)> */
+		func() bool {
+			position398, tokenIndex398 := position, tokenIndex
+			{
+				position399 := position
+				{
+					position400, tokenIndex400 := position, tokenIndex
+					{
+						position402 := position
+						if !_rules[rulelNumber]() {
+							goto l401
 						}
-						add(rulePegText, position214)
+						add(rulePegText, position402)
 					}
-					if !_rules[ruleAction27]() {
-						goto l208
+					if !_rules[ruleAction67]() {
+						goto l401
+					}
+					goto l400
+				l401:
+					position, tokenIndex = position400, tokenIndex400
+					if !_rules[rulesingleJsonpathFilter]() {
+						goto l403
+					}
+					goto l400
+				l403:
+					position, tokenIndex = position400, tokenIndex400
+					if !_rules[rulevalueFunction]() {
+						goto l398
 					}
 				}
-			l210:
-				add(rulebasicQuery, position209)
+			l400:
+				add(ruleqNumericParam, position399)
 			}
 			return true
-		l208:
-			position, tokenIndex = position208, tokenIndex208
+		l398:
+			position, tokenIndex = position398, tokenIndex398
 			return false
 		},
-		/* 36 logicOr <- <(space ('|' '|') space)> */
+		/* 56 qLiteral <- <(lNumber / lBool / lString / lNull)> */
 		func() bool {
-			position217, tokenIndex217 := position, tokenIndex
+			position404, tokenIndex404 := position, tokenIndex
 			{
-				position218 := position
-				if !_rules[rulespace]() {
-					goto l217
-				}
-				if buffer[position] != rune('|') {
-					goto l217
+				position405 := position
+				{
+					position406, tokenIndex406 := position, tokenIndex
+					if !_rules[rulelNumber]() {
+						goto l407
+					}
+					goto l406
+				l407:
+					position, tokenIndex = position406, tokenIndex406
+					if !_rules[rulelBool]() {
+						goto l408
+					}
+					goto l406
+				l408:
+					position, tokenIndex = position406, tokenIndex406
+					if !_rules[rulelString]() {
+						goto l409
+					}
+					goto l406
+				l409:
+					position, tokenIndex = position406, tokenIndex406
+					if !_rules[rulelNull]() {
+						goto l404
+					}
 				}
-				position++
-				if buffer[position] != rune('|') {
-					goto l217
+			l406:
+				add(ruleqLiteral, position405)
+			}
+			return true
+		l404:
+			position, tokenIndex = position404, tokenIndex404
+			return false
+		},
+		/* 57 singleJsonpathFilter <- <(<jsonpathFilter> Action68)> */
+		func() bool {
+			position410, tokenIndex410 := position, tokenIndex
+			{
+				position411 := position
+				{
+					position412 := position
+					if !_rules[rulejsonpathFilter]() {
+						goto l410
+					}
+					add(rulePegText, position412)
 				}
-				position++
-				if !_rules[rulespace]() {
-					goto l217
+				if !_rules[ruleAction68]() {
+					goto l410
 				}
-				add(rulelogicOr, position218)
+				add(rulesingleJsonpathFilter, position411)
 			}
 			return true
-		l217:
-			position, tokenIndex = position217, tokenIndex217
+		l410:
+			position, tokenIndex = position410, tokenIndex410
 			return false
 		},
-		/* 37 logicAnd <- <(space ('&' '&') space)> */
+		/* 58 jsonpathFilter <- <(Action69 jsonpathParameter Action70)> */
 		func() bool {
-			position219, tokenIndex219 := position, tokenIndex
+			position413, tokenIndex413 := position, tokenIndex
 			{
-				position220 := position
-				if !_rules[rulespace]() {
-					goto l219
-				}
-				if buffer[position] != rune('&') {
-					goto l219
+				position414 := position
+				if !_rules[ruleAction69]() {
+					goto l413
 				}
-				position++
-				if buffer[position] != rune('&') {
-					goto l219
+				if !_rules[rulejsonpathParameter]() {
+					goto l413
 				}
-				position++
-				if !_rules[rulespace]() {
-					goto l219
+				if !_rules[ruleAction70]() {
+					goto l413
 				}
-				add(rulelogicAnd, position220)
+				add(rulejsonpathFilter, position414)
 			}
 			return true
-		l219:
-			position, tokenIndex = position219, tokenIndex219
+		l413:
+			position, tokenIndex = position413, tokenIndex413
 			return false
 		},
-		/* 38 logicNot <- <('!' space)> */
+		/* 59 valueFunction <- <(<(valueFunctionName functionArguments)> Action71)> */
 		func() bool {
-			position221, tokenIndex221 := position, tokenIndex
+			position415, tokenIndex415 := position, tokenIndex
 			{
-				position222 := position
-				if buffer[position] != rune('!') {
-					goto l221
+				position416 := position
+				{
+					position417 := position
+					if !_rules[rulevalueFunctionName]() {
+						goto l415
+					}
+					if !_rules[rulefunctionArguments]() {
+						goto l415
+					}
+					add(rulePegText, position417)
 				}
-				position++
-				if !_rules[rulespace]() {
-					goto l221
+				if !_rules[ruleAction71]() {
+					goto l415
 				}
-				add(rulelogicNot, position222)
+				add(rulevalueFunction, position416)
 			}
 			return true
-		l221:
-			position, tokenIndex = position221, tokenIndex221
+		l415:
+			position, tokenIndex = position415, tokenIndex415
 			return false
 		},
-		/* 39 comparator <- <((qParam space (('=' '=' space qParam Action28) / ('!' '=' space qParam Action29))) / (qNumericParam space (('<' '=' space qNumericParam Action30) / ('<' space qNumericParam Action31) / ('>' '=' space qNumericParam Action32) / ('>' space qNumericParam Action33))) / (singleJsonpathFilter space ('=' '~') space '/' <regex> '/' Action34))> */
+		/* 60 logicalFunction <- <(<(logicalFunctionName functionArguments)> Action72)> */
 		func() bool {
-			position223, tokenIndex223 := position, tokenIndex
+			position418, tokenIndex418 := position, tokenIndex
 			{
-				position224 := position
+				position419 := position
 				{
-					position225, tokenIndex225 := position, tokenIndex
-					if !_rules[ruleqParam]() {
-						goto l226
+					position420 := position
+					if !_rules[rulelogicalFunctionName]() {
+						goto l418
 					}
-					if !_rules[rulespace]() {
-						goto l226
+					if !_rules[rulefunctionArguments]() {
+						goto l418
 					}
+					add(rulePegText, position420)
+				}
+				if !_rules[ruleAction72]() {
+					goto l418
+				}
+				add(rulelogicalFunction, position419)
+			}
+			return true
+		l418:
+			position, tokenIndex = position418, tokenIndex418
+			return false
+		},
+		/* 61 valueFunctionName <- <(<(('l' 'e' 'n' 'g' 't' 'h') / ('c' 'o' 'u' 'n' 't') / ('v' 'a' 'l' 'u' 'e'))> Action73)> */
+		func() bool {
+			position421, tokenIndex421 := position, tokenIndex
+			{
+				position422 := position
+				{
+					position423 := position
 					{
-						position227, tokenIndex227 := position, tokenIndex
-						if buffer[position] != rune('=') {
-							goto l228
+						position424, tokenIndex424 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l425
 						}
 						position++
-						if buffer[position] != rune('=') {
-							goto l228
+						if buffer[position] != rune('e') {
+							goto l425
 						}
 						position++
-						if !_rules[rulespace]() {
-							goto l228
-						}
-						if !_rules[ruleqParam]() {
-							goto l228
-						}
-						if !_rules[ruleAction28]() {
-							goto l228
+						if buffer[position] != rune('n') {
+							goto l425
 						}
-						goto l227
-					l228:
-						position, tokenIndex = position227, tokenIndex227
-						if buffer[position] != rune('!') {
-							goto l226
+						position++
+						if buffer[position] != rune('g') {
+							goto l425
 						}
 						position++
-						if buffer[position] != rune('=') {
-							goto l226
+						if buffer[position] != rune('t') {
+							goto l425
 						}
 						position++
-						if !_rules[rulespace]() {
-							goto l226
+						if buffer[position] != rune('h') {
+							goto l425
 						}
-						if !_rules[ruleqParam]() {
-							goto l226
+						position++
+						goto l424
+					l425:
+						position, tokenIndex = position424, tokenIndex424
+						if buffer[position] != rune('c') {
+							goto l426
 						}
-						if !_rules[ruleAction29]() {
-							goto l226
+						position++
+						if buffer[position] != rune('o') {
+							goto l426
 						}
-					}
-				l227:
-					goto l225
-				l226:
-					position, tokenIndex = position225, tokenIndex225
-					if !_rules[ruleqNumericParam]() {
-						goto l229
-					}
-					if !_rules[rulespace]() {
-						goto l229
-					}
-					{
-						position230, tokenIndex230 := position, tokenIndex
-						if buffer[position] != rune('<') {
-							goto l231
+						position++
+						if buffer[position] != rune('u') {
+							goto l426
 						}
 						position++
-						if buffer[position] != rune('=') {
-							goto l231
+						if buffer[position] != rune('n') {
+							goto l426
 						}
 						position++
-						if !_rules[rulespace]() {
-							goto l231
+						if buffer[position] != rune('t') {
+							goto l426
 						}
-						if !_rules[ruleqNumericParam]() {
-							goto l231
+						position++
+						goto l424
+					l426:
+						position, tokenIndex = position424, tokenIndex424
+						if buffer[position] != rune('v') {
+							goto l421
 						}
-						if !_rules[ruleAction30]() {
-							goto l231
+						position++
+						if buffer[position] != rune('a') {
+							goto l421
 						}
-						goto l230
-					l231:
-						position, tokenIndex = position230, tokenIndex230
-						if buffer[position] != rune('<') {
-							goto l232
+						position++
+						if buffer[position] != rune('l') {
+							goto l421
 						}
 						position++
-						if !_rules[rulespace]() {
-							goto l232
+						if buffer[position] != rune('u') {
+							goto l421
 						}
-						if !_rules[ruleqNumericParam]() {
-							goto l232
+						position++
+						if buffer[position] != rune('e') {
+							goto l421
 						}
-						if !_rules[ruleAction31]() {
-							goto l232
+						position++
+					}
+				l424:
+					add(rulePegText, position423)
+				}
+				if !_rules[ruleAction73]() {
+					goto l421
+				}
+				add(rulevalueFunctionName, position422)
+			}
+			return true
+		l421:
+			position, tokenIndex = position421, tokenIndex421
+			return false
+		},
+		/* 62 logicalFunctionName <- <(<(('m' 'a' 't' 'c' 'h') / ('s' 'e' 'a' 'r' 'c' 'h'))> Action74)> */
+		func() bool {
+			position427, tokenIndex427 := position, tokenIndex
+			{
+				position428 := position
+				{
+					position429 := position
+					{
+						position430, tokenIndex430 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l431
 						}
-						goto l230
-					l232:
-						position, tokenIndex = position230, tokenIndex230
-						if buffer[position] != rune('>') {
-							goto l233
+						position++
+						if buffer[position] != rune('a') {
+							goto l431
 						}
 						position++
-						if buffer[position] != rune('=') {
-							goto l233
+						if buffer[position] != rune('t') {
+							goto l431
 						}
 						position++
-						if !_rules[rulespace]() {
-							goto l233
+						if buffer[position] != rune('c') {
+							goto l431
 						}
-						if !_rules[ruleqNumericParam]() {
-							goto l233
+						position++
+						if buffer[position] != rune('h') {
+							goto l431
 						}
-						if !_rules[ruleAction32]() {
-							goto l233
+						position++
+						goto l430
+					l431:
+						position, tokenIndex = position430, tokenIndex430
+						if buffer[position] != rune('s') {
+							goto l427
 						}
-						goto l230
-					l233:
-						position, tokenIndex = position230, tokenIndex230
-						if buffer[position] != rune('>') {
-							goto l229
+						position++
+						if buffer[position] != rune('e') {
+							goto l427
 						}
 						position++
-						if !_rules[rulespace]() {
-							goto l229
+						if buffer[position] != rune('a') {
+							goto l427
 						}
-						if !_rules[ruleqNumericParam]() {
-							goto l229
+						position++
+						if buffer[position] != rune('r') {
+							goto l427
 						}
-						if !_rules[ruleAction33]() {
-							goto l229
+						position++
+						if buffer[position] != rune('c') {
+							goto l427
 						}
-					}
-				l230:
-					goto l225
-				l229:
-					position, tokenIndex = position225, tokenIndex225
-					if !_rules[rulesingleJsonpathFilter]() {
-						goto l223
-					}
-					if !_rules[rulespace]() {
-						goto l223
-					}
-					if buffer[position] != rune('=') {
-						goto l223
-					}
-					position++
-					if buffer[position] != rune('~') {
-						goto l223
-					}
-					position++
-					if !_rules[rulespace]() {
-						goto l223
-					}
-					if buffer[position] != rune('/') {
-						goto l223
-					}
-					position++
-					{
-						position234 := position
-						if !_rules[ruleregex]() {
-							goto l223
+						position++
+						if buffer[position] != rune('h') {
+							goto l427
 						}
-						add(rulePegText, position234)
-					}
-					if buffer[position] != rune('/') {
-						goto l223
-					}
-					position++
-					if !_rules[ruleAction34]() {
-						goto l223
+						position++
 					}
+				l430:
+					add(rulePegText, position429)
 				}
-			l225:
-				add(rulecomparator, position224)
-			}
-			return true
-		l223:
-			position, tokenIndex = position223, tokenIndex223
-			return false
-		},
-		/* 40 qParam <- <((qLiteral Action35) / singleJsonpathFilter)> */
-		func() bool {
-			position235, tokenIndex235 := position, tokenIndex
-			{
-				position236 := position
-				{
-					position237, tokenIndex237 := position, tokenIndex
-					if !_rules[ruleqLiteral]() {
-						goto l238
-					}
-					if !_rules[ruleAction35]() {
-						goto l238
-					}
-					goto l237
-				l238:
-					position, tokenIndex = position237, tokenIndex237
-					if !_rules[rulesingleJsonpathFilter]() {
-						goto l235
-					}
+				if !_rules[ruleAction74]() {
+					goto l427
 				}
-			l237:
-				add(ruleqParam, position236)
+				add(rulelogicalFunctionName, position428)
 			}
 			return true
-		l235:
-			position, tokenIndex = position235, tokenIndex235
+		l427:
+			position, tokenIndex = position427, tokenIndex427
 			return false
 		},
-		/* 41 qNumericParam <- <((lNumber Action36) / singleJsonpathFilter)> */
+		/* 63 functionArguments <- <('(' space functionArgument (space ',' space functionArgument)* space ')')> */
 		func() bool {
-			position239, tokenIndex239 := position, tokenIndex
+			position432, tokenIndex432 := position, tokenIndex
 			{
-				position240 := position
+				position433 := position
+				if buffer[position] != rune('(') {
+					goto l432
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l432
+				}
+				if !_rules[rulefunctionArgument]() {
+					goto l432
+				}
+			l434:
 				{
-					position241, tokenIndex241 := position, tokenIndex
-					if !_rules[rulelNumber]() {
-						goto l242
+					position435, tokenIndex435 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l435
 					}
-					if !_rules[ruleAction36]() {
-						goto l242
+					if buffer[position] != rune(',') {
+						goto l435
 					}
-					goto l241
-				l242:
-					position, tokenIndex = position241, tokenIndex241
-					if !_rules[rulesingleJsonpathFilter]() {
-						goto l239
+					position++
+					if !_rules[rulespace]() {
+						goto l435
+					}
+					if !_rules[rulefunctionArgument]() {
+						goto l435
 					}
+					goto l434
+				l435:
+					position, tokenIndex = position435, tokenIndex435
+				}
+				if !_rules[rulespace]() {
+					goto l432
+				}
+				if buffer[position] != rune(')') {
+					goto l432
 				}
-			l241:
-				add(ruleqNumericParam, position240)
+				position++
+				add(rulefunctionArguments, position433)
 			}
 			return true
-		l239:
-			position, tokenIndex = position239, tokenIndex239
+		l432:
+			position, tokenIndex = position432, tokenIndex432
 			return false
 		},
-		/* 42 qLiteral <- <(lNumber / lBool / lString / lNull)> */
+		/* 64 functionArgument <- <((<qLiteral> Action75) / valueFunction / (<jsonpathFilter> Action76))> */
 		func() bool {
-			position243, tokenIndex243 := position, tokenIndex
+			position436, tokenIndex436 := position, tokenIndex
 			{
-				position244 := position
+				position437 := position
 				{
-					position245, tokenIndex245 := position, tokenIndex
-					if !_rules[rulelNumber]() {
-						goto l246
+					position438, tokenIndex438 := position, tokenIndex
+					{
+						position440 := position
+						if !_rules[ruleqLiteral]() {
+							goto l439
+						}
+						add(rulePegText, position440)
 					}
-					goto l245
-				l246:
-					position, tokenIndex = position245, tokenIndex245
-					if !_rules[rulelBool]() {
-						goto l247
+					if !_rules[ruleAction75]() {
+						goto l439
 					}
-					goto l245
-				l247:
-					position, tokenIndex = position245, tokenIndex245
-					if !_rules[rulelString]() {
-						goto l248
+					goto l438
+				l439:
+					position, tokenIndex = position438, tokenIndex438
+					if !_rules[rulevalueFunction]() {
+						goto l441
 					}
-					goto l245
-				l248:
-					position, tokenIndex = position245, tokenIndex245
-					if !_rules[rulelNull]() {
-						goto l243
+					goto l438
+				l441:
+					position, tokenIndex = position438, tokenIndex438
+					{
+						position442 := position
+						if !_rules[rulejsonpathFilter]() {
+							goto l436
+						}
+						add(rulePegText, position442)
 					}
-				}
-			l245:
-				add(ruleqLiteral, position244)
-			}
-			return true
-		l243:
-			position, tokenIndex = position243, tokenIndex243
-			return false
-		},
-		/* 43 singleJsonpathFilter <- <(<jsonpathFilter> Action37)> */
-		func() bool {
-			position249, tokenIndex249 := position, tokenIndex
-			{
-				position250 := position
-				{
-					position251 := position
-					if !_rules[rulejsonpathFilter]() {
-						goto l249
+					if !_rules[ruleAction76]() {
+						goto l436
 					}
-					add(rulePegText, position251)
-				}
-				if !_rules[ruleAction37]() {
-					goto l249
-				}
-				add(rulesingleJsonpathFilter, position250)
-			}
-			return true
-		l249:
-			position, tokenIndex = position249, tokenIndex249
-			return false
-		},
-		/* 44 jsonpathFilter <- <(Action38 jsonpathParameter Action39)> */
-		func() bool {
-			position252, tokenIndex252 := position, tokenIndex
-			{
-				position253 := position
-				if !_rules[ruleAction38]() {
-					goto l252
-				}
-				if !_rules[rulejsonpathParameter]() {
-					goto l252
 				}
-				if !_rules[ruleAction39]() {
-					goto l252
-				}
-				add(rulejsonpathFilter, position253)
+			l438:
+				add(rulefunctionArgument, position437)
 			}
 			return true
-		l252:
-			position, tokenIndex = position252, tokenIndex252
+		l436:
+			position, tokenIndex = position436, tokenIndex436
 			return false
 		},
-		/* 45 lNumber <- <(<(('-' / '+')? [0-9] ('-' / '+' / '.' / [0-9] / [a-z] / [A-Z])*)> Action40)> */
+		/* 65 lNumber <- <((&{p.strictMode} <('-'? ('0' / ([1-9] [0-9]*)) ('.' [0-9]+)? (('e' / 'E') ('-' / '+')? [0-9]+)?)> Action77) / (&{!p.strictMode} <(('-' / '+')? [0-9] ('-' / '+' / '.' / [0-9] / [a-z] / [A-Z])*)> Action78))> */
 		func() bool {
-			position254, tokenIndex254 := position, tokenIndex
+			position443, tokenIndex443 := position, tokenIndex
 			{
-				position255 := position
+				position444 := position
 				{
-					position256 := position
+					position445, tokenIndex445 := position, tokenIndex
+					if !(p.strictMode) {
+						goto l446
+					}
 					{
-						position257, tokenIndex257 := position, tokenIndex
+						position447 := position
 						{
-							position259, tokenIndex259 := position, tokenIndex
+							position448, tokenIndex448 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l260
-							}
-							position++
-							goto l259
-						l260:
-							position, tokenIndex = position259, tokenIndex259
-							if buffer[position] != rune('+') {
-								goto l257
+								goto l448
 							}
 							position++
+							goto l449
+						l448:
+							position, tokenIndex = position448, tokenIndex448
 						}
-					l259:
-						goto l258
-					l257:
-						position, tokenIndex = position257, tokenIndex257
-					}
-				l258:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l254
-					}
-					position++
-				l261:
-					{
-						position262, tokenIndex262 := position, tokenIndex
+					l449:
 						{
-							position263, tokenIndex263 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l264
+							position450, tokenIndex450 := position, tokenIndex
+							if buffer[position] != rune('0') {
+								goto l451
 							}
 							position++
-							goto l263
-						l264:
-							position, tokenIndex = position263, tokenIndex263
-							if buffer[position] != rune('+') {
-								goto l265
+							goto l450
+						l451:
+							position, tokenIndex = position450, tokenIndex450
+							if c := buffer[position]; c < rune('1') || c > rune('9') {
+								goto l446
 							}
 							position++
-							goto l263
-						l265:
-							position, tokenIndex = position263, tokenIndex263
+						l452:
+							{
+								position453, tokenIndex453 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l453
+								}
+								position++
+								goto l452
+							l453:
+								position, tokenIndex = position453, tokenIndex453
+							}
+						}
+					l450:
+						{
+							position454, tokenIndex454 := position, tokenIndex
 							if buffer[position] != rune('.') {
-								goto l266
+								goto l454
 							}
 							position++
-							goto l263
-						l266:
-							position, tokenIndex = position263, tokenIndex263
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l267
+								goto l454
 							}
 							position++
-							goto l263
-						l267:
-							position, tokenIndex = position263, tokenIndex263
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l268
+						l456:
+							{
+								position457, tokenIndex457 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l457
+								}
+								position++
+								goto l456
+							l457:
+								position, tokenIndex = position457, tokenIndex457
 							}
-							position++
-							goto l263
-						l268:
-							position, tokenIndex = position263, tokenIndex263
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l262
+							goto l455
+						l454:
+							position, tokenIndex = position454, tokenIndex454
+						}
+					l455:
+						{
+							position458, tokenIndex458 := position, tokenIndex
+							{
+								position460, tokenIndex460 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l461
+								}
+								position++
+								goto l460
+							l461:
+								position, tokenIndex = position460, tokenIndex460
+								if buffer[position] != rune('E') {
+									goto l458
+								}
+								position++
+							}
+						l460:
+							{
+								position462, tokenIndex462 := position, tokenIndex
+								{
+									position464, tokenIndex464 := position, tokenIndex
+									if buffer[position] != rune('-') {
+										goto l465
+									}
+									position++
+									goto l464
+								l465:
+									position, tokenIndex = position464, tokenIndex464
+									if buffer[position] != rune('+') {
+										goto l462
+									}
+									position++
+								}
+							l464:
+								goto l463
+							l462:
+								position, tokenIndex = position462, tokenIndex462
+							}
+						l463:
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l458
 							}
 							position++
+						l466:
+							{
+								position467, tokenIndex467 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l467
+								}
+								position++
+								goto l466
+							l467:
+								position, tokenIndex = position467, tokenIndex467
+							}
+							goto l459
+						l458:
+							position, tokenIndex = position458, tokenIndex458
 						}
-					l263:
-						goto l261
-					l262:
-						position, tokenIndex = position262, tokenIndex262
+					l459:
+						add(rulePegText, position447)
+					}
+					if !_rules[ruleAction77]() {
+						goto l446
+					}
+					goto l445
+				l446:
+					position, tokenIndex = position445, tokenIndex445
+					if !(!p.strictMode) {
+						goto l443
+					}
+					{
+						position468 := position
+						{
+							position469, tokenIndex469 := position, tokenIndex
+							{
+								position471, tokenIndex471 := position, tokenIndex
+								if buffer[position] != rune('-') {
+									goto l472
+								}
+								position++
+								goto l471
+							l472:
+								position, tokenIndex = position471, tokenIndex471
+								if buffer[position] != rune('+') {
+									goto l469
+								}
+								position++
+							}
+						l471:
+							goto l470
+						l469:
+							position, tokenIndex = position469, tokenIndex469
+						}
+					l470:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l443
+						}
+						position++
+					l473:
+						{
+							position474, tokenIndex474 := position, tokenIndex
+							{
+								position475, tokenIndex475 := position, tokenIndex
+								if buffer[position] != rune('-') {
+									goto l476
+								}
+								position++
+								goto l475
+							l476:
+								position, tokenIndex = position475, tokenIndex475
+								if buffer[position] != rune('+') {
+									goto l477
+								}
+								position++
+								goto l475
+							l477:
+								position, tokenIndex = position475, tokenIndex475
+								if buffer[position] != rune('.') {
+									goto l478
+								}
+								position++
+								goto l475
+							l478:
+								position, tokenIndex = position475, tokenIndex475
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l479
+								}
+								position++
+								goto l475
+							l479:
+								position, tokenIndex = position475, tokenIndex475
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l480
+								}
+								position++
+								goto l475
+							l480:
+								position, tokenIndex = position475, tokenIndex475
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l474
+								}
+								position++
+							}
+						l475:
+							goto l473
+						l474:
+							position, tokenIndex = position474, tokenIndex474
+						}
+						add(rulePegText, position468)
+					}
+					if !_rules[ruleAction78]() {
+						goto l443
 					}
-					add(rulePegText, position256)
-				}
-				if !_rules[ruleAction40]() {
-					goto l254
 				}
-				add(rulelNumber, position255)
+			l445:
+				add(rulelNumber, position444)
 			}
 			return true
-		l254:
-			position, tokenIndex = position254, tokenIndex254
+		l443:
+			position, tokenIndex = position443, tokenIndex443
 			return false
 		},
-		/* 46 lBool <- <(((('t' 'r' 'u' 'e') / ('T' 'r' 'u' 'e') / ('T' 'R' 'U' 'E')) Action41) / ((('f' 'a' 'l' 's' 'e') / ('F' 'a' 'l' 's' 'e') / ('F' 'A' 'L' 'S' 'E')) Action42))> */
+		/* 66 lBool <- <(((('t' 'r' 'u' 'e') / (&{!p.strictMode} (('T' 'r' 'u' 'e') / ('T' 'R' 'U' 'E')))) Action79) / ((('f' 'a' 'l' 's' 'e') / (&{!p.strictMode} (('F' 'a' 'l' 's' 'e') / ('F' 'A' 'L' 'S' 'E')))) Action80))> */
 		func() bool {
-			position269, tokenIndex269 := position, tokenIndex
+			position481, tokenIndex481 := position, tokenIndex
 			{
-				position270 := position
+				position482 := position
 				{
-					position271, tokenIndex271 := position, tokenIndex
+					position483, tokenIndex483 := position, tokenIndex
 					{
-						position273, tokenIndex273 := position, tokenIndex
+						position485, tokenIndex485 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l274
-						}
-						position++
-						if buffer[position] != rune('r') {
-							goto l274
-						}
-						position++
-						if buffer[position] != rune('u') {
-							goto l274
-						}
-						position++
-						if buffer[position] != rune('e') {
-							goto l274
-						}
-						position++
-						goto l273
-					l274:
-						position, tokenIndex = position273, tokenIndex273
-						if buffer[position] != rune('T') {
-							goto l275
+							goto l486
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l275
+							goto l486
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l275
+							goto l486
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l275
-						}
-						position++
-						goto l273
-					l275:
-						position, tokenIndex = position273, tokenIndex273
-						if buffer[position] != rune('T') {
-							goto l272
-						}
-						position++
-						if buffer[position] != rune('R') {
-							goto l272
+							goto l486
 						}
 						position++
-						if buffer[position] != rune('U') {
-							goto l272
+						goto l485
+					l486:
+						position, tokenIndex = position485, tokenIndex485
+						if !(!p.strictMode) {
+							goto l484
 						}
-						position++
-						if buffer[position] != rune('E') {
-							goto l272
+						{
+							position487, tokenIndex487 := position, tokenIndex
+							if buffer[position] != rune('T') {
+								goto l488
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l488
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l488
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l488
+							}
+							position++
+							goto l487
+						l488:
+							position, tokenIndex = position487, tokenIndex487
+							if buffer[position] != rune('T') {
+								goto l484
+							}
+							position++
+							if buffer[position] != rune('R') {
+								goto l484
+							}
+							position++
+							if buffer[position] != rune('U') {
+								goto l484
+							}
+							position++
+							if buffer[position] != rune('E') {
+								goto l484
+							}
+							position++
 						}
-						position++
+					l487:
 					}
-				l273:
-					if !_rules[ruleAction41]() {
-						goto l272
+				l485:
+					if !_rules[ruleAction79]() {
+						goto l484
 					}
-					goto l271
-				l272:
-					position, tokenIndex = position271, tokenIndex271
+					goto l483
+				l484:
+					position, tokenIndex = position483, tokenIndex483
 					{
-						position276, tokenIndex276 := position, tokenIndex
+						position489, tokenIndex489 := position, tokenIndex
 						if buffer[position] != rune('f') {
-							goto l277
-						}
-						position++
-						if buffer[position] != rune('a') {
-							goto l277
-						}
-						position++
-						if buffer[position] != rune('l') {
-							goto l277
-						}
-						position++
-						if buffer[position] != rune('s') {
-							goto l277
-						}
-						position++
-						if buffer[position] != rune('e') {
-							goto l277
-						}
-						position++
-						goto l276
-					l277:
-						position, tokenIndex = position276, tokenIndex276
-						if buffer[position] != rune('F') {
-							goto l278
+							goto l490
 						}
 						position++
 						if buffer[position] != rune('a') {
-							goto l278
+							goto l490
 						}
 						position++
 						if buffer[position] != rune('l') {
-							goto l278
+							goto l490
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l278
+							goto l490
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l278
-						}
-						position++
-						goto l276
-					l278:
-						position, tokenIndex = position276, tokenIndex276
-						if buffer[position] != rune('F') {
-							goto l269
-						}
-						position++
-						if buffer[position] != rune('A') {
-							goto l269
-						}
-						position++
-						if buffer[position] != rune('L') {
-							goto l269
+							goto l490
 						}
 						position++
-						if buffer[position] != rune('S') {
-							goto l269
+						goto l489
+					l490:
+						position, tokenIndex = position489, tokenIndex489
+						if !(!p.strictMode) {
+							goto l481
 						}
-						position++
-						if buffer[position] != rune('E') {
-							goto l269
+						{
+							position491, tokenIndex491 := position, tokenIndex
+							if buffer[position] != rune('F') {
+								goto l492
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l492
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l492
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l492
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l492
+							}
+							position++
+							goto l491
+						l492:
+							position, tokenIndex = position491, tokenIndex491
+							if buffer[position] != rune('F') {
+								goto l481
+							}
+							position++
+							if buffer[position] != rune('A') {
+								goto l481
+							}
+							position++
+							if buffer[position] != rune('L') {
+								goto l481
+							}
+							position++
+							if buffer[position] != rune('S') {
+								goto l481
+							}
+							position++
+							if buffer[position] != rune('E') {
+								goto l481
+							}
+							position++
 						}
-						position++
+					l491:
 					}
-				l276:
-					if !_rules[ruleAction42]() {
-						goto l269
+				l489:
+					if !_rules[ruleAction80]() {
+						goto l481
 					}
 				}
-			l271:
-				add(rulelBool, position270)
+			l483:
+				add(rulelBool, position482)
 			}
 			return true
-		l269:
-			position, tokenIndex = position269, tokenIndex269
+		l481:
+			position, tokenIndex = position481, tokenIndex481
 			return false
 		},
-		/* 47 lString <- <(('\'' <(('\\' ('\\' / '\'')) / (!'\'' .))*> '\'' Action43) / ('"' <(('\\' ('\\' / '"')) / (!'"' .))*> '"' Action44))> */
+		/* 67 lString <- <((&{p.strictMode} '\'' <singleQuotedString> '\'' Action81) / (&{p.strictMode} '"' <doubleQuotedString> '"' Action82) / (&{!p.strictMode} '\'' <(('\\' ('\\' / '\'')) / (!'\'' .))*> '\'' Action83) / (&{!p.strictMode} '"' <(('\\' ('\\' / '"')) / (!'"' .))*> '"' Action84))> */
 		func() bool {
-			position279, tokenIndex279 := position, tokenIndex
+			position493, tokenIndex493 := position, tokenIndex
 			{
-				position280 := position
+				position494 := position
 				{
-					position281, tokenIndex281 := position, tokenIndex
+					position495, tokenIndex495 := position, tokenIndex
+					if !(p.strictMode) {
+						goto l496
+					}
 					if buffer[position] != rune('\'') {
-						goto l282
+						goto l496
 					}
 					position++
 					{
-						position283 := position
-					l284:
+						position497 := position
+						if !_rules[rulesingleQuotedString]() {
+							goto l496
+						}
+						add(rulePegText, position497)
+					}
+					if buffer[position] != rune('\'') {
+						goto l496
+					}
+					position++
+					if !_rules[ruleAction81]() {
+						goto l496
+					}
+					goto l495
+				l496:
+					position, tokenIndex = position495, tokenIndex495
+					if !(p.strictMode) {
+						goto l498
+					}
+					if buffer[position] != rune('"') {
+						goto l498
+					}
+					position++
+					{
+						position499 := position
+						if !_rules[ruledoubleQuotedString]() {
+							goto l498
+						}
+						add(rulePegText, position499)
+					}
+					if buffer[position] != rune('"') {
+						goto l498
+					}
+					position++
+					if !_rules[ruleAction82]() {
+						goto l498
+					}
+					goto l495
+				l498:
+					position, tokenIndex = position495, tokenIndex495
+					if !(!p.strictMode) {
+						goto l500
+					}
+					if buffer[position] != rune('\'') {
+						goto l500
+					}
+					position++
+					{
+						position501 := position
+					l502:
 						{
-							position285, tokenIndex285 := position, tokenIndex
+							position503, tokenIndex503 := position, tokenIndex
 							{
-								position286, tokenIndex286 := position, tokenIndex
+								position504, tokenIndex504 := position, tokenIndex
 								if buffer[position] != rune('\\') {
-									goto l287
+									goto l505
 								}
 								position++
 								{
-									position288, tokenIndex288 := position, tokenIndex
+									position506, tokenIndex506 := position, tokenIndex
 									if buffer[position] != rune('\\') {
-										goto l289
+										goto l507
 									}
 									position++
-									goto l288
-								l289:
-									position, tokenIndex = position288, tokenIndex288
+									goto l506
+								l507:
+									position, tokenIndex = position506, tokenIndex506
 									if buffer[position] != rune('\'') {
-										goto l287
+										goto l505
 									}
 									position++
 								}
-							l288:
-								goto l286
-							l287:
-								position, tokenIndex = position286, tokenIndex286
+							l506:
+								goto l504
+							l505:
+								position, tokenIndex = position504, tokenIndex504
 								{
-									position290, tokenIndex290 := position, tokenIndex
+									position508, tokenIndex508 := position, tokenIndex
 									if buffer[position] != rune('\'') {
-										goto l290
+										goto l508
 									}
 									position++
-									goto l285
-								l290:
-									position, tokenIndex = position290, tokenIndex290
+									goto l503
+								l508:
+									position, tokenIndex = position508, tokenIndex508
 								}
 								if !matchDot() {
-									goto l285
+									goto l503
 								}
 							}
-						l286:
-							goto l284
-						l285:
-							position, tokenIndex = position285, tokenIndex285
+						l504:
+							goto l502
+						l503:
+							position, tokenIndex = position503, tokenIndex503
 						}
-						add(rulePegText, position283)
+						add(rulePegText, position501)
 					}
 					if buffer[position] != rune('\'') {
-						goto l282
+						goto l500
 					}
 					position++
-					if !_rules[ruleAction43]() {
-						goto l282
+					if !_rules[ruleAction83]() {
+						goto l500
+					}
+					goto l495
+				l500:
+					position, tokenIndex = position495, tokenIndex495
+					if !(!p.strictMode) {
+						goto l493
 					}
-					goto l281
-				l282:
-					position, tokenIndex = position281, tokenIndex281
 					if buffer[position] != rune('"') {
-						goto l279
+						goto l493
 					}
 					position++
 					{
-						position291 := position
-					l292:
+						position509 := position
+					l510:
 						{
-							position293, tokenIndex293 := position, tokenIndex
+							position511, tokenIndex511 := position, tokenIndex
 							{
-								position294, tokenIndex294 := position, tokenIndex
+								position512, tokenIndex512 := position, tokenIndex
 								if buffer[position] != rune('\\') {
-									goto l295
+									goto l513
 								}
 								position++
 								{
-									position296, tokenIndex296 := position, tokenIndex
+									position514, tokenIndex514 := position, tokenIndex
 									if buffer[position] != rune('\\') {
-										goto l297
+										goto l515
 									}
 									position++
-									goto l296
-								l297:
-									position, tokenIndex = position296, tokenIndex296
+									goto l514
+								l515:
+									position, tokenIndex = position514, tokenIndex514
 									if buffer[position] != rune('"') {
-										goto l295
+										goto l513
 									}
 									position++
 								}
-							l296:
-								goto l294
-							l295:
-								position, tokenIndex = position294, tokenIndex294
+							l514:
+								goto l512
+							l513:
+								position, tokenIndex = position512, tokenIndex512
 								{
-									position298, tokenIndex298 := position, tokenIndex
+									position516, tokenIndex516 := position, tokenIndex
 									if buffer[position] != rune('"') {
-										goto l298
+										goto l516
 									}
 									position++
-									goto l293
-								l298:
-									position, tokenIndex = position298, tokenIndex298
+									goto l511
+								l516:
+									position, tokenIndex = position516, tokenIndex516
 								}
 								if !matchDot() {
-									goto l293
+									goto l511
 								}
 							}
-						l294:
-							goto l292
-						l293:
-							position, tokenIndex = position293, tokenIndex293
+						l512:
+							goto l510
+						l511:
+							position, tokenIndex = position511, tokenIndex511
 						}
-						add(rulePegText, position291)
+						add(rulePegText, position509)
 					}
 					if buffer[position] != rune('"') {
-						goto l279
+						goto l493
 					}
 					position++
-					if !_rules[ruleAction44]() {
-						goto l279
+					if !_rules[ruleAction84]() {
+						goto l493
 					}
 				}
-			l281:
-				add(rulelString, position280)
+			l495:
+				add(rulelString, position494)
 			}
 			return true
-		l279:
-			position, tokenIndex = position279, tokenIndex279
+		l493:
+			position, tokenIndex = position493, tokenIndex493
 			return false
 		},
-		/* 48 lNull <- <((('n' 'u' 'l' 'l') / ('N' 'u' 'l' 'l') / ('N' 'U' 'L' 'L')) Action45)> */
+		/* 68 lNull <- <((('n' 'u' 'l' 'l') / (&{!p.strictMode} (('N' 'u' 'l' 'l') / ('N' 'U' 'L' 'L')))) Action85)> */
 		func() bool {
-			position299, tokenIndex299 := position, tokenIndex
+			position517, tokenIndex517 := position, tokenIndex
 			{
-				position300 := position
+				position518 := position
 				{
-					position301, tokenIndex301 := position, tokenIndex
+					position519, tokenIndex519 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l302
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l302
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l302
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l302
-					}
-					position++
-					goto l301
-				l302:
-					position, tokenIndex = position301, tokenIndex301
-					if buffer[position] != rune('N') {
-						goto l303
+						goto l520
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l303
+						goto l520
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l303
+						goto l520
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l303
-					}
-					position++
-					goto l301
-				l303:
-					position, tokenIndex = position301, tokenIndex301
-					if buffer[position] != rune('N') {
-						goto l299
-					}
-					position++
-					if buffer[position] != rune('U') {
-						goto l299
+						goto l520
 					}
 					position++
-					if buffer[position] != rune('L') {
-						goto l299
+					goto l519
+				l520:
+					position, tokenIndex = position519, tokenIndex519
+					if !(!p.strictMode) {
+						goto l517
 					}
-					position++
-					if buffer[position] != rune('L') {
-						goto l299
+					{
+						position521, tokenIndex521 := position, tokenIndex
+						if buffer[position] != rune('N') {
+							goto l522
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l522
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l522
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l522
+						}
+						position++
+						goto l521
+					l522:
+						position, tokenIndex = position521, tokenIndex521
+						if buffer[position] != rune('N') {
+							goto l517
+						}
+						position++
+						if buffer[position] != rune('U') {
+							goto l517
+						}
+						position++
+						if buffer[position] != rune('L') {
+							goto l517
+						}
+						position++
+						if buffer[position] != rune('L') {
+							goto l517
+						}
+						position++
 					}
-					position++
+				l521:
 				}
-			l301:
-				if !_rules[ruleAction45]() {
-					goto l299
+			l519:
+				if !_rules[ruleAction85]() {
+					goto l517
 				}
-				add(rulelNull, position300)
+				add(rulelNull, position518)
 			}
 			return true
-		l299:
-			position, tokenIndex = position299, tokenIndex299
+		l517:
+			position, tokenIndex = position517, tokenIndex517
 			return false
 		},
-		/* 49 regex <- <(('\\' ('\\' / '/')) / (!'/' .))*> */
+		/* 69 regex <- <(('\\' ('\\' / '/')) / (!'/' .))*> */
 		func() bool {
 			{
-				position305 := position
-			l306:
+				position524 := position
+			l525:
 				{
-					position307, tokenIndex307 := position, tokenIndex
+					position526, tokenIndex526 := position, tokenIndex
 					{
-						position308, tokenIndex308 := position, tokenIndex
+						position527, tokenIndex527 := position, tokenIndex
 						if buffer[position] != rune('\\') {
-							goto l309
+							goto l528
 						}
 						position++
 						{
-							position310, tokenIndex310 := position, tokenIndex
+							position529, tokenIndex529 := position, tokenIndex
 							if buffer[position] != rune('\\') {
-								goto l311
+								goto l530
 							}
 							position++
-							goto l310
-						l311:
-							position, tokenIndex = position310, tokenIndex310
+							goto l529
+						l530:
+							position, tokenIndex = position529, tokenIndex529
 							if buffer[position] != rune('/') {
-								goto l309
+								goto l528
 							}
 							position++
 						}
-					l310:
-						goto l308
-					l309:
-						position, tokenIndex = position308, tokenIndex308
+					l529:
+						goto l527
+					l528:
+						position, tokenIndex = position527, tokenIndex527
 						{
-							position312, tokenIndex312 := position, tokenIndex
+							position531, tokenIndex531 := position, tokenIndex
 							if buffer[position] != rune('/') {
-								goto l312
+								goto l531
 							}
 							position++
-							goto l307
-						l312:
-							position, tokenIndex = position312, tokenIndex312
+							goto l526
+						l531:
+							position, tokenIndex = position531, tokenIndex531
 						}
 						if !matchDot() {
-							goto l307
+							goto l526
 						}
 					}
-				l308:
-					goto l306
-				l307:
-					position, tokenIndex = position307, tokenIndex307
+				l527:
+					goto l525
+				l526:
+					position, tokenIndex = position526, tokenIndex526
 				}
-				add(ruleregex, position305)
+				add(ruleregex, position524)
 			}
 			return true
 		},
-		/* 50 squareBracketStart <- <('[' space)> */
+		/* 70 squareBracketStart <- <('[' space)> */
 		func() bool {
-			position313, tokenIndex313 := position, tokenIndex
+			position532, tokenIndex532 := position, tokenIndex
 			{
-				position314 := position
+				position533 := position
 				if buffer[position] != rune('[') {
-					goto l313
+					goto l532
 				}
 				position++
 				if !_rules[rulespace]() {
-					goto l313
+					goto l532
 				}
-				add(rulesquareBracketStart, position314)
+				add(rulesquareBracketStart, position533)
 			}
 			return true
-		l313:
-			position, tokenIndex = position313, tokenIndex313
+		l532:
+			position, tokenIndex = position532, tokenIndex532
 			return false
 		},
-		/* 51 squareBracketEnd <- <(space ']')> */
+		/* 71 squareBracketEnd <- <(space ']')> */
 		func() bool {
-			position315, tokenIndex315 := position, tokenIndex
+			position534, tokenIndex534 := position, tokenIndex
 			{
-				position316 := position
+				position535 := position
 				if !_rules[rulespace]() {
-					goto l315
+					goto l534
 				}
 				if buffer[position] != rune(']') {
-					goto l315
+					goto l534
 				}
 				position++
-				add(rulesquareBracketEnd, position316)
+				add(rulesquareBracketEnd, position535)
 			}
 			return true
-		l315:
-			position, tokenIndex = position315, tokenIndex315
+		l534:
+			position, tokenIndex = position534, tokenIndex534
 			return false
 		},
-		/* 52 scriptStart <- <('(' space)> */
+		/* 72 scriptStart <- <('(' space)> */
 		func() bool {
-			position317, tokenIndex317 := position, tokenIndex
+			position536, tokenIndex536 := position, tokenIndex
 			{
-				position318 := position
+				position537 := position
 				if buffer[position] != rune('(') {
-					goto l317
+					goto l536
 				}
 				position++
 				if !_rules[rulespace]() {
-					goto l317
+					goto l536
 				}
-				add(rulescriptStart, position318)
+				add(rulescriptStart, position537)
 			}
 			return true
-		l317:
-			position, tokenIndex = position317, tokenIndex317
+		l536:
+			position, tokenIndex = position536, tokenIndex536
 			return false
 		},
-		/* 53 scriptEnd <- <(space ')')> */
+		/* 73 scriptEnd <- <(space ')')> */
 		func() bool {
-			position319, tokenIndex319 := position, tokenIndex
+			position538, tokenIndex538 := position, tokenIndex
 			{
-				position320 := position
+				position539 := position
 				if !_rules[rulespace]() {
-					goto l319
+					goto l538
 				}
 				if buffer[position] != rune(')') {
-					goto l319
+					goto l538
 				}
 				position++
-				add(rulescriptEnd, position320)
+				add(rulescriptEnd, position539)
 			}
 			return true
-		l319:
-			position, tokenIndex = position319, tokenIndex319
+		l538:
+			position, tokenIndex = position538, tokenIndex538
 			return false
 		},
-		/* 54 filterStart <- <('?' '(' space)> */
+		/* 74 subQueryStart <- <('(' space)> */
 		func() bool {
-			position321, tokenIndex321 := position, tokenIndex
+			position540, tokenIndex540 := position, tokenIndex
 			{
-				position322 := position
-				if buffer[position] != rune('?') {
-					goto l321
-				}
-				position++
+				position541 := position
 				if buffer[position] != rune('(') {
-					goto l321
+					goto l540
 				}
 				position++
 				if !_rules[rulespace]() {
-					goto l321
+					goto l540
 				}
-				add(rulefilterStart, position322)
+				add(rulesubQueryStart, position541)
 			}
 			return true
-		l321:
-			position, tokenIndex = position321, tokenIndex321
+		l540:
+			position, tokenIndex = position540, tokenIndex540
 			return false
 		},
-		/* 55 filterEnd <- <(space ')')> */
+		/* 75 subQueryEnd <- <(space ')')> */
 		func() bool {
-			position323, tokenIndex323 := position, tokenIndex
+			position542, tokenIndex542 := position, tokenIndex
 			{
-				position324 := position
+				position543 := position
 				if !_rules[rulespace]() {
-					goto l323
+					goto l542
 				}
 				if buffer[position] != rune(')') {
-					goto l323
-				}
-				position++
-				add(rulefilterEnd, position324)
-			}
-			return true
-		l323:
-			position, tokenIndex = position323, tokenIndex323
-			return false
-		},
-		/* 56 subQueryStart <- <('(' space)> */
-		func() bool {
-			position325, tokenIndex325 := position, tokenIndex
-			{
-				position326 := position
-				if buffer[position] != rune('(') {
-					goto l325
+					goto l542
 				}
 				position++
-				if !_rules[rulespace]() {
-					goto l325
-				}
-				add(rulesubQueryStart, position326)
+				add(rulesubQueryEnd, position543)
 			}
 			return true
-		l325:
-			position, tokenIndex = position325, tokenIndex325
+		l542:
+			position, tokenIndex = position542, tokenIndex542
 			return false
 		},
-		/* 57 subQueryEnd <- <(space ')')> */
+		/* 76 space <- <(' ' / (&{p.strictMode} ('\t' / '\n' / '\r')))*> */
 		func() bool {
-			position327, tokenIndex327 := position, tokenIndex
 			{
-				position328 := position
-				if !_rules[rulespace]() {
-					goto l327
-				}
-				if buffer[position] != rune(')') {
-					goto l327
+				position545 := position
+			l546:
+				{
+					position547, tokenIndex547 := position, tokenIndex
+					{
+						position548, tokenIndex548 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l549
+						}
+						position++
+						goto l548
+					l549:
+						position, tokenIndex = position548, tokenIndex548
+						if !(p.strictMode) {
+							goto l547
+						}
+						{
+							position550, tokenIndex550 := position, tokenIndex
+							if buffer[position] != rune('\t') {
+								goto l551
+							}
+							position++
+							goto l550
+						l551:
+							position, tokenIndex = position550, tokenIndex550
+							if buffer[position] != rune('\n') {
+								goto l552
+							}
+							position++
+							goto l550
+						l552:
+							position, tokenIndex = position550, tokenIndex550
+							if buffer[position] != rune('\r') {
+								goto l547
+							}
+							position++
+						}
+					l550:
+					}
+				l548:
+					goto l546
+				l547:
+					position, tokenIndex = position547, tokenIndex547
 				}
-				position++
-				add(rulesubQueryEnd, position328)
+				add(rulespace, position545)
 			}
 			return true
-		l327:
-			position, tokenIndex = position327, tokenIndex327
-			return false
 		},
-		/* 58 space <- <' '*> */
+		/* 77 segmentSpace <- <(&{p.strictMode} space)?> */
 		func() bool {
 			{
-				position330 := position
-			l331:
+				position554 := position
 				{
-					position332, tokenIndex332 := position, tokenIndex
-					if buffer[position] != rune(' ') {
-						goto l332
+					position555, tokenIndex555 := position, tokenIndex
+					if !(p.strictMode) {
+						goto l555
 					}
-					position++
-					goto l331
-				l332:
-					position, tokenIndex = position332, tokenIndex332
+					if !_rules[rulespace]() {
+						goto l555
+					}
+					goto l556
+				l555:
+					position, tokenIndex = position555, tokenIndex555
 				}
-				add(rulespace, position330)
+			l556:
+				add(rulesegmentSpace, position554)
 			}
 			return true
 		},
-		/* 60 Action0 <- <{
+		/* 79 Action0 <- <{
 		    p.root = p.deleteRootIdentifier(p.pop().(syntaxNode))
 		    p.setConnectedText(p.root)
 		}> */
@@ -3511,7 +5647,7 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 			return true
 		},
 		nil,
-		/* 62 Action1 <- <{
+		/* 81 Action1 <- <{
 		    panic(p.syntaxErr(
 		        begin, msgErrorInvalidSyntaxUnrecognizedInput, buffer))
 		}> */
@@ -3521,260 +5657,513 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 			}
 			return true
 		},
-		/* 63 Action2 <- <{
-		    p.setNodeChain()
-		    p.updateRootValueGroup()
+		/* 82 Action2 <- <{
+		    p.setNodeChain()
+		    p.updateRootValueGroup()
+		}> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 83 Action3 <- <{
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 84 Action4 <- <{
+		    p.setLastPosition(begin+2, end)
+		    p.pushRecursiveChildIdentifier(p.pop().(syntaxNode))
+		    p.setLastPosition(begin, begin+2)
+		}> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 85 Action5 <- <{
+		    p.setLastNodeText(text)
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 86 Action6 <- <{
+		    p.pushFunction(text, p.pop().(string))
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		/* 87 Action7 <- <{
+		    p.push(text)
+		}> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 88 Action8 <- <{
+		    p.setLastNodeText(text)
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 89 Action9 <- <{
+		    p.pushRootIdentifier()
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 90 Action10 <- <{
+		    p.pushCurrentRootIdentifier()
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 91 Action11 <- <{
+		    p.pushChildSingleIdentifier(text)
+		}> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 92 Action12 <- <{
+		    p.pushChildSingleIdentifier(p.unescape(text))
+		}> */
+		func() bool {
+			{
+				add(ruleAction12, position)
+			}
+			return true
+		},
+		/* 93 Action13 <- <{
+		    identifier2 := p.pop().(syntaxNode)
+		    identifier1 := p.pop().(syntaxNode)
+		    p.pushChildMultiIdentifier(identifier1, identifier2)
+		}> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 94 Action14 <- <{
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 95 Action15 <- <{
+		    p.pushChildWildcardIdentifier()
+		}> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 96 Action16 <- <{
+		    p.pushChildSingleIdentifier(p.unescapeSingleQuotedString(text))
+		}> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 97 Action17 <- <{
+		    p.pushChildSingleIdentifier(p.unescapeDoubleQuotedString(text))
+		}> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 98 Action18 <- <{
+		    appendNode := p.pop().(syntaxNode)
+		    node := p.pop().(syntaxNode)
+		    p.pushMultiSelector(node, appendNode)
+		}> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 99 Action19 <- <{
+		    childIndexUnion := p.pop().(*syntaxUnionQualifier)
+		    parentIndexUnion := p.pop().(*syntaxUnionQualifier)
+		    parentIndexUnion.merge(childIndexUnion)
+		    parentIndexUnion.setValueGroup()
+		    p.push(parentIndexUnion)
+		}> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 100 Action20 <- <{
+		    step  := p.pop().(*syntaxIndexSubscript)
+		    end   := p.pop().(*syntaxIndexSubscript)
+		    start := p.pop().(*syntaxIndexSubscript)
+
+		    if step.isOmitted {
+		        step.number = 1
+		    }
+
+		    if step.number >= 0 {
+		        p.pushSlicePositiveStepSubscript(start, end, step)
+		    } else {
+		        p.pushSliceNegativeStepSubscript(start, end, step)
+		    }
+		}> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 101 Action21 <- <{
+		    p.pushIndexSubscript(text)
+		}> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 102 Action22 <- <{
+		    p.pushWildcardSubscript()
+		}> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 103 Action23 <- <{
+		    p.setLastPosition(begin, end)
+		    p.pushUnionQualifier(p.pop().(syntaxSubscript))
+		}> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 104 Action24 <- <{
+		    p.pushIndexSubscript(`1`)
 		}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction24, position)
 			}
 			return true
 		},
-		/* 64 Action3 <- <{
-		    p.pushRecursiveChildIdentifier(p.pop().(syntaxNode))
+		/* 105 Action25 <- <{
+		    if len(text) > 0 {
+		        p.pushIndexSubscript(text)
+		    } else {
+		        p.pushOmittedIndexSubscript(`0`)
+		    }
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction25, position)
 			}
 			return true
 		},
-		/* 65 Action4 <- <{
-		    p.setLastNodeText(text)
+		/* 106 Action26 <- <{
+		    p.pushScriptQualifier(p.pop().(syntaxScript))
 		}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction26, position)
 			}
 			return true
 		},
-		/* 66 Action5 <- <{
-		    p.pushFunction(text, p.pop().(string))
+		/* 107 Action27 <- <{
+		    p.pushNotSupportedScript(text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction27, position)
 			}
 			return true
 		},
-		/* 67 Action6 <- <{
-		    p.push(text)
+		/* 108 Action28 <- <{
+		    rightScript := p.pop().(syntaxScript)
+		    leftScript := p.pop().(syntaxScript)
+		    p.pushScriptAdd(leftScript, rightScript)
 		}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction28, position)
 			}
 			return true
 		},
-		/* 68 Action7 <- <{
-		    p.setLastNodeText(text)
+		/* 109 Action29 <- <{
+		    rightScript := p.pop().(syntaxScript)
+		    leftScript := p.pop().(syntaxScript)
+		    p.pushScriptSubtract(leftScript, rightScript)
 		}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction29, position)
 			}
 			return true
 		},
-		/* 69 Action8 <- <{
-		    p.pushRootIdentifier()
+		/* 110 Action30 <- <{
+		    rightScript := p.pop().(syntaxScript)
+		    leftScript := p.pop().(syntaxScript)
+		    p.pushScriptMultiply(leftScript, rightScript)
 		}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction30, position)
 			}
 			return true
 		},
-		/* 70 Action9 <- <{
-		    p.pushCurrentRootIdentifier()
+		/* 111 Action31 <- <{
+		    rightScript := p.pop().(syntaxScript)
+		    leftScript := p.pop().(syntaxScript)
+		    p.pushScriptDivide(leftScript, rightScript)
 		}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction31, position)
 			}
 			return true
 		},
-		/* 71 Action10 <- <{
-		    p.pushChildSingleIdentifier(p.unescape(text))
+		/* 112 Action32 <- <{
+		    rightScript := p.pop().(syntaxScript)
+		    leftScript := p.pop().(syntaxScript)
+		    p.pushScriptModulo(leftScript, rightScript)
 		}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction32, position)
 			}
 			return true
 		},
-		/* 72 Action11 <- <{
-		    identifier2 := p.pop().(syntaxNode)
-		    identifier1 := p.pop().(syntaxNode)
-		    p.pushChildMultiIdentifier(identifier1, identifier2)
+		/* 113 Action33 <- <{
+		    p.pushScriptNegate(p.pop().(syntaxScript))
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction33, position)
 			}
 			return true
 		},
-		/* 73 Action12 <- <{
-		    p.pushChildWildcardIdentifier()
+		/* 114 Action34 <- <{
+		    p.pushScriptParameterLiteral(p.pop())
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction34, position)
 			}
 			return true
 		},
-		/* 74 Action13 <- <{
-		    p.pushChildSingleIdentifier(p.unescapeSingleQuotedString(text))
+		/* 115 Action35 <- <{
+		    p.pushScriptParameterLiteral(p.pop())
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction35, position)
 			}
 			return true
 		},
-		/* 75 Action14 <- <{
-		    p.pushChildSingleIdentifier(p.unescapeDoubleQuotedString(text))
+		/* 116 Action36 <- <{
+		    p.push(p.toFloat(text))
 		}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 76 Action15 <- <{
-		    childIndexUnion := p.pop().(*syntaxUnionQualifier)
-		    parentIndexUnion := p.pop().(*syntaxUnionQualifier)
-		    parentIndexUnion.merge(childIndexUnion)
-		    parentIndexUnion.setValueGroup()
-		    p.push(parentIndexUnion)
+		/* 117 Action37 <- <{
+		    p.push(true)
 		}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 77 Action16 <- <{
-		    step  := p.pop().(*syntaxIndexSubscript)
-		    end   := p.pop().(*syntaxIndexSubscript)
-		    start := p.pop().(*syntaxIndexSubscript)
-
-		    if step.isOmitted {
-		        step.number = 1
-		    }
-
-		    if step.number >= 0 {
-		        p.pushSlicePositiveStepSubscript(start, end, step)
-		    } else {
-		        p.pushSliceNegativeStepSubscript(start, end, step)
-		    }
+		/* 118 Action38 <- <{
+		    p.push(false)
 		}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 78 Action17 <- <{
-		    p.pushIndexSubscript(text)
+		/* 119 Action39 <- <{
+		    isLength := p.pop().(bool)
+		    node := p.pop().(syntaxNode)
+		    if node.isValueGroup() {
+		        panic(p.syntaxErr(
+		            begin, msgErrorInvalidSyntaxFilterValueGroup, buffer))
+		    }
+		    p.pushScriptParameterJSONPath(node, isLength)
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 79 Action18 <- <{
-		    p.pushWildcardSubscript()
+		/* 120 Action40 <- <{
+		    p.saveParams()
 		}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 80 Action19 <- <{
-		    p.pushUnionQualifier(p.pop().(syntaxSubscript))
+		/* 121 Action41 <- <{
+		    p.setNodeChain()
+		    p.updateRootValueGroup()
+		    p.loadParams()
 		}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 81 Action20 <- <{
-		    p.pushIndexSubscript(`1`)
+		/* 122 Action42 <- <{
+		    p.setLastPosition(begin+2, end)
+		    p.pushRecursiveChildIdentifier(p.pop().(syntaxNode))
+		    p.setLastPosition(begin, begin+2)
 		}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 82 Action21 <- <{
-		    if len(text) > 0 {
-		        p.pushIndexSubscript(text)
-		    } else {
-		        p.pushOmittedIndexSubscript(`0`)
-		    }
+		/* 123 Action43 <- <{
+		    p.setLastNodeText(text)
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 83 Action22 <- <{
-		    p.pushScriptQualifier(text)
+		/* 124 Action44 <- <{
+		    p.pushChildSingleIdentifier(text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 84 Action23 <- <{
+		/* 125 Action45 <- <{
 		    p.pushFilterQualifier(p.pop().(syntaxQuery))
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 85 Action24 <- <{
+		/* 126 Action46 <- <{
 		    rightQuery := p.pop().(syntaxQuery)
 		    leftQuery := p.pop().(syntaxQuery)
 		    p.pushLogicalOr(leftQuery, rightQuery)
 		}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction46, position)
 			}
 			return true
 		},
-		/* 86 Action25 <- <{
+		/* 127 Action47 <- <{
 		    rightQuery := p.pop().(syntaxQuery)
 		    leftQuery := p.pop().(syntaxQuery)
 		    p.pushLogicalAnd(leftQuery, rightQuery)
 		}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction47, position)
+			}
+			return true
+		},
+		/* 128 Action48 <- <{
+		    p.pushLogicalNot(p.pop().(syntaxQuery))
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction48, position)
+			}
+			return true
+		},
+		/* 129 Action49 <- <{
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 87 Action26 <- <{
+		/* 130 Action50 <- <{
 		    query := p.pop()
 		    p.push(query)
+		    p.setLastPosition(begin, end)
 
 		    if logicalNot, ok := query.(*syntaxLogicalNot); ok {
 		        query = (*logicalNot).query
 		    }
 		    if checkQuery, ok := query.(*syntaxBasicCompareQuery); ok {
-		        _, leftIsCurrentRoot := checkQuery.leftParam.param.(*syntaxQueryParamCurrentRoot)
-		        _, rigthIsCurrentRoot := checkQuery.rightParam.param.(*syntaxQueryParamCurrentRoot)
-		        if leftIsCurrentRoot && rigthIsCurrentRoot {
+		        if !checkQuery.leftParam.isLiteral && !checkQuery.rightParam.isLiteral {
 		            panic(p.syntaxErr(
 		                begin, msgErrorInvalidSyntaxTwoCurrentNode, buffer))
 		        }
@@ -3782,11 +6171,26 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 		}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction50, position)
+			}
+			return true
+		},
+		/* 131 Action51 <- <{
+		    logicalFunction := p.pop().(syntaxQuery)
+		    if text[0:1] == `!` {
+		        p.pushLogicalNot(logicalFunction)
+		    } else {
+		        p.push(logicalFunction)
+		    }
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction51, position)
 			}
 			return true
 		},
-		/* 88 Action27 <- <{
+		/* 132 Action52 <- <{
 		    _ = p.pop()
 		    jsonpathFilter := p.pop().(syntaxQuery)
 		    if text[0:1] == `!` {
@@ -3794,132 +6198,203 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 		    } else {
 		        p.push(jsonpathFilter)
 		    }
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction27, position)
+				add(ruleAction52, position)
 			}
 			return true
 		},
-		/* 89 Action28 <- <{
+		/* 133 Action53 <- <{
 		    rightParam := p.pop().(*syntaxBasicCompareParameter)
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareEQ(leftParam, rightParam)
 		}> */
 		func() bool {
 			{
-				add(ruleAction28, position)
+				add(ruleAction53, position)
 			}
 			return true
 		},
-		/* 90 Action29 <- <{
+		/* 134 Action54 <- <{
 		    rightParam := p.pop().(*syntaxBasicCompareParameter)
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareNE(leftParam, rightParam)
 		}> */
 		func() bool {
 			{
-				add(ruleAction29, position)
+				add(ruleAction54, position)
 			}
 			return true
 		},
-		/* 91 Action30 <- <{
+		/* 135 Action55 <- <{
 		    rightParam := p.pop().(*syntaxBasicCompareParameter)
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareGE(leftParam, rightParam)
 		}> */
 		func() bool {
 			{
-				add(ruleAction30, position)
+				add(ruleAction55, position)
 			}
 			return true
 		},
-		/* 92 Action31 <- <{
+		/* 136 Action56 <- <{
 		    rightParam := p.pop().(*syntaxBasicCompareParameter)
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareGT(leftParam, rightParam)
 		}> */
 		func() bool {
 			{
-				add(ruleAction31, position)
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 93 Action32 <- <{
+		/* 137 Action57 <- <{
 		    rightParam := p.pop().(*syntaxBasicCompareParameter)
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareLE(leftParam, rightParam)
 		}> */
 		func() bool {
 			{
-				add(ruleAction32, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 94 Action33 <- <{
+		/* 138 Action58 <- <{
 		    rightParam := p.pop().(*syntaxBasicCompareParameter)
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareLT(leftParam, rightParam)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 95 Action34 <- <{
+		/* 139 Action59 <- <{
 		    leftParam := p.pop().(*syntaxBasicCompareParameter)
 		    p.pushCompareRegex(leftParam, text)
+		    p.setLastRegexPosition(begin-1, end+1)
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction59, position)
+			}
+			return true
+		},
+		/* 140 Action60 <- <{
+		    rightParam := p.pop().(*syntaxBasicCompareParameter)
+		    leftParam := p.pop().(*syntaxBasicCompareParameter)
+		    p.pushStrictCompareEQ(leftParam, rightParam)
+		}> */
+		func() bool {
+			{
+				add(ruleAction60, position)
+			}
+			return true
+		},
+		/* 141 Action61 <- <{
+		    rightParam := p.pop().(*syntaxBasicCompareParameter)
+		    leftParam := p.pop().(*syntaxBasicCompareParameter)
+		    p.pushStrictCompareNE(leftParam, rightParam)
+		}> */
+		func() bool {
+			{
+				add(ruleAction61, position)
+			}
+			return true
+		},
+		/* 142 Action62 <- <{
+		    rightParam := p.pop().(*syntaxBasicCompareParameter)
+		    leftParam := p.pop().(*syntaxBasicCompareParameter)
+		    p.pushStrictCompareLE(leftParam, rightParam)
+		}> */
+		func() bool {
+			{
+				add(ruleAction62, position)
+			}
+			return true
+		},
+		/* 143 Action63 <- <{
+		    rightParam := p.pop().(*syntaxBasicCompareParameter)
+		    leftParam := p.pop().(*syntaxBasicCompareParameter)
+		    p.pushStrictCompareLT(leftParam, rightParam)
+		}> */
+		func() bool {
+			{
+				add(ruleAction63, position)
+			}
+			return true
+		},
+		/* 144 Action64 <- <{
+		    rightParam := p.pop().(*syntaxBasicCompareParameter)
+		    leftParam := p.pop().(*syntaxBasicCompareParameter)
+		    p.pushStrictCompareGE(leftParam, rightParam)
+		}> */
+		func() bool {
+			{
+				add(ruleAction64, position)
+			}
+			return true
+		},
+		/* 145 Action65 <- <{
+		    rightParam := p.pop().(*syntaxBasicCompareParameter)
+		    leftParam := p.pop().(*syntaxBasicCompareParameter)
+		    p.pushStrictCompareGT(leftParam, rightParam)
+		}> */
+		func() bool {
+			{
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 96 Action35 <- <{
+		/* 146 Action66 <- <{
 		    p.pushCompareParameterLiteral(p.pop())
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 97 Action36 <- <{
+		/* 147 Action67 <- <{
 		    p.pushCompareParameterLiteral(p.pop())
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction67, position)
 			}
 			return true
 		},
-		/* 98 Action37 <- <{
+		/* 148 Action68 <- <{
 		    isLiteral := p.pop().(bool)
 		    param := p.pop().(syntaxQueryJSONPathParameter)
-		    if param.isValueGroupParameter() {
+		    if param.isValueGroupParameter() && !p.isValueGroupCompareAllowed() {
 		        panic(p.syntaxErr(
 		            begin, msgErrorInvalidSyntaxFilterValueGroup, buffer))
 		    }
 		    p.pushBasicCompareParameter(param.(syntaxQuery), isLiteral)
+		    p.setLastPosition(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction68, position)
 			}
 			return true
 		},
-		/* 99 Action38 <- <{
+		/* 149 Action69 <- <{
 		    p.saveParams()
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 100 Action39 <- <{
+		/* 150 Action70 <- <{
 		    p.loadParams()
 
 		    node := p.pop().(syntaxNode)
@@ -3939,61 +6414,147 @@ func (p *pegJSONPathParser) Init(options ...func(*pegJSONPathParser) error) erro
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction70, position)
+			}
+			return true
+		},
+		/* 151 Action71 <- <{
+		    p.pushFunctionExtension(begin, buffer)
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction71, position)
+			}
+			return true
+		},
+		/* 152 Action72 <- <{
+		    p.pushFunctionExtension(begin, buffer)
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction72, position)
+			}
+			return true
+		},
+		/* 153 Action73 <- <{
+		    p.push(text)
+		}> */
+		func() bool {
+			{
+				add(ruleAction73, position)
+			}
+			return true
+		},
+		/* 154 Action74 <- <{
+		    p.push(text)
+		}> */
+		func() bool {
+			{
+				add(ruleAction74, position)
+			}
+			return true
+		},
+		/* 155 Action75 <- <{
+		    p.pushCompareParameterLiteral(p.pop())
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 101 Action40 <- <{
+		/* 156 Action76 <- <{
+		    isLiteral := p.pop().(bool)
+		    p.pushBasicCompareParameter(p.pop().(syntaxQuery), isLiteral)
+		    p.setLastPosition(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction76, position)
+			}
+			return true
+		},
+		/* 157 Action77 <- <{
 		    p.push(p.toFloat(text))
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 102 Action41 <- <{
+		/* 158 Action78 <- <{
+		    p.push(p.toFloat(text))
+		}> */
+		func() bool {
+			{
+				add(ruleAction78, position)
+			}
+			return true
+		},
+		/* 159 Action79 <- <{
 		    p.push(true)
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 103 Action42 <- <{
+		/* 160 Action80 <- <{
 		    p.push(false)
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction80, position)
+			}
+			return true
+		},
+		/* 161 Action81 <- <{
+		    p.push(p.unescapeSingleQuotedString(text))
+		}> */
+		func() bool {
+			{
+				add(ruleAction81, position)
+			}
+			return true
+		},
+		/* 162 Action82 <- <{
+		    p.push(p.unescapeDoubleQuotedString(text))
+		}> */
+		func() bool {
+			{
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 104 Action43 <- <{
+		/* 163 Action83 <- <{
 		    p.push(p.unescape(text))
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 105 Action44 <- <{
+		/* 164 Action84 <- <{
 		    p.push(p.unescape(text))
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 106 Action45 <- <{
+		/* 165 Action85 <- <{
 		    p.push(nil)
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction85, position)
 			}
 			return true
 		},
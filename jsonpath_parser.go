@@ -2,6 +2,7 @@ package jsonpath
 
 import (
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"strconv"
 )
@@ -14,6 +15,21 @@ type jsonPathParser struct {
 	filterFunctions    map[string]func(interface{}) (interface{}, error)
 	aggregateFunctions map[string]func([]interface{}) (interface{}, error)
 	accessorMode       bool
+	strictMode         bool
+	valueGroupCompare  valueGroupCompareMode
+	hasRootReference   bool
+	positions          map[interface{}]astPosition
+}
+
+// astPosition represents the range of the syntax in the JSONPath.
+type astPosition struct {
+	begin int
+	end   int
+}
+
+// astRemovedRoot is the key of the position of the root identifier removed from the head of the node.
+type astRemovedRoot struct {
+	node syntaxNode
 }
 
 func (p *jsonPathParser) saveParams() {
@@ -40,6 +56,33 @@ func (p *jsonPathParser) pop() interface{} {
 	return param
 }
 
+// setLastPosition saves the position of the last pushed node, query, subscript or script.
+// The position of the compare parameter is saved for its query.
+func (p *jsonPathParser) setLastPosition(begin, end int) {
+	param := p.params[len(p.params)-1]
+	if compareParam, ok := param.(*syntaxBasicCompareParameter); ok {
+		param = compareParam.param
+	}
+	p.setPosition(param, begin, end)
+}
+
+// setLastRegexPosition saves the position of the regular expression of the last pushed comparison.
+func (p *jsonPathParser) setLastRegexPosition(begin, end int) {
+	switch query := p.params[len(p.params)-1].(type) {
+	case *syntaxBasicCompareQuery:
+		p.setPosition(query.rightParam.param, begin, end)
+	case *syntaxValueGroupCompareQuery:
+		p.setPosition(query.rightParam.param, begin, end)
+	}
+}
+
+func (p *jsonPathParser) setPosition(param interface{}, begin, end int) {
+	if p.positions == nil {
+		p.positions = map[interface{}]astPosition{}
+	}
+	p.positions[param] = astPosition{begin: begin, end: end}
+}
+
 func (p *jsonPathParser) toInt(text string) int {
 	value, err := strconv.Atoi(text)
 	if err != nil {
@@ -163,6 +206,12 @@ func (p *jsonPathParser) setNodeChain() {
 
 			nextNode := next.(syntaxNode)
 
+			if multiSelector, ok := last.(*syntaxMultiSelectorQualifier); ok {
+				for _, selector := range multiSelector.selectors {
+					selector.setNext(nextNode)
+				}
+			}
+
 			if multiIdentifier, ok := last.(*syntaxChildMultiIdentifier); ok {
 				for _, singleIdentifier := range multiIdentifier.identifiers {
 					singleIdentifier.setNext(nextNode)
@@ -223,6 +272,9 @@ func (p *jsonPathParser) deleteRootIdentifier(targetNode syntaxNode) syntaxNode
 			if targetNode.isValueGroup() {
 				targetNode.getNext().setValueGroup()
 			}
+			if position, ok := p.positions[targetNode]; ok {
+				p.positions[astRemovedRoot{node: targetNode.getNext()}] = position
+			}
 			targetNode.setNext(nil)
 			targetNode = targetNode.getNext()
 		}
@@ -324,6 +376,10 @@ func (p *jsonPathParser) pushChildSingleIdentifier(text string) {
 	identifier.errorRuntime = &errorBasicRuntime{
 		node: identifier.syntaxBasicNode,
 	}
+	identifier.errorMemberRuntime = &errorBasicRuntime{
+		node: identifier.syntaxBasicNode,
+		path: joinMapPath(``, identifier.identifier),
+	}
 
 	p.push(&identifier)
 }
@@ -408,7 +464,8 @@ func (p *jsonPathParser) pushChildWildcardIdentifier() {
 func (p *jsonPathParser) pushRecursiveChildIdentifier(node syntaxNode) {
 	var nextMapRequired, nextListRequired bool
 	switch node.(type) {
-	case *syntaxChildWildcardIdentifier, *syntaxChildMultiIdentifier, *syntaxFilterQualifier:
+	case *syntaxChildWildcardIdentifier, *syntaxChildMultiIdentifier, *syntaxFilterQualifier,
+		*syntaxScriptQualifier, *syntaxMultiSelectorQualifier:
 		nextMapRequired = true
 		nextListRequired = true
 	case *syntaxChildSingleIdentifier:
@@ -447,6 +504,7 @@ func (p *jsonPathParser) pushUnionQualifier(subscript syntaxSubscript) {
 	qualifier.errorRuntime = &errorBasicRuntime{
 		node: qualifier.syntaxBasicNode,
 	}
+	qualifier.setIndexErrors(qualifier.subscripts)
 
 	p.push(&qualifier)
 }
@@ -467,13 +525,118 @@ func (p *jsonPathParser) pushFilterQualifier(query syntaxQuery) {
 	p.push(&qualifier)
 }
 
-func (p *jsonPathParser) pushScriptQualifier(text string) {
+func (p *jsonPathParser) pushMultiSelector(node syntaxNode, appendNode syntaxNode) {
+	if multiSelector, ok := node.(*syntaxMultiSelectorQualifier); ok {
+		multiSelector.selectors = append(multiSelector.selectors, appendNode)
+		p.push(multiSelector)
+		return
+	}
+
+	qualifier := syntaxMultiSelectorQualifier{
+		syntaxBasicNode: &syntaxBasicNode{
+			valueGroup:   true,
+			accessorMode: p.accessorMode,
+		},
+		selectors: []syntaxNode{
+			node,
+			appendNode,
+		},
+	}
+
+	qualifier.errorRuntime = &errorBasicRuntime{
+		node: qualifier.syntaxBasicNode,
+	}
+
+	p.push(&qualifier)
+}
+
+func (p *jsonPathParser) pushScriptQualifier(script syntaxScript) {
+	qualifier := syntaxScriptQualifier{
+		syntaxBasicNode: &syntaxBasicNode{
+			accessorMode: p.accessorMode,
+		},
+		script: script,
+	}
+
+	qualifier.errorRuntime = &errorBasicRuntime{
+		node: qualifier.syntaxBasicNode,
+	}
+
+	p.push(&qualifier)
+}
+
+func (p *jsonPathParser) pushNotSupportedScript(text string) {
 	panic(ErrorNotSupported{
 		feature: `script`,
 		path:    `[(` + text + `)]`,
 	})
 }
 
+func (p *jsonPathParser) pushScriptAdd(leftScript, rightScript syntaxScript) {
+	p.push(&syntaxScriptAdd{
+		syntaxBasicScriptArithmetic: &syntaxBasicScriptArithmetic{
+			leftScript:  leftScript,
+			rightScript: rightScript,
+		},
+	})
+}
+
+func (p *jsonPathParser) pushScriptSubtract(leftScript, rightScript syntaxScript) {
+	p.push(&syntaxScriptSubtract{
+		syntaxBasicScriptArithmetic: &syntaxBasicScriptArithmetic{
+			leftScript:  leftScript,
+			rightScript: rightScript,
+		},
+	})
+}
+
+func (p *jsonPathParser) pushScriptMultiply(leftScript, rightScript syntaxScript) {
+	p.push(&syntaxScriptMultiply{
+		syntaxBasicScriptArithmetic: &syntaxBasicScriptArithmetic{
+			leftScript:  leftScript,
+			rightScript: rightScript,
+		},
+	})
+}
+
+func (p *jsonPathParser) pushScriptDivide(leftScript, rightScript syntaxScript) {
+	p.push(&syntaxScriptDivide{
+		syntaxBasicScriptArithmetic: &syntaxBasicScriptArithmetic{
+			leftScript:  leftScript,
+			rightScript: rightScript,
+		},
+	})
+}
+
+func (p *jsonPathParser) pushScriptModulo(leftScript, rightScript syntaxScript) {
+	p.push(&syntaxScriptModulo{
+		syntaxBasicScriptArithmetic: &syntaxBasicScriptArithmetic{
+			leftScript:  leftScript,
+			rightScript: rightScript,
+		},
+	})
+}
+
+func (p *jsonPathParser) pushScriptNegate(script syntaxScript) {
+	p.push(&syntaxScriptNegate{script: script})
+}
+
+func (p *jsonPathParser) pushScriptParameterLiteral(literal interface{}) {
+	p.push(&syntaxScriptParamLiteral{literal: literal})
+}
+
+func (p *jsonPathParser) pushScriptParameterJSONPath(node syntaxNode, isLength bool) {
+	_, isRoot := node.(*syntaxRootIdentifier)
+	p.hasRootReference = p.hasRootReference || isRoot
+	param := &syntaxScriptParamJSONPath{
+		param:    p.deleteRootIdentifier(node),
+		isRoot:   isRoot,
+		isLength: isLength,
+	}
+	p.updateAccessorMode(param.param, false)
+	p.push(param)
+}
+
 func (p *jsonPathParser) pushSlicePositiveStepSubscript(start, end, step *syntaxIndexSubscript) {
 	p.push(&syntaxSlicePositiveStepSubscript{
 		syntaxBasicSubscript: &syntaxBasicSubscript{
@@ -497,11 +660,19 @@ func (p *jsonPathParser) pushSliceNegativeStepSubscript(start, end, step *syntax
 }
 
 func (p *jsonPathParser) _pushIndexSubscript(text string, isOmitted bool) {
+	number := p.toInt(text)
+	if p.strictMode && (int64(number) > maxIJSONInteger || int64(number) < -maxIJSONInteger) {
+		panic(ErrorInvalidArgument{
+			argument: text,
+			err:      fmt.Errorf(`out of the I-JSON integer range`),
+		})
+	}
+
 	p.push(&syntaxIndexSubscript{
 		syntaxBasicSubscript: &syntaxBasicSubscript{
 			valueGroup: false,
 		},
-		number:    p.toInt(text),
+		number:    number,
 		isOmitted: isOmitted,
 	})
 }
@@ -540,10 +711,33 @@ func (p *jsonPathParser) pushLogicalNot(query syntaxQuery) {
 	p.push(&syntaxLogicalNot{query: query})
 }
 
+func (p *jsonPathParser) isValueGroupCompareAllowed() bool {
+	return !p.strictMode && p.valueGroupCompare != valueGroupCompareNone
+}
+
+func (p *jsonPathParser) _createValueGroupCompareQuery(
+	leftParam, rightParam *syntaxBasicCompareParameter,
+	comparator syntaxComparator, isNegated bool) syntaxQuery {
+
+	return &syntaxValueGroupCompareQuery{
+		leftParam:         leftParam,
+		rightParam:        rightParam,
+		isLeftValueGroup:  !p.isValueTypeParameter(leftParam),
+		isRightValueGroup: !p.isValueTypeParameter(rightParam),
+		comparator:        comparator,
+		isNegated:         isNegated,
+		isAll:             p.valueGroupCompare == valueGroupCompareAll,
+	}
+}
+
 func (p *jsonPathParser) _createBasicCompareQuery(
 	leftParam, rightParam *syntaxBasicCompareParameter,
 	comparator syntaxComparator) syntaxQuery {
 
+	if !p.isValueTypeParameter(leftParam) || !p.isValueTypeParameter(rightParam) {
+		return p._createValueGroupCompareQuery(leftParam, rightParam, comparator, false)
+	}
+
 	return &syntaxBasicCompareQuery{
 		leftParam:  leftParam,
 		rightParam: rightParam,
@@ -558,6 +752,10 @@ func (p *jsonPathParser) pushCompareEQ(
 
 func (p *jsonPathParser) pushCompareNE(
 	leftParam, rightParam *syntaxBasicCompareParameter) {
+	if !p.isValueTypeParameter(leftParam) || !p.isValueTypeParameter(rightParam) {
+		p.push(p._createValueGroupCompareQuery(leftParam, rightParam, &syntaxCompareEQ{}, true))
+		return
+	}
 	p.push(&syntaxLogicalNot{
 		query: p._createBasicCompareQuery(leftParam, rightParam, &syntaxCompareEQ{}),
 	})
@@ -605,6 +803,51 @@ func (p *jsonPathParser) pushCompareRegex(
 		}))
 }
 
+func (p *jsonPathParser) _createStrictCompareQuery(
+	leftParam, rightParam *syntaxBasicCompareParameter,
+	isLess, isEqual, isGreater bool) syntaxQuery {
+
+	return &syntaxStrictCompareQuery{
+		leftParam:  leftParam,
+		rightParam: rightParam,
+		isLess:     isLess,
+		isEqual:    isEqual,
+		isGreater:  isGreater,
+	}
+}
+
+func (p *jsonPathParser) pushStrictCompareEQ(
+	leftParam, rightParam *syntaxBasicCompareParameter) {
+	p.push(p._createStrictCompareQuery(leftParam, rightParam, false, true, false))
+}
+
+func (p *jsonPathParser) pushStrictCompareNE(
+	leftParam, rightParam *syntaxBasicCompareParameter) {
+	p.push(&syntaxLogicalNot{
+		query: p._createStrictCompareQuery(leftParam, rightParam, false, true, false),
+	})
+}
+
+func (p *jsonPathParser) pushStrictCompareLT(
+	leftParam, rightParam *syntaxBasicCompareParameter) {
+	p.push(p._createStrictCompareQuery(leftParam, rightParam, true, false, false))
+}
+
+func (p *jsonPathParser) pushStrictCompareLE(
+	leftParam, rightParam *syntaxBasicCompareParameter) {
+	p.push(p._createStrictCompareQuery(leftParam, rightParam, true, true, false))
+}
+
+func (p *jsonPathParser) pushStrictCompareGT(
+	leftParam, rightParam *syntaxBasicCompareParameter) {
+	p.push(p._createStrictCompareQuery(leftParam, rightParam, false, false, true))
+}
+
+func (p *jsonPathParser) pushStrictCompareGE(
+	leftParam, rightParam *syntaxBasicCompareParameter) {
+	p.push(p._createStrictCompareQuery(leftParam, rightParam, false, true, true))
+}
+
 func (p *jsonPathParser) pushBasicCompareParameter(
 	parameter syntaxQuery, isLiteral bool) {
 	p.push(&syntaxBasicCompareParameter{
@@ -620,7 +863,95 @@ func (p *jsonPathParser) pushCompareParameterLiteral(text interface{}) {
 		}, true)
 }
 
+func (p *jsonPathParser) isValueTypeParameter(param *syntaxBasicCompareParameter) bool {
+	if jsonpathParam, ok := param.param.(syntaxQueryJSONPathParameter); ok {
+		return !jsonpathParam.isValueGroupParameter()
+	}
+	return true
+}
+
+func (p *jsonPathParser) isNodesTypeParameter(param *syntaxBasicCompareParameter) bool {
+	_, ok := param.param.(syntaxQueryJSONPathParameter)
+	return ok
+}
+
+func (p *jsonPathParser) pushFunctionExtension(begin int, buffer string) {
+	var params []*syntaxBasicCompareParameter
+	var name string
+	for {
+		param := p.pop()
+		if functionName, ok := param.(string); ok {
+			name = functionName
+			break
+		}
+		params = append([]*syntaxBasicCompareParameter{param.(*syntaxBasicCompareParameter)}, params...)
+	}
+
+	basicFunction := &syntaxBasicFunctionQuery{
+		params:    params,
+		isLiteral: true,
+	}
+	for _, param := range params {
+		basicFunction.isLiteral = basicFunction.isLiteral && param.isLiteral
+	}
+
+	checkParams := func(count int, checkType func(*syntaxBasicCompareParameter) bool) {
+		if len(params) != count {
+			panic(p.syntaxErr(begin, msgErrorInvalidSyntaxFunctionArgCount, buffer))
+		}
+		for _, param := range params {
+			if !checkType(param) {
+				panic(p.syntaxErr(begin, msgErrorInvalidSyntaxFunctionArgType, buffer))
+			}
+		}
+	}
+
+	var function syntaxQuery
+	switch name {
+	case `length`:
+		checkParams(1, p.isValueTypeParameter)
+		function = &syntaxFunctionLength{
+			syntaxBasicFunctionQuery: basicFunction,
+		}
+	case `count`:
+		checkParams(1, p.isNodesTypeParameter)
+		function = &syntaxFunctionCount{
+			syntaxBasicFunctionQuery: basicFunction,
+			isValueGroupParam:        !p.isValueTypeParameter(params[0]),
+		}
+	case `value`:
+		checkParams(1, p.isNodesTypeParameter)
+		function = &syntaxFunctionValue{
+			syntaxBasicFunctionQuery: basicFunction,
+			isValueGroupParam:        !p.isValueTypeParameter(params[0]),
+		}
+	case `match`, `search`:
+		checkParams(2, p.isValueTypeParameter)
+		regexFunction := &syntaxFunctionRegex{
+			syntaxBasicFunctionQuery: basicFunction,
+			isFullMatch:              name == `match`,
+		}
+		if literalParam, ok := params[1].param.(*syntaxQueryParamLiteral); ok {
+			if pattern, ok := literalParam.literal[0].(string); ok {
+				regex, err := regexp.Compile(convertIRegexp(pattern, regexFunction.isFullMatch))
+				if err != nil {
+					panic(ErrorInvalidArgument{
+						argument: pattern,
+						err:      err,
+					})
+				}
+				regexFunction.regex = regex
+			}
+		}
+		p.push(regexFunction)
+		return
+	}
+
+	p.pushBasicCompareParameter(function, basicFunction.isLiteral)
+}
+
 func (p *jsonPathParser) pushCompareParameterRoot(node syntaxNode) {
+	p.hasRootReference = true
 	param := &syntaxQueryParamRoot{
 		param: node,
 	}
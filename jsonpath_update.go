@@ -0,0 +1,167 @@
+package jsonpath
+
+import "context"
+
+// Set sets the value to the results of the given JSONPath,
+// and returns the updated root and the number of the effectively updated locations.
+// The missing members are created if the JSONPath returns a single value, in the same way as the upsert mode.
+func Set(jsonPath string, src interface{}, value interface{}, config ...Config) (interface{}, int, error) {
+	return modify(jsonPath, src, `set`, true, func(accessor Accessor) error {
+		return accessor.checkValue(value)
+	}, func(accessor Accessor, _ string) error {
+		accessor.Set(value)
+		return nil
+	}, config...)
+}
+
+// Update replaces the results of the given JSONPath with the values returned by the function,
+// and returns the updated root and the number of the effectively updated locations.
+// The error returned by the function, or the value that cannot be set to the Go value, stops the update.
+func Update(
+	jsonPath string, src interface{}, function func(interface{}) (interface{}, error),
+	config ...Config) (interface{}, int, error) {
+
+	return modify(jsonPath, src, `update`, false, nil, func(accessor Accessor, path string) error {
+		value, err := function(accessor.Get())
+		if err != nil {
+			return err
+		}
+		if err := accessor.checkValue(value); err != nil {
+			return ErrorNotSettable{operation: `update`, path: path, reason: err.Error()}
+		}
+		accessor.Set(value)
+		return nil
+	}, config...)
+}
+
+// Delete deletes the results of the given JSONPath from their parents,
+// and returns the updated root and the number of the deleted locations.
+func Delete(jsonPath string, src interface{}, config ...Config) (interface{}, int, error) {
+	return modify(jsonPath, src, `delete`, false, nil, func(accessor Accessor, _ string) error {
+		accessor.Delete()
+		return nil
+	}, config...)
+}
+
+// modify applies the operation to the accessors of the results.
+// All of the results are checked before the operation is applied,
+// so that the source is not modified if any of the results cannot be updated,
+// or cannot accept the value by the check.
+// The results at the same location are deduplicated by their normalized paths,
+// so that the operation is applied and counted once for each location.
+// The results under the modified locations are skipped, since they are already replaced or removed
+// with their ancestors, so that the number counts only the effective modifications.
+func modify(
+	jsonPath string, src interface{}, operation string, isUpsert bool,
+	check func(Accessor) error, apply func(Accessor, string) error, config ...Config) (interface{}, int, error) {
+
+	var modifyConfig Config
+	if len(config) > 0 {
+		modifyConfig = config[0]
+	}
+	modifyConfig.accessorMode = true
+	modifyConfig.pathMode = true
+
+	parsed, err := parse(jsonPath, modifyConfig)
+	if err != nil {
+		return src, 0, err
+	}
+
+	newRoot := src
+	container := bufferContainer{
+		pathMode:   true,
+		path:       `$`,
+		upsertMode: isUpsert && isSingleValueNode(parsed.root),
+		setter: func(value interface{}) {
+			newRoot = value
+		},
+	}
+	if modifyConfig.patchRecorder != nil {
+		container.recorder = modifyConfig.patchRecorder
+		container.pointer = getRootPointer
+		container.getRoot = func() interface{} { return newRoot }
+	}
+
+	retrieveErr, abortErr := retrieveWithLimits(
+		context.Background(), modifyConfig.limits, parsed.root, src, &container)
+	if abortErr != nil {
+		return src, 0, abortErr
+	}
+	if retrieveErr != nil {
+		if modifyConfig.strictMode {
+			return src, 0, nil
+		}
+		return src, 0, retrieveErr.(error)
+	}
+
+	targets := make([]PathValue, 0, len(container.result))
+	locations := make(map[string]struct{}, len(container.result))
+	for _, result := range container.result {
+		pathValue := result.(PathValue)
+		if _, ok := locations[pathValue.Path]; ok {
+			continue
+		}
+		locations[pathValue.Path] = struct{}{}
+		accessor := pathValue.Value.(Accessor)
+		if !isModifiable(operation, accessor) {
+			return src, 0, ErrorNotSettable{
+				operation: operation,
+				path:      pathValue.Path,
+				reason:    accessor.getReason(operation),
+			}
+		}
+		if check != nil {
+			if err := check(accessor); err != nil {
+				return src, 0, ErrorNotSettable{
+					operation: operation,
+					path:      pathValue.Path,
+					reason:    err.Error(),
+				}
+			}
+		}
+		targets = append(targets, pathValue)
+	}
+
+	var count int
+	modifiedPaths := make(map[string]struct{})
+	for _, target := range targets {
+		if isUnderPaths(target.Path, modifiedPaths) {
+			continue
+		}
+		if err := apply(target.Value.(Accessor), target.Path); err != nil {
+			return newRoot, count, err
+		}
+		count++
+		modifiedPaths[target.Path] = struct{}{}
+	}
+
+	return newRoot, count, nil
+}
+
+// isUnderPaths returns whether the normalized path is the descendant of any of the given normalized paths.
+func isUnderPaths(path string, paths map[string]struct{}) bool {
+	var isQuoted bool
+	for index := 1; index < len(path); index++ {
+		switch path[index] {
+		case '\\':
+			index++
+		case '\'':
+			isQuoted = !isQuoted
+		case '[':
+			if isQuoted {
+				continue
+			}
+			if _, ok := paths[path[:index]]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isModifiable(operation string, accessor Accessor) bool {
+	if operation == `delete` {
+		return accessor.Delete != nil
+	}
+	return accessor.Set != nil
+}
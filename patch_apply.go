@@ -0,0 +1,197 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ApplyPatch applies the operations of JSON Patch (RFC 6902) to the JSON unmarshalled into interface{},
+// and returns the updated root.
+// The objects and the arrays are updated in place, and the root is replaced if the operation targets the root.
+// The operations before the failed one remain applied.
+func ApplyPatch(src interface{}, operations []PatchOperation) (interface{}, error) {
+	for _, operation := range operations {
+		var err error
+		if src, err = applyPatchOperation(src, operation); err != nil {
+			return src, err
+		}
+	}
+	return src, nil
+}
+
+func applyPatchOperation(src interface{}, operation PatchOperation) (interface{}, error) {
+	tokens, err := splitPointer(operation.Path)
+	if err != nil {
+		return src, createErrorPatchFailed(operation, operation.Path, `invalid pointer`)
+	}
+
+	switch operation.Op {
+	case `add`:
+		return updatePatchTarget(src, tokens, operation, operation.Value, addPatchValue)
+
+	case `remove`:
+		return updatePatchTarget(src, tokens, operation, nil, removePatchValue)
+
+	case `replace`:
+		return updatePatchTarget(src, tokens, operation, operation.Value, replacePatchValue)
+
+	case `move`, `copy`:
+		fromTokens, err := splitPointer(operation.From)
+		if err != nil {
+			return src, createErrorPatchFailed(operation, operation.From, `invalid pointer`)
+		}
+		value, ok := getPointerValue(src, fromTokens)
+		if !ok {
+			return src, createErrorPatchFailed(operation, operation.From, `member did not exist`)
+		}
+		if operation.Op == `copy` {
+			return updatePatchTarget(src, tokens, operation, copyPatchValue(value), addPatchValue)
+		}
+		if operation.Path == operation.From {
+			return src, nil
+		}
+		if strings.HasPrefix(operation.Path, operation.From+`/`) {
+			return src, createErrorPatchFailed(operation, operation.Path, `moved into its child`)
+		}
+		src, err := updatePatchTarget(src, fromTokens, operation, nil, removePatchValue)
+		if err != nil {
+			return src, err
+		}
+		return updatePatchTarget(src, tokens, operation, value, addPatchValue)
+
+	case `test`:
+		value, ok := getPointerValue(src, tokens)
+		if !ok {
+			return src, createErrorPatchFailed(operation, operation.Path, `member did not exist`)
+		}
+		actualJSON, actualErr := json.Marshal(value)
+		expectedJSON, expectedErr := json.Marshal(operation.Value)
+		if actualErr != nil || expectedErr != nil || string(actualJSON) != string(expectedJSON) {
+			return src, createErrorPatchFailed(operation, operation.Path, `test failed`)
+		}
+		return src, nil
+	}
+
+	return src, createErrorPatchFailed(operation, operation.Path, `unknown op`)
+}
+
+// updatePatchTarget applies the update to the parent of the target, and returns the updated root.
+// The parents are set again on the way back, because the arrays can be replaced by the update.
+func updatePatchTarget(
+	src interface{}, tokens []string, operation PatchOperation, value interface{},
+	update func(interface{}, string, interface{}) (interface{}, string)) (interface{}, error) {
+
+	if len(tokens) == 0 {
+		if operation.Op == `remove` {
+			return src, createErrorPatchFailed(operation, operation.Path, `root could not be removed`)
+		}
+		return value, nil
+	}
+
+	if len(tokens) == 1 {
+		updated, reason := update(src, tokens[0], value)
+		if len(reason) > 0 {
+			return src, createErrorPatchFailed(operation, operation.Path, reason)
+		}
+		return updated, nil
+	}
+
+	child, ok := getPointerValue(src, tokens[:1])
+	if !ok {
+		return src, createErrorPatchFailed(operation, operation.Path, `member did not exist`)
+	}
+	updatedChild, err := updatePatchTarget(child, tokens[1:], operation, value, update)
+	if err != nil {
+		return src, err
+	}
+	updated, _ := replacePatchValue(src, tokens[0], updatedChild)
+	return updated, nil
+}
+
+func addPatchValue(parent interface{}, token string, value interface{}) (interface{}, string) {
+	switch typedParent := parent.(type) {
+	case map[string]interface{}:
+		typedParent[token] = value
+		return typedParent, ``
+	case []interface{}:
+		if token == `-` {
+			return append(typedParent, value), ``
+		}
+		index, ok := getPointerIndex(token)
+		if !ok || index > len(typedParent) {
+			return parent, `index out of range`
+		}
+		newList := make([]interface{}, 0, len(typedParent)+1)
+		newList = append(newList, typedParent[:index]...)
+		newList = append(newList, value)
+		newList = append(newList, typedParent[index:]...)
+		return newList, ``
+	}
+	return parent, `parent is not a container`
+}
+
+func removePatchValue(parent interface{}, token string, _ interface{}) (interface{}, string) {
+	switch typedParent := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := typedParent[token]; !ok {
+			return parent, `member did not exist`
+		}
+		delete(typedParent, token)
+		return typedParent, ``
+	case []interface{}:
+		index, ok := getPointerIndex(token)
+		if !ok || index >= len(typedParent) {
+			return parent, `index out of range`
+		}
+		newList := make([]interface{}, 0, len(typedParent)-1)
+		newList = append(newList, typedParent[:index]...)
+		newList = append(newList, typedParent[index+1:]...)
+		return newList, ``
+	}
+	return parent, `parent is not a container`
+}
+
+func replacePatchValue(parent interface{}, token string, value interface{}) (interface{}, string) {
+	switch typedParent := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := typedParent[token]; !ok {
+			return parent, `member did not exist`
+		}
+		typedParent[token] = value
+		return typedParent, ``
+	case []interface{}:
+		index, ok := getPointerIndex(token)
+		if !ok || index >= len(typedParent) {
+			return parent, `index out of range`
+		}
+		typedParent[index] = value
+		return typedParent, ``
+	}
+	return parent, `parent is not a container`
+}
+
+func copyPatchValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		newMap := make(map[string]interface{}, len(typedValue))
+		for key, member := range typedValue {
+			newMap[key] = copyPatchValue(member)
+		}
+		return newMap
+	case []interface{}:
+		newList := make([]interface{}, len(typedValue))
+		for index, element := range typedValue {
+			newList[index] = copyPatchValue(element)
+		}
+		return newList
+	}
+	return value
+}
+
+func createErrorPatchFailed(operation PatchOperation, path string, reason string) ErrorPatchFailed {
+	return ErrorPatchFailed{
+		op:     operation.Op,
+		path:   path,
+		reason: reason,
+	}
+}
@@ -0,0 +1,34 @@
+package jsonpath
+
+import "encoding/json"
+
+// PatchOperation represents an operation of JSON Patch (RFC 6902).
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// MarshalJSON returns the operation with the members required by its op.
+// The value of add, replace and test is kept even if it is null.
+func (o PatchOperation) MarshalJSON() ([]byte, error) {
+	switch o.Op {
+	case `add`, `replace`, `test`:
+		return json.Marshal(struct {
+			Op    string      `json:"op"`
+			Path  string      `json:"path"`
+			Value interface{} `json:"value"`
+		}{o.Op, o.Path, o.Value})
+	case `move`, `copy`:
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			From string `json:"from"`
+			Path string `json:"path"`
+		}{o.Op, o.From, o.Path})
+	}
+	return json.Marshal(struct {
+		Op   string `json:"op"`
+		Path string `json:"path"`
+	}{o.Op, o.Path})
+}
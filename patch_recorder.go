@@ -0,0 +1,183 @@
+package jsonpath
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// PatchRecorder records the updates through the accessors as the operations of JSON Patch (RFC 6902).
+// It is not safe for concurrent use.
+type PatchRecorder struct {
+	operations []PatchOperation
+}
+
+// Operations returns the recorded operations in the order of the updates.
+func (r *PatchRecorder) Operations() []PatchOperation {
+	return r.operations
+}
+
+// record copies the value, so that the operation is not changed by the following updates.
+func (r *PatchRecorder) record(op string, path string, value interface{}) {
+	r.operations = append(r.operations, PatchOperation{
+		Op:    op,
+		Path:  path,
+		Value: copyPatchValue(value),
+	})
+}
+
+// recordMapAccessor records the updates of the member.
+// The updates of the object detached from the root by the other updates are not recorded.
+func (r *PatchRecorder) recordMapAccessor(
+	accessor Accessor, getRoot func() interface{}, srcMap map[string]interface{}, key string,
+	pointer func() string) Accessor {
+
+	set, remove := accessor.Set, accessor.Delete
+	accessor.Set = func(value interface{}) {
+		op := `replace`
+		if _, ok := srcMap[key]; !ok {
+			op = `add`
+		}
+		path := pointer()
+		set(value)
+		if r.isAttached(getRoot(), path, srcMap) {
+			r.record(op, path, value)
+		}
+	}
+	accessor.Delete = func() {
+		if _, ok := srcMap[key]; !ok {
+			return
+		}
+		path := pointer()
+		isAttached := r.isAttached(getRoot(), path, srcMap)
+		remove()
+		if isAttached {
+			r.record(`remove`, path, nil)
+		}
+	}
+	return accessor
+}
+
+// recordListAccessor records the updates of the element.
+// The updates of the array detached from the root by the other updates are not recorded.
+func (r *PatchRecorder) recordListAccessor(
+	accessor Accessor, getRoot func() interface{}, list *accessorList, index int,
+	pointer func() string) Accessor {
+
+	set, remove := accessor.Set, accessor.Delete
+	accessor.Set = func(value interface{}) {
+		if _, ok := list.getPosition(index); !ok {
+			return
+		}
+		path := pointer()
+		set(value)
+		if r.isAttached(getRoot(), path, list.list) {
+			r.record(`replace`, path, value)
+		}
+	}
+	if remove != nil {
+		accessor.Delete = func() {
+			if _, ok := list.getPosition(index); !ok {
+				return
+			}
+			path := pointer()
+			isAttached := r.isAttached(getRoot(), path, list.list)
+			remove()
+			if isAttached {
+				r.record(`remove`, path, nil)
+			}
+		}
+	}
+	return accessor
+}
+
+// isAttached returns whether the parent of the pointer is still the given object or array.
+func (r *PatchRecorder) isAttached(root interface{}, pointer string, parent interface{}) bool {
+	tokens, _ := splitPointer(pointer)
+	value, ok := getPointerValue(root, tokens[:len(tokens)-1])
+	if !ok {
+		return false
+	}
+
+	parentValue, currentValue := reflect.ValueOf(parent), reflect.ValueOf(value)
+	return currentValue.Kind() == parentValue.Kind() && currentValue.Pointer() == parentValue.Pointer()
+}
+
+// recordValueAccessor records the updates of the Go value.
+// The value ignored by Set is not recorded.
+func (r *PatchRecorder) recordValueAccessor(accessor Accessor, pointer func() string) Accessor {
+	set, remove := accessor.Set, accessor.Delete
+	if set != nil {
+		accessor.Set = func(value interface{}) {
+			if accessor.checkValue(value) != nil {
+				return
+			}
+			set(value)
+			r.record(`replace`, pointer(), value)
+		}
+	}
+	if remove != nil {
+		accessor.Delete = func() {
+			path := pointer()
+			remove()
+			r.record(`remove`, path, nil)
+		}
+	}
+	return accessor
+}
+
+// recordMissingAccessor records the creation of the missing member in upsert mode.
+// The operation is decided by the part of the path existing before Set,
+// and its value is taken from the created part after Set.
+func (r *PatchRecorder) recordMissingAccessor(
+	accessor Accessor, getRoot func() interface{}, pointer func() string) Accessor {
+
+	set := accessor.Set
+	accessor.Set = func(value interface{}) {
+		tokens, _ := splitPointer(pointer())
+		op, depth, length := r.getCreation(getRoot(), tokens)
+
+		set(value)
+
+		// The root is taken again, since Set replaces the root itself if it is extended or created.
+		root := getRoot()
+		if length < 0 {
+			createdValue, _ := getPointerValue(root, tokens[:depth])
+			r.record(op, joinPointer(tokens[:depth]), createdValue)
+			return
+		}
+
+		index, _ := getPointerIndex(tokens[depth])
+		for addIndex := length; addIndex <= index; addIndex++ {
+			addTokens := append(append([]string{}, tokens[:depth]...), strconv.Itoa(addIndex))
+			addValue, _ := getPointerValue(root, addTokens)
+			r.record(`add`, joinPointer(addTokens), addValue)
+		}
+	}
+	return accessor
+}
+
+// getCreation returns the operation and the depth of the path where the creation starts.
+// The missing member is added, the null value is replaced,
+// and the array shorter than the index is extended from its length.
+func (r *PatchRecorder) getCreation(root interface{}, tokens []string) (string, int, int) {
+	current := root
+	for depth, token := range tokens {
+		switch typedCurrent := current.(type) {
+		case map[string]interface{}:
+			next, ok := typedCurrent[token]
+			if !ok {
+				return `add`, depth + 1, -1
+			}
+			current = next
+		case []interface{}:
+			index, _ := getPointerIndex(token)
+			if index >= len(typedCurrent) {
+				return `add`, depth, len(typedCurrent)
+			}
+			current = typedCurrent[index]
+		default:
+			return `replace`, depth, -1
+		}
+	}
+	return `replace`, len(tokens), -1
+}
@@ -0,0 +1,13 @@
+package jsonpath
+
+// PathValue represents the result node of JSONPath paired with its normalized path.
+type PathValue struct {
+	Path  string
+	Value interface{}
+}
+
+// Pointer returns the path in the form of the JSON Pointer (RFC 6901).
+func (p PathValue) Pointer() string {
+	pointer, _ := ToPointer(p.Path)
+	return pointer
+}
@@ -0,0 +1,166 @@
+package jsonpath
+
+import "context"
+
+// Query represents the compiled JSONPath.
+type Query struct {
+	jsonPath   string
+	root       syntaxNode
+	positions  map[interface{}]astPosition
+	pathMode   bool
+	strictMode bool
+	upsertMode bool
+	recorder   *PatchRecorder
+	limits     executionLimits
+}
+
+// ParseQuery returns the Query compiled from the given JSONPath.
+func ParseQuery(jsonPath string, config ...Config) (*Query, error) {
+	parsed, err := parse(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &Query{
+		jsonPath:  jsonPath,
+		root:      parsed.root,
+		positions: parsed.positions,
+	}
+	if len(config) > 0 {
+		query.pathMode = config[0].pathMode
+		query.strictMode = config[0].strictMode
+		query.upsertMode = config[0].upsertMode
+		query.limits = config[0].limits
+		if config[0].accessorMode {
+			query.recorder = config[0].patchRecorder
+		}
+	}
+
+	if query.upsertMode && !query.IsDefinite() {
+		return nil, ErrorNotSupported{
+			feature: `upsert mode with value group`,
+			path:    jsonPath,
+		}
+	}
+
+	return query, nil
+}
+
+// String returns the JSONPath of the query.
+func (q *Query) String() string {
+	return q.jsonPath
+}
+
+// IsDefinite returns whether the query returns a single value.
+func (q *Query) IsDefinite() bool {
+	return isSingleValueNode(q.root)
+}
+
+// Functions returns the names of the filter functions and the aggregate functions of Config
+// used in the query, in the order of their first appearance.
+func (q *Query) Functions() []string {
+	var names []string
+	found := map[string]bool{}
+	var findFunctions func(node *ASTNode)
+	findFunctions = func(node *ASTNode) {
+		switch node.kind {
+		case ASTFilterFunction, ASTAggregateFunction:
+			name := node.value.(string)
+			if !found[name] {
+				found[name] = true
+				names = append(names, name)
+			}
+		}
+		for _, child := range node.children {
+			findFunctions(child)
+		}
+	}
+	findFunctions(q.AST())
+	return names
+}
+
+// AST returns the abstract syntax tree of the query.
+// The tree is built on each call, so that it can be changed without affecting the query.
+func (q *Query) AST() *ASTNode {
+	builder := astBuilder{
+		jsonPath:  []rune(q.jsonPath),
+		positions: q.positions,
+	}
+	return builder.buildPath(ASTRoot, q.root)
+}
+
+// Execute returns the retrieved JSON in the same way as the parser function returned by Parse.
+func (q *Query) Execute(src interface{}) ([]interface{}, error) {
+	return q.ExecuteContext(context.Background(), src)
+}
+
+// ExecuteContext returns the retrieved JSON in the same way as Execute,
+// but the retrieval is stopped with the error of the context when the context is done.
+// The error of the context and the errors of the limits of Config are returned even in strict mode.
+func (q *Query) ExecuteContext(ctx context.Context, src interface{}) ([]interface{}, error) {
+	container := bufferContainer{
+		upsertMode: q.upsertMode,
+	}
+	if q.recorder != nil {
+		container.recorder = q.recorder
+		container.pointer = getRootPointer
+		container.getRoot = func() interface{} { return src }
+	}
+	if q.pathMode {
+		container.pathMode = true
+		container.path = `$`
+	}
+
+	err, abortErr := retrieveWithLimits(ctx, q.limits, q.root, src, &container)
+	if abortErr != nil {
+		return nil, abortErr
+	}
+	if err != nil {
+		if q.strictMode {
+			return []interface{}{}, nil
+		}
+		return container.result, err.(error)
+	}
+	return container.result, nil
+}
+
+// ExecuteLenient returns all the retrieved JSON together with the errors of all the failed branches,
+// such as the elements of the wildcard without the member, instead of the single error returned by Execute.
+// The errors are ErrorMemberNotExist, ErrorTypeUnmatched and ErrorFunctionFailed,
+// which are located by their NormalizedPath, in the order of the retrieval.
+// The missing members of the values visited by the recursive descent are not the failures,
+// and the errors of the JSONPaths in the filters and the aggregate functions are not included.
+// The error of the limits of Config is returned alone with no result.
+func (q *Query) ExecuteLenient(src interface{}) ([]interface{}, []error) {
+	var failures []error
+	container := bufferContainer{
+		upsertMode: q.upsertMode,
+		pathMode:   true,
+		path:       `$`,
+		failures:   &failures,
+	}
+	if q.recorder != nil {
+		container.recorder = q.recorder
+		container.pointer = getRootPointer
+		container.getRoot = func() interface{} { return src }
+	}
+
+	err, abortErr := retrieveWithLimits(context.Background(), q.limits, q.root, src, &container)
+	if abortErr != nil {
+		return nil, []error{abortErr}
+	}
+	if _, ok := err.(errorRuntimeRecorded); err != nil && !ok {
+		container.addFailure(err)
+	}
+
+	results := container.result
+	if !q.pathMode {
+		for index := range results {
+			results[index] = results[index].(PathValue).Value
+		}
+	}
+	if results == nil {
+		results = []interface{}{}
+	}
+	return results, failures
+}
@@ -0,0 +1,154 @@
+package jsonpath
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+)
+
+// QueryCache represents the cache of the compiled JSONPaths, which keeps the least recently used ones
+// up to the capacity. It is safe for concurrent use by multiple goroutines.
+//
+// The JSONPaths are cached for each Config, which is identified by its modes, its limits, its patch recorder
+// and the identity of its maps of the functions. The functions set to the Config after its JSONPaths
+// are cached are not reflected to them.
+type QueryCache struct {
+	mutex     sync.Mutex
+	capacity  int
+	elements  map[queryCacheKey]*list.Element
+	order     *list.List
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+// QueryCacheStats represents the statistics of QueryCache.
+type QueryCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int
+	Capacity  int
+}
+
+type queryCacheKey struct {
+	jsonPath           string
+	filterFunctions    uintptr
+	aggregateFunctions uintptr
+	accessorMode       bool
+	upsertMode         bool
+	pathMode           bool
+	strictMode         bool
+	valueGroupCompare  valueGroupCompareMode
+	patchRecorder      *PatchRecorder
+	limits             executionLimits
+}
+
+// queryCacheEntry keeps the Config with the query,
+// so that the maps identified by the key are not reused for another Config while it is cached.
+type queryCacheEntry struct {
+	key    queryCacheKey
+	config Config
+	query  *Query
+}
+
+// NewQueryCache returns the QueryCache with the given capacity.
+// If the capacity is not positive, no JSONPath is cached.
+func NewQueryCache(capacity int) *QueryCache {
+	return &QueryCache{
+		capacity: capacity,
+		elements: map[queryCacheKey]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+// ParseQuery returns the cached Query of the given JSONPath and Config,
+// or compiles and caches it in the same way as the ParseQuery function.
+// The JSONPath with the syntax error is not cached.
+func (c *QueryCache) ParseQuery(jsonPath string, config ...Config) (*Query, error) {
+	var cacheConfig Config
+	if len(config) > 0 {
+		cacheConfig = config[0]
+	}
+	key := newQueryCacheKey(jsonPath, cacheConfig)
+
+	c.mutex.Lock()
+	if element, ok := c.elements[key]; ok {
+		c.order.MoveToFront(element)
+		c.hits++
+		c.mutex.Unlock()
+		return element.Value.(*queryCacheEntry).query, nil
+	}
+	c.misses++
+	c.mutex.Unlock()
+
+	query, err := ParseQuery(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.elements[key]; ok {
+		// Another goroutine has cached the same JSONPath while compiling it.
+		c.order.MoveToFront(element)
+		return element.Value.(*queryCacheEntry).query, nil
+	}
+	if c.capacity <= 0 {
+		return query, nil
+	}
+	for c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.elements, oldest.Value.(*queryCacheEntry).key)
+		c.evictions++
+	}
+	c.elements[key] = c.order.PushFront(&queryCacheEntry{key: key, config: cacheConfig, query: query})
+	return query, nil
+}
+
+// Retrieve returns the retrieved JSON using the given JSONPath in the same way as the Retrieve function,
+// but the compiled JSONPath is taken from the cache.
+func (c *QueryCache) Retrieve(jsonPath string, src interface{}, config ...Config) ([]interface{}, error) {
+	query, err := c.ParseQuery(jsonPath, config...)
+	if err != nil {
+		return nil, err
+	}
+	return query.Execute(src)
+}
+
+// Stats returns the statistics of the cache.
+func (c *QueryCache) Stats() QueryCacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return QueryCacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Len:       c.order.Len(),
+		Capacity:  c.capacity,
+	}
+}
+
+// Purge removes all the cached JSONPaths. The statistics are not reset.
+func (c *QueryCache) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.elements = map[queryCacheKey]*list.Element{}
+	c.order.Init()
+}
+
+func newQueryCacheKey(jsonPath string, config Config) queryCacheKey {
+	return queryCacheKey{
+		jsonPath:           jsonPath,
+		filterFunctions:    reflect.ValueOf(config.filterFunctions).Pointer(),
+		aggregateFunctions: reflect.ValueOf(config.aggregateFunctions).Pointer(),
+		accessorMode:       config.accessorMode,
+		upsertMode:         config.upsertMode,
+		pathMode:           config.pathMode,
+		strictMode:         config.strictMode,
+		valueGroupCompare:  config.valueGroupCompare,
+		patchRecorder:      config.patchRecorder,
+		limits:             config.limits,
+	}
+}
@@ -0,0 +1,194 @@
+package jsonpath
+
+// CostClass represents the class of the cost of the Query estimated without the JSON.
+type CostClass int
+
+// The classes of the cost, in ascending order.
+const (
+	CostDefinite    CostClass = iota // selects a single value by the names and the indexes
+	CostLinear                       // selects the children, such as the wildcards, the slices and the filters
+	CostRecursive                    // visits the whole subtree by the recursive descent
+	CostSuperlinear                  // repeats the recursive descent for each value visited by another one
+)
+
+var costClassNames = []string{`Definite`, `Linear`, `Recursive`, `Superlinear`}
+
+func (c CostClass) String() string {
+	if c < 0 || int(c) >= len(costClassNames) {
+		return `Unknown`
+	}
+	return costClassNames[c]
+}
+
+// QueryCost represents the cost of the Query estimated from its syntax.
+type QueryCost struct {
+	// Class is the class of the cost.
+	Class CostClass
+	// RecursiveDescents is the number of the recursive descents.
+	RecursiveDescents int
+	// RecursiveDescentsInFilters is the number of the recursive descents in the filters.
+	RecursiveDescentsInFilters int
+	// Filters is the number of the filters.
+	Filters int
+	// MaxFilterNesting is the maximum number of the filters nested in one another.
+	MaxFilterNesting int
+	// MaxRootQueriesPerFilter is the maximum number of the JSONPaths starting with `$`,
+	// which are evaluated on each evaluation of a filter.
+	MaxRootQueriesPerFilter int
+	// Regexes is the number of the regular expressions, including the functions match and search.
+	Regexes int
+}
+
+// Cost returns the cost of the query estimated from its syntax, without retrieving the JSON.
+// The recursive descent in a filter or after another recursive descent is estimated as CostSuperlinear,
+// if it can be repeated for each value visited by the preceding recursive descent.
+func (q *Query) Cost() QueryCost {
+	analyzer := costAnalyzer{}
+	analyzer.analyzeNodes(q.root)
+
+	cost := analyzer.cost
+	switch {
+	case analyzer.isSuperlinear:
+		cost.Class = CostSuperlinear
+	case cost.RecursiveDescents > 0:
+		cost.Class = CostRecursive
+	case analyzer.hasValueGroup:
+		cost.Class = CostLinear
+	}
+	return cost
+}
+
+// costAnalyzer counts the syntaxes of the node chains and the filter queries.
+// The recursive descents enclosing the current node are counted to find the repeated recursive descents.
+type costAnalyzer struct {
+	cost                QueryCost
+	hasValueGroup       bool
+	isSuperlinear       bool
+	enclosingRecursions int
+	filterNesting       int
+	rootQueries         int
+}
+
+func (a *costAnalyzer) analyzeNodes(node syntaxNode) {
+	enclosingRecursions := a.enclosingRecursions
+	for ; node != nil; node = node.getNext() {
+		a.analyzeNode(node)
+		if _, ok := node.(*syntaxRecursiveChildIdentifier); ok {
+			a.enclosingRecursions++
+		}
+	}
+	a.enclosingRecursions = enclosingRecursions
+}
+
+// analyzeNode analyzes the node without following its next node.
+func (a *costAnalyzer) analyzeNode(node syntaxNode) {
+	if node.isValueGroup() {
+		a.hasValueGroup = true
+	}
+
+	switch typedNode := node.(type) {
+	case *syntaxRecursiveChildIdentifier:
+		a.cost.RecursiveDescents++
+		if a.filterNesting > 0 {
+			a.cost.RecursiveDescentsInFilters++
+		}
+		if a.enclosingRecursions > 0 {
+			a.isSuperlinear = true
+		}
+	case *syntaxChildMultiIdentifier:
+		for _, identifier := range typedNode.identifiers {
+			a.analyzeNode(identifier)
+		}
+	case *syntaxMultiSelectorQualifier:
+		for _, selector := range typedNode.selectors {
+			a.analyzeNode(selector)
+		}
+	case *syntaxFilterQualifier:
+		a.cost.Filters++
+		a.filterNesting++
+		if a.filterNesting > a.cost.MaxFilterNesting {
+			a.cost.MaxFilterNesting = a.filterNesting
+		}
+		rootQueries := a.rootQueries
+		a.rootQueries = 0
+		a.analyzeQuery(typedNode.query)
+		if a.rootQueries > a.cost.MaxRootQueriesPerFilter {
+			a.cost.MaxRootQueriesPerFilter = a.rootQueries
+		}
+		a.rootQueries = rootQueries
+		a.filterNesting--
+	case *syntaxScriptQualifier:
+		a.analyzeScript(typedNode.script)
+	case *syntaxAggregateFunction:
+		a.analyzeNodes(typedNode.param)
+	}
+}
+
+func (a *costAnalyzer) analyzeQuery(query syntaxQuery) {
+	switch typedQuery := query.(type) {
+	case *syntaxLogicalOr:
+		a.analyzeQuery(typedQuery.leftQuery)
+		a.analyzeQuery(typedQuery.rightQuery)
+	case *syntaxLogicalAnd:
+		a.analyzeQuery(typedQuery.leftQuery)
+		a.analyzeQuery(typedQuery.rightQuery)
+	case *syntaxLogicalNot:
+		a.analyzeQuery(typedQuery.query)
+	case *syntaxBasicCompareQuery:
+		if _, ok := typedQuery.comparator.(*syntaxCompareRegex); ok {
+			a.cost.Regexes++
+		}
+		a.analyzeParams(typedQuery.leftParam, typedQuery.rightParam)
+	case *syntaxValueGroupCompareQuery:
+		if _, ok := typedQuery.comparator.(*syntaxCompareRegex); ok {
+			a.cost.Regexes++
+		}
+		a.analyzeParams(typedQuery.leftParam, typedQuery.rightParam)
+	case *syntaxStrictCompareQuery:
+		a.analyzeParams(typedQuery.leftParam, typedQuery.rightParam)
+	case *syntaxQueryParamRoot:
+		a.rootQueries++
+		a.analyzeNodes(typedQuery.param)
+	case *syntaxQueryParamCurrentRoot:
+		a.analyzeNodes(typedQuery.param)
+	case *syntaxFunctionLength:
+		a.analyzeParams(typedQuery.params...)
+	case *syntaxFunctionCount:
+		a.analyzeParams(typedQuery.params...)
+	case *syntaxFunctionValue:
+		a.analyzeParams(typedQuery.params...)
+	case *syntaxFunctionRegex:
+		a.cost.Regexes++
+		a.analyzeParams(typedQuery.params...)
+	}
+}
+
+func (a *costAnalyzer) analyzeParams(params ...*syntaxBasicCompareParameter) {
+	for _, param := range params {
+		a.analyzeQuery(param.param)
+	}
+}
+
+func (a *costAnalyzer) analyzeScript(script syntaxScript) {
+	switch typedScript := script.(type) {
+	case *syntaxScriptAdd:
+		a.analyzeArithmetic(typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptSubtract:
+		a.analyzeArithmetic(typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptMultiply:
+		a.analyzeArithmetic(typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptDivide:
+		a.analyzeArithmetic(typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptModulo:
+		a.analyzeArithmetic(typedScript.syntaxBasicScriptArithmetic)
+	case *syntaxScriptNegate:
+		a.analyzeScript(typedScript.script)
+	case *syntaxScriptParamJSONPath:
+		a.analyzeNodes(typedScript.param)
+	}
+}
+
+func (a *costAnalyzer) analyzeArithmetic(arithmetic *syntaxBasicScriptArithmetic) {
+	a.analyzeScript(arithmetic.leftScript)
+	a.analyzeScript(arithmetic.rightScript)
+}
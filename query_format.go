@@ -0,0 +1,218 @@
+package jsonpath
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Format returns the JSONPath in the canonical form.
+// The child identifiers are written in the bracket notation with the single quotes,
+// the omitted root identifier is written, and the blanks and the default step of the slice are removed,
+// so that the equivalent JSONPaths are formatted into the same string, such as `$.a['b']` and `$["a"].b`.
+// The result can be parsed with the same Config.
+func Format(jsonPath string, config ...Config) (string, error) {
+	query, err := ParseQuery(jsonPath, config...)
+	if err != nil {
+		return ``, err
+	}
+
+	formatter := astFormatter{strictMode: query.strictMode}
+	formatter.writePath(query.AST())
+	return formatter.builder.String(), nil
+}
+
+// astFormatter writes the JSONPath from the ASTNode tree.
+// The syntaxes are chosen so that the written JSONPath is accepted in the same mode.
+type astFormatter struct {
+	builder    strings.Builder
+	strictMode bool
+}
+
+func (f *astFormatter) writePath(path *ASTNode) {
+	for _, segment := range path.children {
+		f.writeSegment(segment)
+	}
+}
+
+func (f *astFormatter) writeSegment(segment *ASTNode) {
+	switch segment.kind {
+	case ASTRoot:
+		f.builder.WriteString(`$`)
+	case ASTCurrent:
+		f.builder.WriteString(`@`)
+	case ASTDescendant:
+		f.builder.WriteString(`..`)
+	case ASTFilterFunction, ASTAggregateFunction:
+		f.builder.WriteString(`.` + segment.value.(string) + `()`)
+	case ASTLength:
+		f.builder.WriteString(`.length`)
+	case ASTFilter:
+		if f.strictMode {
+			f.builder.WriteString(`[`)
+			f.writeSelector(segment)
+			f.builder.WriteString(`]`)
+			return
+		}
+		f.builder.WriteString(`[?(`)
+		f.writeExpression(segment.children[0], 0)
+		f.builder.WriteString(`)]`)
+	case ASTScript:
+		f.builder.WriteString(`[(`)
+		f.writeScript(segment.children[0], 0)
+		f.builder.WriteString(`)]`)
+	default:
+		f.builder.WriteString(`[`)
+		f.writeSelector(segment)
+		f.builder.WriteString(`]`)
+	}
+}
+
+// writeSelector writes the selector without the square brackets.
+func (f *astFormatter) writeSelector(selector *ASTNode) {
+	switch selector.kind {
+	case ASTChild:
+		f.builder.WriteString(quoteNormalizedPathKey(selector.value.(string)))
+	case ASTWildcard:
+		f.builder.WriteString(`*`)
+	case ASTIndex:
+		f.builder.WriteString(strconv.Itoa(selector.value.(int)))
+	case ASTSlice:
+		f.writeSlice(selector)
+	case ASTUnion:
+		for index, child := range selector.children {
+			if index > 0 {
+				f.builder.WriteString(`,`)
+			}
+			f.writeSelector(child)
+		}
+	case ASTFilter:
+		f.builder.WriteString(`?`)
+		f.writeExpression(selector.children[0], 0)
+	}
+}
+
+// writeSlice writes the slice without the omitted indexes and the default step.
+func (f *astFormatter) writeSlice(slice *ASTNode) {
+	if start := slice.children[0].value; start != nil {
+		f.builder.WriteString(strconv.Itoa(start.(int)))
+	}
+	f.builder.WriteString(`:`)
+	if end := slice.children[1].value; end != nil {
+		f.builder.WriteString(strconv.Itoa(end.(int)))
+	}
+	if step := slice.children[2].value; step != nil && step.(int) != 1 {
+		f.builder.WriteString(`:` + strconv.Itoa(step.(int)))
+	}
+}
+
+// The precedences of the logical operators in the filter.
+const (
+	formatPrecedenceOr = iota + 1
+	formatPrecedenceAnd
+	formatPrecedenceBasic
+)
+
+// writeExpression writes the expression of the filter,
+// enclosing it in parentheses if its operator has lower precedence than the given one.
+func (f *astFormatter) writeExpression(expression *ASTNode, precedence int) {
+	switch expression.kind {
+	case ASTOr:
+		f.writeLogical(expression, ` || `, formatPrecedenceOr, precedence)
+	case ASTAnd:
+		f.writeLogical(expression, ` && `, formatPrecedenceAnd, precedence)
+	case ASTNot:
+		f.builder.WriteString(`!`)
+		operand := expression.children[0]
+		if operand.kind == ASTPath || operand.kind == ASTFunctionExtension {
+			f.writeExpression(operand, formatPrecedenceBasic)
+			return
+		}
+		f.builder.WriteString(`(`)
+		f.writeExpression(operand, 0)
+		f.builder.WriteString(`)`)
+	case ASTComparison:
+		f.writeExpression(expression.children[0], formatPrecedenceBasic)
+		f.builder.WriteString(expression.value.(string))
+		if expression.value == `=~` {
+			f.builder.WriteString(`/` + expression.children[1].value.(string) + `/`)
+			return
+		}
+		f.writeExpression(expression.children[1], formatPrecedenceBasic)
+	case ASTFunctionExtension:
+		f.builder.WriteString(expression.value.(string) + `(`)
+		for index, argument := range expression.children {
+			if index > 0 {
+				f.builder.WriteString(`,`)
+			}
+			f.writeExpression(argument, formatPrecedenceBasic)
+		}
+		f.builder.WriteString(`)`)
+	case ASTPath:
+		f.writePath(expression)
+	case ASTLiteral:
+		f.writeLiteral(expression.value, 'g')
+	}
+}
+
+func (f *astFormatter) writeLogical(expression *ASTNode, operator string, operatorPrecedence, precedence int) {
+	if operatorPrecedence < precedence {
+		f.builder.WriteString(`(`)
+		defer f.builder.WriteString(`)`)
+	}
+	f.writeExpression(expression.children[0], operatorPrecedence)
+	f.builder.WriteString(operator)
+	f.writeExpression(expression.children[1], operatorPrecedence+1)
+}
+
+// The precedences of the arithmetic operators in the script.
+const (
+	formatPrecedenceAdditive = iota + 1
+	formatPrecedenceMultiplicative
+	formatPrecedenceFactor
+)
+
+// writeScript writes the expression of the script,
+// enclosing it in parentheses if its operator has lower precedence than the given one.
+func (f *astFormatter) writeScript(script *ASTNode, precedence int) {
+	switch script.kind {
+	case ASTArithmetic:
+		operatorPrecedence := formatPrecedenceMultiplicative
+		if script.value == `+` || script.value == `-` {
+			operatorPrecedence = formatPrecedenceAdditive
+		}
+		if operatorPrecedence < precedence {
+			f.builder.WriteString(`(`)
+			defer f.builder.WriteString(`)`)
+		}
+		f.writeScript(script.children[0], operatorPrecedence)
+		f.builder.WriteString(script.value.(string))
+		f.writeScript(script.children[1], operatorPrecedence+1)
+	case ASTNegate:
+		f.builder.WriteString(`-`)
+		f.writeScript(script.children[0], formatPrecedenceFactor)
+	case ASTPath:
+		f.writePath(script)
+	case ASTLiteral:
+		f.writeLiteral(script.value, 'f')
+	}
+}
+
+// writeLiteral writes the literal in the syntax of the current mode.
+// The number is written in the given format of strconv.FormatFloat,
+// since the exponent is not accepted by the number of the script.
+func (f *astFormatter) writeLiteral(literal interface{}, numberFormat byte) {
+	switch typedLiteral := literal.(type) {
+	case float64:
+		f.builder.WriteString(strconv.FormatFloat(typedLiteral, numberFormat, -1, 64))
+	case string:
+		if f.strictMode {
+			f.builder.WriteString(quoteNormalizedPathKey(typedLiteral))
+			return
+		}
+		f.builder.WriteString(`'` + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(typedLiteral) + `'`)
+	case bool:
+		f.builder.WriteString(strconv.FormatBool(typedLiteral))
+	case nil:
+		f.builder.WriteString(`null`)
+	}
+}
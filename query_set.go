@@ -0,0 +1,240 @@
+package jsonpath
+
+import (
+	"strconv"
+	"strings"
+)
+
+// QuerySet represents the JSONPaths compiled together to retrieve the JSON in a single traversal.
+// The leading child identifiers and indexes shared by the JSONPaths are evaluated once.
+type QuerySet struct {
+	root       *querySetNode
+	count      int
+	pathMode   bool
+	strictMode bool
+}
+
+// querySetNode is a node of the prefix tree of the JSONPaths.
+// The steps are the nodes of each JSONPath that select this node from the parent,
+// and they evaluate the rest of the JSONPath when the shared selection fails.
+// The remains are the rest of each JSONPath that ends its shared prefix at this node,
+// and the nil remain means that the value itself is a result.
+type querySetNode struct {
+	members map[string]*querySetNode
+	indexes map[int]*querySetNode
+	steps   []querySetStep
+	remains []querySetStep
+}
+
+type querySetStep struct {
+	index int
+	node  syntaxNode
+}
+
+// querySetSegment is the member or the element selected by the shared prefix.
+// The index is -1 for the member.
+type querySetSegment struct {
+	key   string
+	index int
+}
+
+// NewQuerySet returns the QuerySet compiled from the given JSONPaths.
+// The accessor mode and the execution limits cannot be used.
+func NewQuerySet(jsonPaths []string, config ...Config) (*QuerySet, error) {
+	querySet := &QuerySet{
+		root:  &querySetNode{},
+		count: len(jsonPaths),
+	}
+	if len(config) > 0 {
+		querySet.pathMode = config[0].pathMode
+		querySet.strictMode = config[0].strictMode
+	}
+
+	for index, jsonPath := range jsonPaths {
+		if len(config) > 0 && config[0].accessorMode {
+			return nil, ErrorNotSupported{
+				feature: `accessor mode with query set`,
+				path:    jsonPath,
+			}
+		}
+		if len(config) > 0 && config[0].limits.isLimited() {
+			return nil, ErrorNotSupported{
+				feature: `execution limits with query set`,
+				path:    jsonPath,
+			}
+		}
+
+		parsed, err := parse(jsonPath, config...)
+		if err != nil {
+			return nil, err
+		}
+		querySet.add(index, parsed.root)
+	}
+
+	return querySet, nil
+}
+
+func (s *QuerySet) add(index int, node syntaxNode) {
+	if _, ok := node.(*syntaxRootIdentifier); ok {
+		node = node.getNext()
+	}
+
+	current := s.root
+	for ; node != nil; node = node.getNext() {
+		next := current.getChild(node)
+		if next == nil {
+			break
+		}
+		next.steps = append(next.steps, querySetStep{index: index, node: node})
+		current = next
+	}
+	current.remains = append(current.remains, querySetStep{index: index, node: node})
+}
+
+// Retrieve returns the results and the errors of each JSONPath in the given order.
+// They are the same as the ones returned by the parser-function of each JSONPath.
+func (s *QuerySet) Retrieve(src interface{}) ([][]interface{}, []error) {
+	containers := make([]bufferContainer, s.count)
+	for index := range containers {
+		containers[index].pathMode = s.pathMode
+	}
+	errs := make([]error, s.count)
+
+	s.evaluate(s.root, src, src, `$`, make([]querySetSegment, 0, 8), containers, errs)
+
+	results := make([][]interface{}, s.count)
+	for index := range containers {
+		if errs[index] != nil && s.strictMode {
+			results[index], errs[index] = []interface{}{}, nil
+			continue
+		}
+		results[index] = containers[index].result
+	}
+	return results, errs
+}
+
+// evaluate retrieves the values of the shared prefix from the current value.
+// The path is built only in path mode, and the segments are kept to locate the errors of the steps.
+func (s *QuerySet) evaluate(node *querySetNode, root, current interface{}, path string,
+	segments []querySetSegment, containers []bufferContainer, errs []error) {
+
+	for _, remain := range node.remains {
+		container := &containers[remain.index]
+		if remain.node == nil {
+			container.appendResult(current, path)
+			continue
+		}
+		s.evaluateStep(remain, root, current, path, segments, container, errs)
+	}
+
+	if len(node.members) > 0 {
+		srcMap, isMap := current.(map[string]interface{})
+		for key, member := range node.members {
+			if isMap {
+				if value, ok := srcMap[key]; ok {
+					s.evaluate(member, root, value, s.getMapPath(path, key),
+						append(segments, querySetSegment{key: key, index: -1}), containers, errs)
+					continue
+				}
+			}
+			for _, step := range member.steps {
+				s.evaluateStep(step, root, current, path, segments, &containers[step.index], errs)
+			}
+		}
+	}
+
+	if len(node.indexes) > 0 {
+		srcArray, isArray := current.([]interface{})
+		for index, element := range node.indexes {
+			if isArray && index < len(srcArray) {
+				s.evaluate(element, root, srcArray[index], s.getListPath(path, index),
+					append(segments, querySetSegment{index: index}), containers, errs)
+				continue
+			}
+			for _, step := range element.steps {
+				s.evaluateStep(step, root, current, path, segments, &containers[step.index], errs)
+			}
+		}
+	}
+}
+
+// evaluateStep evaluates the rest of the JSONPath on its own from the given node.
+func (s *QuerySet) evaluateStep(step querySetStep, root, current interface{}, path string,
+	segments []querySetSegment, container *bufferContainer, errs []error) {
+
+	if s.pathMode {
+		container.path = path
+	}
+	if err := step.node.retrieve(root, current, container); err != nil {
+		errs[step.index] = addErrorParentPath(err, s.getErrorPath(path, segments)).(error)
+	}
+}
+
+// getChild returns the child of the prefix tree for the node selecting a single member or element,
+// or nil if the node cannot be shared.
+func (n *querySetNode) getChild(node syntaxNode) *querySetNode {
+	switch typedNode := node.(type) {
+	case *syntaxChildSingleIdentifier:
+		if n.members == nil {
+			n.members = map[string]*querySetNode{}
+		}
+		child, ok := n.members[typedNode.identifier]
+		if !ok {
+			child = &querySetNode{}
+			n.members[typedNode.identifier] = child
+		}
+		return child
+	case *syntaxUnionQualifier:
+		if len(typedNode.subscripts) != 1 {
+			return nil
+		}
+		subscript, ok := typedNode.subscripts[0].(*syntaxIndexSubscript)
+		if !ok || subscript.number < 0 {
+			return nil
+		}
+		if n.indexes == nil {
+			n.indexes = map[int]*querySetNode{}
+		}
+		child, ok := n.indexes[subscript.number]
+		if !ok {
+			child = &querySetNode{}
+			n.indexes[subscript.number] = child
+		}
+		return child
+	}
+	return nil
+}
+
+// getMapPath returns the path of the shared member in path mode.
+func (s *QuerySet) getMapPath(path string, key string) string {
+	if !s.pathMode {
+		return path
+	}
+	return path + `[` + quoteNormalizedPathKey(key) + `]`
+}
+
+func (s *QuerySet) getListPath(path string, index int) string {
+	if !s.pathMode {
+		return path
+	}
+	return path + `[` + strconv.Itoa(index) + `]`
+}
+
+// getErrorPath returns the path of the current value without the root to locate the error of the step.
+// It is built from the segments unless the path has been built in path mode.
+func (s *QuerySet) getErrorPath(path string, segments []querySetSegment) string {
+	if s.pathMode {
+		return path[len(`$`):]
+	}
+	var builder strings.Builder
+	for _, segment := range segments {
+		builder.WriteByte('[')
+		if segment.index >= 0 {
+			builder.WriteString(strconv.Itoa(segment.index))
+		} else {
+			builder.WriteString(quoteNormalizedPathKey(segment.key))
+		}
+		builder.WriteByte(']')
+	}
+	return builder.String()
+}
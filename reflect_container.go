@@ -0,0 +1,215 @@
+package jsonpath
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+var reflectMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+var reflectTextMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// getReflectContainer follows the pointers and the interfaces of the value,
+// and returns the struct, the map with the string keys, the slice or the array found at the end.
+// The types marshaled by themselves and the byte slices are treated as the values,
+// not as the containers.
+func getReflectContainer(value interface{}) (reflect.Value, bool) {
+	if value == nil {
+		return reflect.Value{}, false
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	for reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface {
+		if reflectValue.IsNil() {
+			return reflect.Value{}, false
+		}
+		reflectValue = reflectValue.Elem()
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		if isReflectMarshaler(reflectValue.Type()) {
+			return reflect.Value{}, false
+		}
+		if reflectValue.Kind() == reflect.Map && reflectValue.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+		if reflectValue.Kind() == reflect.Slice && reflectValue.Type().Elem().Kind() == reflect.Uint8 {
+			return reflect.Value{}, false
+		}
+		return reflectValue, true
+	}
+
+	return reflect.Value{}, false
+}
+
+func getReflectList(value interface{}) (reflect.Value, bool) {
+	reflectValue, ok := getReflectContainer(value)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		return reflectValue, true
+	}
+	return reflect.Value{}, false
+}
+
+func isContainerValue(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	case nil, string, float64, bool, json.Number:
+		return false
+	}
+	_, ok := getReflectContainer(value)
+	return ok
+}
+
+func isObjectValue(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}:
+		return true
+	case nil, string, float64, bool, json.Number, []interface{}:
+		return false
+	}
+	_, ok := getReflectObject(value)
+	return ok
+}
+
+// getReflectLength returns the number of the elements of the slice or the array,
+// or the number of the members of the object.
+func getReflectLength(value interface{}) (int, bool) {
+	if srcObject, ok := getReflectObject(value); ok {
+		return len(srcObject.getKeys()), true
+	}
+	if srcList, ok := getReflectList(value); ok {
+		return srcList.Len(), true
+	}
+	return 0, false
+}
+
+func isReflectMarshaler(reflectType reflect.Type) bool {
+	pointerType := reflect.PtrTo(reflectType)
+	return reflectType.Implements(reflectMarshalerType) ||
+		pointerType.Implements(reflectMarshalerType) ||
+		reflectType.Implements(reflectTextMarshalerType) ||
+		pointerType.Implements(reflectTextMarshalerType)
+}
+
+// getReflectNextSrc returns the pointer of the addressable struct or array,
+// so that the following nodes can set their members.
+func getReflectNextSrc(reflectValue reflect.Value) interface{} {
+	switch reflectValue.Kind() {
+	case reflect.Struct, reflect.Array:
+		if reflectValue.CanAddr() {
+			return reflectValue.Addr().Interface()
+		}
+	}
+	return reflectValue.Interface()
+}
+
+func getReflectValueAccessor(reflectValue reflect.Value) Accessor {
+	accessor := Accessor{
+		Get:          func() interface{} { return reflectValue.Interface() },
+		setReason:    `the Go value is not addressable`,
+		deleteReason: `the Go value cannot be removed from the struct, the slice or the array`,
+	}
+	if reflectValue.CanSet() {
+		accessor.setReason = ``
+		accessor.Set = func(value interface{}) {
+			if convertedValue, err := convertReflectValue(value, reflectValue.Type()); err == nil {
+				reflectValue.Set(convertedValue)
+			}
+		}
+		accessor.check = func(value interface{}) error {
+			_, err := convertReflectValue(value, reflectValue.Type())
+			return err
+		}
+	}
+	return accessor
+}
+
+// convertReflectValue converts the value to be set into the target type.
+// The numbers are converted between the Go numeric kinds only if the value is kept,
+// and the other types have to be assignable to the target type.
+func convertReflectValue(value interface{}, targetType reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(targetType), nil
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Type().AssignableTo(targetType) {
+		return reflectValue, nil
+	}
+
+	if isReflectNumberKind(reflectValue.Kind()) && isReflectNumberKind(targetType.Kind()) {
+		convertedValue := reflectValue.Convert(targetType)
+		if isReflectLossless(reflectValue, convertedValue) {
+			return convertedValue, nil
+		}
+		return reflect.Value{}, fmt.Errorf(`%v cannot be converted into %s without loss`, value, targetType)
+	}
+
+	return reflect.Value{}, fmt.Errorf(`%s cannot be assigned to %s`, reflectValue.Type(), targetType)
+}
+
+// isReflectLossless returns whether the converted number is the same as the source number.
+// The conversion between the floats is accepted unless it overflows.
+func isReflectLossless(srcValue, convertedValue reflect.Value) bool {
+	if isReflectFloatKind(srcValue.Kind()) && isReflectFloatKind(convertedValue.Kind()) {
+		return !math.IsInf(convertedValue.Float(), 0) || math.IsInf(srcValue.Float(), 0)
+	}
+	return convertedValue.Convert(srcValue.Type()).Interface() == srcValue.Interface() &&
+		isReflectNegative(convertedValue) == isReflectNegative(srcValue)
+}
+
+func isReflectNegative(reflectValue reflect.Value) bool {
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflectValue.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float() < 0
+	}
+	return false
+}
+
+func isReflectFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isReflectNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convertReflectScalar converts the values of the Go numeric, string and bool kinds
+// into float64, string and bool, which are the types produced by json.Unmarshal.
+func convertReflectScalar(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(reflectValue.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(reflectValue.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float(), true
+	case reflect.String:
+		return reflectValue.String(), true
+	case reflect.Bool:
+		return reflectValue.Bool(), true
+	}
+
+	return nil, false
+}
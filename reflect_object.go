@@ -0,0 +1,286 @@
+package jsonpath
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// reflectObject is the view as the JSON object of a struct or a map with the string keys.
+type reflectObject struct {
+	value  reflect.Value
+	fields *reflectStructFields
+}
+
+type reflectStructField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	depth     int
+	tagged    bool
+}
+
+type reflectStructFields struct {
+	fields      []reflectStructField
+	nameIndexes map[string]int
+}
+
+var reflectStructFieldsCache sync.Map
+
+func getReflectObject(value interface{}) (*reflectObject, bool) {
+	reflectValue, ok := getReflectContainer(value)
+	if !ok {
+		return nil, false
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.Struct:
+		return &reflectObject{
+			value:  reflectValue,
+			fields: getReflectStructFields(reflectValue.Type()),
+		}, true
+	case reflect.Map:
+		return &reflectObject{
+			value: reflectValue,
+		}, true
+	}
+
+	return nil, false
+}
+
+// getKeys returns the names of the existing members in the sorted order.
+func (o *reflectObject) getKeys() []string {
+	if o.fields == nil {
+		keys := make([]string, 0, o.value.Len())
+		iterator := o.value.MapRange()
+		for iterator.Next() {
+			keys = append(keys, iterator.Key().String())
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	keys := make([]string, 0, len(o.fields.fields))
+	for index := range o.fields.fields {
+		if _, ok := o.fields.fields[index].getValue(o.value); ok {
+			keys = append(keys, o.fields.fields[index].name)
+		}
+	}
+	return keys
+}
+
+func (o *reflectObject) getValue(key string) (reflect.Value, bool) {
+	if o.fields == nil {
+		value := o.value.MapIndex(o.getMapKey(key))
+		return value, value.IsValid()
+	}
+
+	field, ok := o.fields.getField(key)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return field.getValue(o.value)
+}
+
+func (o *reflectObject) getAccessor(key string, value reflect.Value) Accessor {
+	if o.fields != nil {
+		return getReflectValueAccessor(value)
+	}
+
+	mapKey := o.getMapKey(key)
+	return Accessor{
+		Get: func() interface{} {
+			if value := o.value.MapIndex(mapKey); value.IsValid() {
+				return value.Interface()
+			}
+			return nil
+		},
+		Set: func(value interface{}) {
+			if convertedValue, err := convertReflectValue(value, o.value.Type().Elem()); err == nil {
+				o.value.SetMapIndex(mapKey, convertedValue)
+			}
+		},
+		Delete: func() {
+			o.value.SetMapIndex(mapKey, reflect.Value{})
+		},
+		check: func(value interface{}) error {
+			_, err := convertReflectValue(value, o.value.Type().Elem())
+			return err
+		},
+	}
+}
+
+func (o *reflectObject) getMapKey(key string) reflect.Value {
+	return reflect.ValueOf(key).Convert(o.value.Type().Key())
+}
+
+func getReflectStructFields(structType reflect.Type) *reflectStructFields {
+	if fields, ok := reflectStructFieldsCache.Load(structType); ok {
+		return fields.(*reflectStructFields)
+	}
+
+	fields, _ := reflectStructFieldsCache.LoadOrStore(structType, createReflectStructFields(structType))
+	return fields.(*reflectStructFields)
+}
+
+// createReflectStructFields lists the fields in the same way as encoding/json.
+// The fields of the embedded structs are promoted,
+// and the shallowest or the only tagged field wins between the fields with the same name.
+func createReflectStructFields(structType reflect.Type) *reflectStructFields {
+	var candidates []reflectStructField
+	collectReflectStructFields(structType, nil, 0, map[reflect.Type]bool{structType: true}, &candidates)
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].name != candidates[j].name {
+			return candidates[i].name < candidates[j].name
+		}
+		if candidates[i].depth != candidates[j].depth {
+			return candidates[i].depth < candidates[j].depth
+		}
+		return candidates[i].tagged && !candidates[j].tagged
+	})
+
+	fields := &reflectStructFields{
+		fields:      make([]reflectStructField, 0, len(candidates)),
+		nameIndexes: make(map[string]int, len(candidates)),
+	}
+
+	for start := 0; start < len(candidates); {
+		end := start + 1
+		for end < len(candidates) && candidates[end].name == candidates[start].name {
+			end++
+		}
+
+		dominant := candidates[start]
+		isAmbiguous := end-start > 1 &&
+			candidates[start+1].depth == dominant.depth &&
+			candidates[start+1].tagged == dominant.tagged
+		if !isAmbiguous {
+			fields.nameIndexes[dominant.name] = len(fields.fields)
+			fields.fields = append(fields.fields, dominant)
+		}
+
+		start = end
+	}
+
+	return fields
+}
+
+func collectReflectStructFields(
+	structType reflect.Type, parentIndex []int, depth int,
+	visited map[reflect.Type]bool, candidates *[]reflectStructField) {
+
+	for index := 0; index < structType.NumField(); index++ {
+		field := structType.Field(index)
+
+		tag := field.Tag.Get(`json`)
+		if tag == `-` {
+			continue
+		}
+
+		name, options := tag, ``
+		if comma := strings.IndexByte(tag, ','); comma >= 0 {
+			name, options = tag[:comma], tag[comma+1:]
+		}
+
+		fieldIndex := make([]int, len(parentIndex)+1)
+		copy(fieldIndex, parentIndex)
+		fieldIndex[len(parentIndex)] = index
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == `` && fieldType.Kind() == reflect.Struct {
+			if !visited[fieldType] {
+				visited[fieldType] = true
+				collectReflectStructFields(fieldType, fieldIndex, depth+1, visited, candidates)
+				delete(visited, fieldType)
+			}
+			continue
+		}
+
+		if field.PkgPath != `` {
+			continue
+		}
+
+		*candidates = append(*candidates, reflectStructField{
+			name:      getReflectFieldName(name, field.Name),
+			index:     fieldIndex,
+			omitEmpty: hasReflectTagOption(options, `omitempty`),
+			depth:     depth,
+			tagged:    name != ``,
+		})
+	}
+}
+
+func getReflectFieldName(tagName, fieldName string) string {
+	if tagName != `` {
+		return tagName
+	}
+	return fieldName
+}
+
+func hasReflectTagOption(options, option string) bool {
+	for options != `` {
+		var current string
+		current, options = options, ``
+		if comma := strings.IndexByte(current, ','); comma >= 0 {
+			current, options = current[:comma], current[comma+1:]
+		}
+		if current == option {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *reflectStructFields) getField(name string) (*reflectStructField, bool) {
+	index, ok := f.nameIndexes[name]
+	if !ok {
+		return nil, false
+	}
+	return &f.fields[index], true
+}
+
+// getValue returns the value of the field.
+// The field is not found if it is omitted by the omitempty option
+// or is promoted through a nil pointer.
+func (f *reflectStructField) getValue(srcStruct reflect.Value) (reflect.Value, bool) {
+	value := srcStruct
+	for position, index := range f.index {
+		if position > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return reflect.Value{}, false
+			}
+			value = value.Elem()
+		}
+		value = value.Field(index)
+	}
+
+	if f.omitEmpty && isEmptyReflectValue(value) {
+		return reflect.Value{}, false
+	}
+
+	return value, true
+}
+
+func isEmptyReflectValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return value.IsNil()
+	}
+	return false
+}
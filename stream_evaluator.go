@@ -0,0 +1,439 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// streamEvaluator walks the values of streamSource along the syntax node chain.
+// The states are the nodes applied to the value at the current position of the source,
+// and the nil state means that the value itself is a result.
+// The results are emitted as json.RawMessage in raw mode.
+// The root loader returns the whole document for the filters referring to the root.
+type streamEvaluator struct {
+	source     streamSource
+	callback   func(interface{}) error
+	pathMode   bool
+	rawMode    bool
+	rootLoader func() (interface{}, error)
+}
+
+func (e *streamEvaluator) evaluate(states []syntaxNode, path string) error {
+	targets, resultCount, isStreamable := e.resolveStates(states)
+
+	if !isStreamable {
+		return e.evaluateValue(states, path)
+	}
+
+	if resultCount > 0 {
+		return e.evaluateResult(targets, resultCount, path)
+	}
+
+	if len(targets) == 0 {
+		return e.skipValue()
+	}
+
+	return e.walk(targets, path)
+}
+
+func (e *streamEvaluator) walk(targets []syntaxNode, path string) error {
+	delimiter, err := e.source.beginContainer()
+	if err != nil {
+		return err
+	}
+
+	switch delimiter {
+	case '{':
+		for {
+			if ok, err := e.source.more(); !ok || err != nil {
+				if err != nil {
+					return err
+				}
+				break
+			}
+
+			key, err := e.source.readKey()
+			if err != nil {
+				return err
+			}
+
+			var childStates []syntaxNode
+			for _, target := range targets {
+				childStates = e.appendObjectChildStates(childStates, target, key)
+			}
+
+			if err := e.evaluateChild(targets, childStates, e.getMapPath(path, key)); err != nil {
+				if functionErr, ok := err.(ErrorFunctionFailed); ok {
+					return addErrorMapPath(functionErr, key).(error)
+				}
+				return err
+			}
+		}
+		return e.source.endContainer()
+
+	case '[':
+		for index := 0; ; index++ {
+			if ok, err := e.source.more(); !ok || err != nil {
+				if err != nil {
+					return err
+				}
+				break
+			}
+
+			var childStates []syntaxNode
+			for _, target := range targets {
+				childStates = e.appendListChildStates(childStates, target, index)
+			}
+
+			if err := e.evaluateChild(targets, childStates, e.getListPath(path, index)); err != nil {
+				if functionErr, ok := err.(ErrorFunctionFailed); ok {
+					return addErrorListPath(functionErr, index).(error)
+				}
+				return err
+			}
+		}
+		return e.source.endContainer()
+	}
+
+	return nil
+}
+
+// evaluateChild evaluates the states on the child value.
+// If the targets have the filters, the child value is decoded alone to decide whether the filters select it,
+// so that the container of the child is not decoded as a whole.
+func (e *streamEvaluator) evaluateChild(targets []syntaxNode, childStates []syntaxNode, path string) error {
+	if !e.hasFilter(targets) {
+		return e.evaluate(childStates, path)
+	}
+
+	rawValue, err := e.source.readValue()
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(rawValue, &value); err != nil {
+		return err
+	}
+
+	var root interface{}
+	if e.rootLoader != nil {
+		if root, err = e.rootLoader(); err != nil {
+			return err
+		}
+	}
+
+	for _, target := range targets {
+		childStates = e.appendFilterChildStates(childStates, target, root, value)
+	}
+
+	subEvaluator := *e
+	subEvaluator.source = &bytesStreamSource{data: rawValue}
+	return subEvaluator.evaluate(childStates, path)
+}
+
+// evaluateResult emits the value that is a result itself,
+// and then walks the buffered value for the results in its subtree.
+func (e *streamEvaluator) evaluateResult(targets []syntaxNode, resultCount int, path string) error {
+	rawValue, err := e.source.readValue()
+	if err != nil {
+		return err
+	}
+
+	var value interface{} = rawValue
+	if !e.rawMode {
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			return err
+		}
+	}
+
+	for index := 0; index < resultCount; index++ {
+		if err := e.emit(value, path); err != nil {
+			return err
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil
+	}
+
+	subEvaluator := *e
+	subEvaluator.source = &bytesStreamSource{data: rawValue}
+	return subEvaluator.walk(targets, path)
+}
+
+// resolveStates passes through the nodes that apply their next node to the same value,
+// and returns the nodes that select the children of the value.
+func (e *streamEvaluator) resolveStates(states []syntaxNode) ([]syntaxNode, int, bool) {
+	var targets []syntaxNode
+	var resultCount int
+	isStreamable := true
+
+	pendingStates := append([]syntaxNode{}, states...)
+	for len(pendingStates) > 0 {
+		state := pendingStates[len(pendingStates)-1]
+		pendingStates = pendingStates[:len(pendingStates)-1]
+
+		if state == nil {
+			resultCount++
+			continue
+		}
+
+		switch state.(type) {
+		case *syntaxRootIdentifier, *syntaxCurrentRootIdentifier:
+			pendingStates = append(pendingStates, state.getNext())
+		case *syntaxRecursiveChildIdentifier:
+			targets = append(targets, state)
+			pendingStates = append(pendingStates, state.getNext())
+		default:
+			targets = append(targets, state)
+			isStreamable = isStreamable && e.isStreamableNode(state)
+		}
+	}
+
+	return targets, resultCount, isStreamable
+}
+
+func (e *streamEvaluator) isStreamableNode(node syntaxNode) bool {
+	switch typedNode := node.(type) {
+	case *syntaxChildSingleIdentifier, *syntaxChildWildcardIdentifier, *syntaxChildMultiIdentifier,
+		*syntaxFilterQualifier:
+		return true
+	case *syntaxUnionQualifier:
+		for _, subscript := range typedNode.subscripts {
+			if !e.isStreamableSubscript(subscript) {
+				return false
+			}
+		}
+		return true
+	case *syntaxMultiSelectorQualifier:
+		for _, selector := range typedNode.selectors {
+			if !e.isStreamableNode(selector) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// isStreamableSubscript returns whether the subscript can be decided without the length of the array.
+func (e *streamEvaluator) isStreamableSubscript(subscript syntaxSubscript) bool {
+	switch typedSubscript := subscript.(type) {
+	case *syntaxWildcardSubscript:
+		return true
+	case *syntaxIndexSubscript:
+		return typedSubscript.number >= 0
+	case *syntaxSlicePositiveStepSubscript:
+		return (typedSubscript.start.isOmitted || typedSubscript.start.number >= 0) &&
+			(typedSubscript.end.isOmitted || typedSubscript.end.number >= 0)
+	}
+	return false
+}
+
+func (e *streamEvaluator) appendObjectChildStates(
+	childStates []syntaxNode, target syntaxNode, key string) []syntaxNode {
+
+	switch typedTarget := target.(type) {
+	case *syntaxRecursiveChildIdentifier:
+		return append(childStates, typedTarget)
+	case *syntaxChildWildcardIdentifier:
+		return append(childStates, typedTarget.next)
+	case *syntaxChildSingleIdentifier:
+		if typedTarget.identifier == key {
+			return append(childStates, typedTarget.next)
+		}
+	case *syntaxChildMultiIdentifier:
+		for _, identifier := range typedTarget.identifiers {
+			childStates = e.appendObjectChildStates(childStates, identifier, key)
+		}
+	case *syntaxMultiSelectorQualifier:
+		for _, selector := range typedTarget.selectors {
+			childStates = e.appendObjectChildStates(childStates, selector, key)
+		}
+	}
+	return childStates
+}
+
+func (e *streamEvaluator) appendListChildStates(
+	childStates []syntaxNode, target syntaxNode, index int) []syntaxNode {
+
+	switch typedTarget := target.(type) {
+	case *syntaxRecursiveChildIdentifier:
+		return append(childStates, typedTarget)
+	case *syntaxChildWildcardIdentifier:
+		return append(childStates, typedTarget.next)
+	case *syntaxChildMultiIdentifier:
+		if typedTarget.isAllWildcard {
+			for _, identifier := range typedTarget.identifiers {
+				childStates = e.appendListChildStates(childStates, identifier, index)
+			}
+		}
+	case *syntaxUnionQualifier:
+		for _, subscript := range typedTarget.subscripts {
+			if e.containsIndex(subscript, index) {
+				childStates = append(childStates, typedTarget.next)
+			}
+		}
+	case *syntaxMultiSelectorQualifier:
+		for _, selector := range typedTarget.selectors {
+			childStates = e.appendListChildStates(childStates, selector, index)
+		}
+	}
+	return childStates
+}
+
+func (e *streamEvaluator) hasFilter(targets []syntaxNode) bool {
+	for _, target := range targets {
+		switch typedTarget := target.(type) {
+		case *syntaxFilterQualifier:
+			return true
+		case *syntaxMultiSelectorQualifier:
+			if e.hasFilter(typedTarget.selectors) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// appendFilterChildStates appends the next node of the filter if the filter selects the child value.
+func (e *streamEvaluator) appendFilterChildStates(
+	childStates []syntaxNode, target syntaxNode, root, value interface{}) []syntaxNode {
+
+	switch typedTarget := target.(type) {
+	case *syntaxFilterQualifier:
+		var container bufferContainer
+		valueList := typedTarget.query.compute(root, []interface{}{value}, &container)
+		if _, ok := valueList[0].(struct{}); !ok {
+			return append(childStates, typedTarget.next)
+		}
+	case *syntaxMultiSelectorQualifier:
+		for _, selector := range typedTarget.selectors {
+			childStates = e.appendFilterChildStates(childStates, selector, root, value)
+		}
+	}
+	return childStates
+}
+
+func (e *streamEvaluator) containsIndex(subscript syntaxSubscript, index int) bool {
+	switch typedSubscript := subscript.(type) {
+	case *syntaxWildcardSubscript:
+		return true
+	case *syntaxIndexSubscript:
+		return typedSubscript.number == index
+	case *syntaxSlicePositiveStepSubscript:
+		start := 0
+		if !typedSubscript.start.isOmitted {
+			start = typedSubscript.start.number
+		}
+		if index < start || typedSubscript.step.number <= 0 {
+			return false
+		}
+		if !typedSubscript.end.isOmitted && index >= typedSubscript.end.number {
+			return false
+		}
+		return (index-start)%typedSubscript.step.number == 0
+	}
+	return false
+}
+
+// evaluateValue decodes the whole value and evaluates the states on it
+// in the same way as Retrieve.
+func (e *streamEvaluator) evaluateValue(states []syntaxNode, path string) error {
+	rawValue, err := e.source.readValue()
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(rawValue, &value); err != nil {
+		return err
+	}
+
+	root := value
+	if e.rootLoader != nil {
+		if root, err = e.rootLoader(); err != nil {
+			return err
+		}
+	}
+
+	for _, state := range states {
+		if state == nil {
+			var result interface{} = value
+			if e.rawMode {
+				result = rawValue
+			}
+			if err := e.emit(result, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, state := range states {
+		if state == nil {
+			continue
+		}
+
+		container := bufferContainer{
+			pathMode: e.pathMode,
+			path:     path,
+		}
+		if err := state.retrieve(root, value, &container); err != nil {
+			if functionErr, ok := err.(ErrorFunctionFailed); ok {
+				return functionErr
+			}
+		}
+		for _, result := range container.result {
+			if e.rawMode {
+				if result, err = e.encodeResult(result); err != nil {
+					return err
+				}
+			}
+			if err := e.callback(result); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodeResult re-encodes the result of the decoded value into json.RawMessage in raw mode.
+func (e *streamEvaluator) encodeResult(result interface{}) (interface{}, error) {
+	if pathValue, ok := result.(PathValue); ok {
+		rawValue, err := json.Marshal(pathValue.Value)
+		pathValue.Value = json.RawMessage(rawValue)
+		return pathValue, err
+	}
+	rawValue, err := json.Marshal(result)
+	return json.RawMessage(rawValue), err
+}
+
+func (e *streamEvaluator) skipValue() error {
+	return e.source.skipValue()
+}
+
+func (e *streamEvaluator) emit(value interface{}, path string) error {
+	if e.pathMode {
+		return e.callback(PathValue{
+			Path:  path,
+			Value: value,
+		})
+	}
+	return e.callback(value)
+}
+
+func (e *streamEvaluator) getMapPath(path string, key string) string {
+	if !e.pathMode {
+		return ``
+	}
+	return path + `[` + quoteNormalizedPathKey(key) + `]`
+}
+
+func (e *streamEvaluator) getListPath(path string, index int) string {
+	if !e.pathMode {
+		return ``
+	}
+	return path + `[` + strconv.Itoa(index) + `]`
+}
@@ -0,0 +1,115 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+// streamSource is the sequential access to the JSON values used by streamEvaluator.
+type streamSource interface {
+	// readValue returns the raw bytes of the next value.
+	readValue() (json.RawMessage, error)
+	// skipValue skips the next value.
+	skipValue() error
+	// beginContainer consumes '{' or '[' of the next value and returns it,
+	// or consumes the next scalar value and returns 0.
+	beginContainer() (byte, error)
+	// more returns whether there is another member or element in the current container.
+	more() (bool, error)
+	// readKey returns the name of the next member and consumes the following colon.
+	readKey() (string, error)
+	// endContainer consumes '}' or ']' of the current container.
+	endContainer() error
+	// end returns the error if any data follows the top-level value.
+	end() error
+}
+
+type decoderStreamSource struct {
+	decoder *json.Decoder
+}
+
+func (s *decoderStreamSource) readValue() (json.RawMessage, error) {
+	var rawValue json.RawMessage
+	err := s.decoder.Decode(&rawValue)
+	return rawValue, err
+}
+
+func (s *decoderStreamSource) skipValue() error {
+	var depth int
+	for {
+		token, err := s.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *decoderStreamSource) beginContainer() (byte, error) {
+	token, err := s.decoder.Token()
+	if err != nil {
+		return 0, err
+	}
+	switch token {
+	case json.Delim('{'):
+		return '{', nil
+	case json.Delim('['):
+		return '[', nil
+	}
+	return 0, nil
+}
+
+func (s *decoderStreamSource) more() (bool, error) {
+	return s.decoder.More(), nil
+}
+
+func (s *decoderStreamSource) readKey() (string, error) {
+	token, err := s.decoder.Token()
+	if err != nil {
+		return ``, err
+	}
+	return token.(string), nil
+}
+
+func (s *decoderStreamSource) endContainer() error {
+	_, err := s.decoder.Token()
+	return err
+}
+
+func (s *decoderStreamSource) end() error {
+	offset := s.decoder.InputOffset()
+	// More reads ahead to the next non-blank character or the end of the reader.
+	s.decoder.More()
+	buffered, _ := ioutil.ReadAll(s.decoder.Buffered())
+	for index, char := range buffered {
+		switch char {
+		case ' ', '\t', '\n', '\r':
+			continue
+		}
+		return getTrailingDataError(char, offset+int64(index)+1)
+	}
+
+	if _, err := s.decoder.Token(); err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// getTrailingDataError returns the same error as json.Unmarshal for the character following the top-level value.
+func getTrailingDataError(char byte, offset int64) error {
+	var value interface{}
+	err := json.Unmarshal([]byte{'0', char}, &value)
+	if syntaxError, ok := err.(*json.SyntaxError); ok {
+		syntaxError.Offset = offset
+	}
+	return err
+}
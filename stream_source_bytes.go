@@ -0,0 +1,241 @@
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// bytesStreamSource scans the raw JSON bytes without decoding the skipped values.
+// The structure of the containers is checked, but the scalar values are only delimited.
+// The malformed JSON is reported with the error of json.Unmarshal for the whole data.
+type bytesStreamSource struct {
+	data       []byte
+	position   int
+	delimiters []byte
+}
+
+func (s *bytesStreamSource) readValue() (json.RawMessage, error) {
+	s.skipBlank()
+	start := s.position
+	if err := s.skipValue(); err != nil {
+		return nil, err
+	}
+	return s.data[start:s.position], nil
+}
+
+func (s *bytesStreamSource) skipValue() error {
+	s.skipBlank()
+	if s.position >= len(s.data) {
+		return s.syntaxError()
+	}
+
+	switch s.data[s.position] {
+	case '"':
+		return s.skipString()
+
+	case '{':
+		return s.skipObject()
+
+	case '[':
+		return s.skipArray()
+
+	default:
+		start := s.position
+		for s.position < len(s.data) && !s.isDelimiter(s.data[s.position]) {
+			s.position++
+		}
+		if start == s.position {
+			return s.syntaxError()
+		}
+		return nil
+	}
+}
+
+// skipObject skips the object checking the colons and the commas between the members.
+func (s *bytesStreamSource) skipObject() error {
+	s.beginDelimiter('{')
+	for {
+		if ok, err := s.more(); !ok || err != nil {
+			if err != nil {
+				return err
+			}
+			return s.endContainer()
+		}
+		if _, err := s.readKey(); err != nil {
+			return err
+		}
+		if err := s.skipValue(); err != nil {
+			return err
+		}
+	}
+}
+
+// skipArray skips the array checking the commas between the elements.
+func (s *bytesStreamSource) skipArray() error {
+	s.beginDelimiter('[')
+	for {
+		if ok, err := s.more(); !ok || err != nil {
+			if err != nil {
+				return err
+			}
+			return s.endContainer()
+		}
+		if err := s.skipValue(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *bytesStreamSource) skipString() error {
+	s.position++
+	for s.position < len(s.data) {
+		switch s.data[s.position] {
+		case '\\':
+			s.position += 2
+			continue
+		case '"':
+			s.position++
+			return nil
+		}
+		s.position++
+	}
+	return s.syntaxError()
+}
+
+func (s *bytesStreamSource) beginContainer() (byte, error) {
+	s.skipBlank()
+	if s.position >= len(s.data) {
+		return 0, s.syntaxError()
+	}
+
+	switch delimiter := s.data[s.position]; delimiter {
+	case '{', '[':
+		s.beginDelimiter(delimiter)
+		return delimiter, nil
+	}
+
+	return 0, s.skipValue()
+}
+
+// more requires the comma before the members and the elements except the first one.
+func (s *bytesStreamSource) more() (bool, error) {
+	s.skipBlank()
+	if s.position >= len(s.data) {
+		return false, s.syntaxError()
+	}
+
+	isFirst := s.isAfterBegin()
+	switch s.data[s.position] {
+	case '}', ']':
+		return false, nil
+	case ',':
+		if isFirst {
+			return false, s.syntaxError()
+		}
+		s.position++
+		return true, nil
+	}
+	if !isFirst {
+		return false, s.syntaxError()
+	}
+	return true, nil
+}
+
+// isAfterBegin returns whether the last non-blank character is the beginning of the container.
+func (s *bytesStreamSource) isAfterBegin() bool {
+	for position := s.position - 1; position >= 0; position-- {
+		switch s.data[position] {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '{', '[':
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+func (s *bytesStreamSource) readKey() (string, error) {
+	s.skipBlank()
+	if s.position >= len(s.data) || s.data[s.position] != '"' {
+		return ``, s.syntaxError()
+	}
+
+	start := s.position
+	if err := s.skipString(); err != nil {
+		return ``, err
+	}
+	rawKey := s.data[start:s.position]
+
+	s.skipBlank()
+	if s.position >= len(s.data) || s.data[s.position] != ':' {
+		return ``, s.syntaxError()
+	}
+	s.position++
+
+	if bytes.IndexByte(rawKey, '\\') < 0 {
+		return string(rawKey[1 : len(rawKey)-1]), nil
+	}
+
+	var key string
+	if err := json.Unmarshal(rawKey, &key); err != nil {
+		return ``, s.syntaxError()
+	}
+	return key, nil
+}
+
+// endContainer requires the closing delimiter matching the opening one.
+func (s *bytesStreamSource) endContainer() error {
+	s.skipBlank()
+	last := len(s.delimiters) - 1
+	if s.position >= len(s.data) || last < 0 {
+		return s.syntaxError()
+	}
+	if s.delimiters[last] == '{' && s.data[s.position] != '}' ||
+		s.delimiters[last] == '[' && s.data[s.position] != ']' {
+		return s.syntaxError()
+	}
+	s.delimiters = s.delimiters[:last]
+	s.position++
+	return nil
+}
+
+func (s *bytesStreamSource) beginDelimiter(delimiter byte) {
+	s.delimiters = append(s.delimiters, delimiter)
+	s.position++
+}
+
+func (s *bytesStreamSource) end() error {
+	s.skipBlank()
+	if s.position < len(s.data) {
+		return s.syntaxError()
+	}
+	return nil
+}
+
+func (s *bytesStreamSource) skipBlank() {
+	for s.position < len(s.data) {
+		switch s.data[s.position] {
+		case ' ', '\t', '\n', '\r':
+			s.position++
+		default:
+			return
+		}
+	}
+}
+
+func (s *bytesStreamSource) isDelimiter(char byte) bool {
+	switch char {
+	case ',', ':', '}', ']', ' ', '\t', '\n', '\r':
+		return true
+	}
+	return false
+}
+
+func (s *bytesStreamSource) syntaxError() error {
+	var value interface{}
+	if err := json.Unmarshal(s.data, &value); err != nil {
+		return err
+	}
+	return &json.SyntaxError{Offset: int64(s.position)}
+}
@@ -7,10 +7,16 @@ type syntaxBasicAnyValueComparator struct {
 
 func (c *syntaxBasicAnyValueComparator) typeCast(values []interface{}) bool {
 	for index := range values {
-		if number, ok := values[index].(json.Number); ok {
-			if floatNumber, err := number.Float64(); err == nil {
+		switch typedValue := values[index].(type) {
+		case json.Number:
+			if floatNumber, err := typedValue.Float64(); err == nil {
 				values[index] = floatNumber
 			}
+		case nil, string, float64, bool, map[string]interface{}, []interface{}, struct{}:
+		default:
+			if scalar, ok := convertReflectScalar(typedValue); ok {
+				values[index] = scalar
+			}
 		}
 	}
 	return len(values) > 0
@@ -17,6 +17,13 @@ func (c *syntaxBasicNumericComparator) typeCast(values []interface{}) bool {
 				values[index] = floatNumber
 			}
 		default:
+			if scalar, ok := convertReflectScalar(typedValue); ok {
+				if _, ok := scalar.(float64); ok {
+					foundValue = true
+					values[index] = scalar
+					continue
+				}
+			}
 			values[index] = struct{}{}
 		}
 	}
@@ -8,9 +8,16 @@ func (c *syntaxBasicStringComparator) typeCast(values []interface{}) bool {
 	for index := range values {
 		if _, ok := values[index].(string); ok {
 			foundValue = true
-		} else {
-			values[index] = struct{}{}
+			continue
 		}
+		if scalar, ok := convertReflectScalar(values[index]); ok {
+			if _, ok := scalar.(string); ok {
+				foundValue = true
+				values[index] = scalar
+				continue
+			}
+		}
+		values[index] = struct{}{}
 	}
 	return foundValue
 }
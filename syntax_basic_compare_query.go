@@ -46,6 +46,11 @@ func (q *syntaxBasicCompareQuery) compute(
 		}
 	}
 
+	if q.leftParam.isLiteral && q.rightParam.isLiteral {
+		// The literals were not compared because Nothing is on either side.
+		return []interface{}{struct{}{}}
+	}
+
 	if !q.leftParam.isLiteral {
 		if !rightFound {
 			q.setBlankValues(leftValues)
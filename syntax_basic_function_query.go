@@ -0,0 +1,30 @@
+package jsonpath
+
+type syntaxBasicFunctionQuery struct {
+	params    []*syntaxBasicCompareParameter
+	isLiteral bool
+}
+
+func (f *syntaxBasicFunctionQuery) computeParams(
+	root interface{}, currentList []interface{}, container *bufferContainer) ([][]interface{}, int) {
+
+	paramValues := make([][]interface{}, len(f.params))
+	for index := range f.params {
+		paramValues[index] = f.params[index].compute(root, currentList, container)
+	}
+
+	if f.isLiteral {
+		return paramValues, 1
+	}
+	return paramValues, len(currentList)
+}
+
+func (f *syntaxBasicFunctionQuery) getParamValue(values []interface{}, index int) interface{} {
+	switch len(values) {
+	case 0:
+		return struct{}{}
+	case 1:
+		return values[0]
+	}
+	return values[index]
+}
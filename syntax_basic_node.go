@@ -50,17 +50,19 @@ func (i *syntaxBasicNode) getNext() syntaxNode {
 func (i *syntaxBasicNode) retrieveAnyValueNext(
 	root interface{}, nextSrc interface{}, container *bufferContainer) errorRuntime {
 
+	container.visit()
+
 	if i.next != nil {
 		return i.next.retrieve(root, nextSrc, container)
 	}
 
 	if i.accessorMode {
-		container.result = append(container.result, Accessor{
+		container.appendResult(Accessor{
 			Get: func() interface{} { return nextSrc },
 			Set: nil,
-		})
+		}, container.path)
 	} else {
-		container.result = append(container.result, nextSrc)
+		container.appendResult(nextSrc, container.path)
 	}
 
 	return nil
@@ -69,24 +71,51 @@ func (i *syntaxBasicNode) retrieveAnyValueNext(
 func (i *syntaxBasicNode) retrieveMapNext(
 	root interface{}, currentMap map[string]interface{}, key string, container *bufferContainer) errorRuntime {
 
+	container.visit()
+
 	nextNode, ok := currentMap[key]
 	if !ok {
+		if container.upsertMode {
+			return addErrorMapPath(i.retrieveMissingNext(root, func(value interface{}) {
+				currentMap[key] = value
+			}, container.getMapPointer(key), container.getMapPath(key), container), key)
+		}
 		return ErrorMemberNotExist{
 			errorBasicRuntime: i.errorRuntime,
 		}
 	}
 
 	if i.next != nil {
-		return i.next.retrieve(root, nextNode, container)
+		if i.accessorMode {
+			return addErrorMapPath(i.retrieveSetterNext(root, nextNode, func(value interface{}) {
+				if _, ok := currentMap[key]; ok {
+					currentMap[key] = value
+				}
+			}, container.getMapPointer(key), container.getMapPath(key), container), key)
+		}
+		if container.pathMode {
+			parentPath := container.path
+			container.path = container.getMapPath(key)
+			err := i.next.retrieve(root, nextNode, container)
+			container.path = parentPath
+			return addErrorMapPath(err, key)
+		}
+		return addErrorMapPath(i.next.retrieve(root, nextNode, container), key)
 	}
 
 	if i.accessorMode {
-		container.result = append(container.result, Accessor{
-			Get: func() interface{} { return currentMap[key] },
-			Set: func(value interface{}) { currentMap[key] = value },
-		})
+		accessor := Accessor{
+			Get:    func() interface{} { return currentMap[key] },
+			Set:    func(value interface{}) { currentMap[key] = value },
+			Delete: func() { delete(currentMap, key) },
+		}
+		if container.recorder != nil {
+			accessor = container.recorder.recordMapAccessor(
+				accessor, container.getRoot, currentMap, key, container.getMapPointer(key))
+		}
+		container.appendResult(accessor, container.getMapPath(key))
 	} else {
-		container.result = append(container.result, nextNode)
+		container.appendResult(nextNode, container.getMapPath(key))
 	}
 
 	return nil
@@ -95,48 +124,204 @@ func (i *syntaxBasicNode) retrieveMapNext(
 func (i *syntaxBasicNode) retrieveListNext(
 	root interface{}, currentList []interface{}, index int, container *bufferContainer) errorRuntime {
 
+	container.visit()
+
 	if i.next != nil {
-		return i.next.retrieve(root, currentList[index], container)
+		if i.accessorMode {
+			list := container.getAccessorList(currentList)
+			return addErrorListPath(i.retrieveSetterNext(root, currentList[index], func(value interface{}) {
+				list.set(index, value)
+			}, container.getListPointer(list, index), container.getListPath(index), container), index)
+		}
+		if container.pathMode {
+			parentPath := container.path
+			container.path = container.getListPath(index)
+			err := i.next.retrieve(root, currentList[index], container)
+			container.path = parentPath
+			return addErrorListPath(err, index)
+		}
+		return addErrorListPath(i.next.retrieve(root, currentList[index], container), index)
 	}
 
 	if i.accessorMode {
-		container.result = append(container.result, Accessor{
-			Get: func() interface{} { return currentList[index] },
-			Set: func(value interface{}) { currentList[index] = value },
-		})
+		list := container.getAccessorList(currentList)
+		accessor := list.getAccessor(index)
+		if container.recorder != nil {
+			accessor = container.recorder.recordListAccessor(
+				accessor, container.getRoot, list, index, container.getListPointer(list, index))
+		}
+		container.appendResult(accessor, container.getListPath(index))
 	} else {
-		container.result = append(container.result, currentList[index])
+		container.appendResult(currentList[index], container.getListPath(index))
+	}
+
+	return nil
+}
+
+// retrieveSetterNext passes the setter of the next value to the next node in accessor mode,
+// so that the next node can replace the next value to create the missing members or to delete the elements.
+// The setter does not put back the next value removed by the other accessors.
+// The JSON Pointer of the next value is also passed if the patch is recorded.
+func (i *syntaxBasicNode) retrieveSetterNext(
+	root interface{}, nextSrc interface{}, setter func(interface{}), pointer func() string, nextPath string,
+	container *bufferContainer) errorRuntime {
+
+	parentPath, parentSetter, parentPointer := container.path, container.setter, container.pointer
+	container.path, container.setter, container.pointer = nextPath, setter, pointer
+	err := i.next.retrieve(root, nextSrc, container)
+	container.path, container.setter, container.pointer = parentPath, parentSetter, parentPointer
+	return err
+}
+
+// retrieveMissingNext returns the accessor of the missing member in upsert mode.
+// The setter creates the missing member, and the following nodes wrap it with the objects and arrays to be created.
+func (i *syntaxBasicNode) retrieveMissingNext(
+	root interface{}, setter func(interface{}), pointer func() string, nextPath string,
+	container *bufferContainer) errorRuntime {
+
+	if i.next != nil {
+		return i.retrieveSetterNext(root, nil, setter, pointer, nextPath, container)
 	}
 
+	var currentValue interface{}
+	accessor := Accessor{
+		Get: func() interface{} { return currentValue },
+		Set: func(value interface{}) {
+			currentValue = value
+			setter(value)
+		},
+	}
+	if container.recorder != nil {
+		accessor = container.recorder.recordMissingAccessor(accessor, container.getRoot, pointer)
+	}
+	container.appendResult(accessor, nextPath)
+
 	return nil
 }
 
+func (i *syntaxBasicNode) retrieveReflectObjectNext(
+	root interface{}, srcObject *reflectObject, key string, container *bufferContainer) errorRuntime {
+
+	container.visit()
+
+	nextValue, ok := srcObject.getValue(key)
+	if !ok {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: i.errorRuntime,
+		}
+	}
+
+	if i.next == nil && i.accessorMode {
+		accessor := srcObject.getAccessor(key, nextValue)
+		if container.recorder != nil {
+			accessor = container.recorder.recordValueAccessor(accessor, container.getMapPointer(key))
+		}
+		container.appendResult(accessor, container.getMapPath(key))
+		return nil
+	}
+
+	return addErrorMapPath(
+		i.retrieveReflectNext(root, nextValue, container.getMapPointer(key), container.getMapPath(key), container), key)
+}
+
+func (i *syntaxBasicNode) retrieveReflectListNext(
+	root interface{}, srcList reflect.Value, index int, container *bufferContainer) errorRuntime {
+
+	container.visit()
+
+	nextValue := srcList.Index(index)
+
+	if i.next == nil && i.accessorMode {
+		accessor := getReflectValueAccessor(nextValue)
+		if container.recorder != nil {
+			accessor = container.recorder.recordValueAccessor(accessor, container.getIndexPointer(index))
+		}
+		container.appendResult(accessor, container.getListPath(index))
+		return nil
+	}
+
+	return addErrorListPath(i.retrieveReflectNext(
+		root, nextValue, container.getIndexPointer(index), container.getListPath(index), container), index)
+}
+
+func (i *syntaxBasicNode) retrieveReflectNext(
+	root interface{}, nextValue reflect.Value, pointer func() string, nextPath string,
+	container *bufferContainer) errorRuntime {
+
+	if i.next == nil {
+		container.appendResult(nextValue.Interface(), nextPath)
+		return nil
+	}
+
+	nextSrc := getReflectNextSrc(nextValue)
+	if i.accessorMode {
+		return i.retrieveSetterNext(
+			root, nextSrc, getReflectValueAccessor(nextValue).Set, pointer, nextPath, container)
+	}
+	if container.pathMode {
+		parentPath := container.path
+		container.path = nextPath
+		err := i.next.retrieve(root, nextSrc, container)
+		container.path = parentPath
+		return err
+	}
+	return i.next.retrieve(root, nextSrc, container)
+}
+
 func (i *syntaxBasicNode) setAccessorMode(mode bool) {
 	i.accessorMode = mode
 }
 
 var errorTypeUnmatched = reflect.TypeOf(ErrorTypeUnmatched{})
 
+// addBranchError adds the error of a branch, which is kept as the deepest error while no result is found.
+// In lenient mode, the error is recorded to the container regardless of the results,
+// and the node returns errorRuntimeRecorded instead of its deepest error.
+func (i *syntaxBasicNode) addBranchError(
+	err errorRuntime, deepestTextLen int, deepestError errorRuntime, container *bufferContainer) (int, errorRuntime) {
+
+	if container.isLenient() {
+		if _, ok := err.(errorRuntimeRecorded); !ok {
+			container.addFailure(err)
+		}
+		return deepestTextLen, errorRuntimeRecorded{errorBasicRuntime: i.errorRuntime}
+	}
+
+	if len(container.result) > 0 {
+		return deepestTextLen, deepestError
+	}
+
+	return i.addDeepestError(err, deepestTextLen, deepestError)
+}
+
 func (i *syntaxBasicNode) addDeepestError(
 	err errorRuntime, deepestTextLen int, deepestError errorRuntime) (int, errorRuntime) {
 
+	if i.isDeeperError(err, deepestTextLen, deepestError) {
+		return len(err.getSyntaxNode().getConnectedText()), err
+	}
+
+	return deepestTextLen, deepestError
+}
+
+// isDeeperError reports whether the error replaces the deepest error.
+func (i *syntaxBasicNode) isDeeperError(err errorRuntime, deepestTextLen int, deepestError errorRuntime) bool {
 	textLength := len(err.getSyntaxNode().getConnectedText())
 
 	if deepestTextLen == 0 || deepestTextLen > textLength {
-		deepestTextLen = textLength
-		deepestError = nil
+		return true
 	}
 
 	if deepestTextLen == textLength {
 		if deepestError == nil {
-			return deepestTextLen, err
+			return true
 		}
 
 		deepestErrorType := reflect.TypeOf(deepestError)
 		if deepestErrorType == errorTypeUnmatched {
-			return deepestTextLen, err
+			return true
 		}
 	}
 
-	return deepestTextLen, deepestError
+	return false
 }
@@ -0,0 +1,38 @@
+package jsonpath
+
+type syntaxBasicScriptArithmetic struct {
+	leftScript  syntaxScript
+	rightScript syntaxScript
+}
+
+func (a *syntaxBasicScriptArithmetic) evaluateOperands(
+	root, current interface{}, container *bufferContainer) (interface{}, interface{}, bool) {
+
+	leftValue, ok := a.leftScript.evaluate(root, current, container)
+	if !ok {
+		return nil, nil, false
+	}
+	rightValue, ok := a.rightScript.evaluate(root, current, container)
+	if !ok {
+		return nil, nil, false
+	}
+	return leftValue, rightValue, true
+}
+
+func (a *syntaxBasicScriptArithmetic) evaluateNumbers(
+	root, current interface{}, container *bufferContainer) (float64, float64, bool) {
+
+	leftValue, rightValue, ok := a.evaluateOperands(root, current, container)
+	if !ok {
+		return 0, 0, false
+	}
+	leftNumber, ok := leftValue.(float64)
+	if !ok {
+		return 0, 0, false
+	}
+	rightNumber, ok := rightValue.(float64)
+	if !ok {
+		return 0, 0, false
+	}
+	return leftNumber, rightNumber, true
+}
@@ -0,0 +1,5 @@
+package jsonpath
+
+type syntaxScript interface {
+	evaluate(root, current interface{}, container *bufferContainer) (interface{}, bool)
+}
@@ -10,7 +10,7 @@ type syntaxAggregateFunction struct {
 func (f *syntaxAggregateFunction) retrieve(
 	root, current interface{}, container *bufferContainer) errorRuntime {
 
-	values := bufferContainer{}
+	values := bufferContainer{limiter: container.limiter}
 
 	if err := f.param.retrieve(root, current, &values); err != nil {
 		return err
@@ -19,6 +19,11 @@ func (f *syntaxAggregateFunction) retrieve(
 	if !f.param.isValueGroup() {
 		if arrayParam, ok := values.result[0].([]interface{}); ok {
 			values.result = arrayParam
+		} else if srcList, ok := getReflectList(values.result[0]); ok {
+			values.result = make([]interface{}, srcList.Len())
+			for index := range values.result {
+				values.result[index] = srcList.Index(index).Interface()
+			}
 		}
 	}
 
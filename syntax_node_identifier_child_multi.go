@@ -20,10 +20,17 @@ func (i *syntaxChildMultiIdentifier) retrieve(
 			// then switch to syntaxUnionQualifier.
 			return i.unionQualifier.retrieve(root, current, container)
 		}
+		if _, ok := getReflectList(current); ok {
+			return i.unionQualifier.retrieve(root, current, container)
+		}
 	}
 
 	srcMap, ok := current.(map[string]interface{})
 	if !ok {
+		if srcObject, ok := getReflectObject(current); ok {
+			return i.retrieveReflectObject(root, current, srcObject, container)
+		}
+
 		foundType := msgTypeNull
 		if current != nil {
 			foundType = reflect.TypeOf(current).String()
@@ -45,17 +52,48 @@ func (i *syntaxChildMultiIdentifier) retrieveMap(
 	var deepestError errorRuntime
 
 	for _, identifier := range i.identifiers {
-		if singleIdentifier, ok := identifier.(*syntaxChildSingleIdentifier); ok {
+		// The missing members are retrieved only in lenient mode, so that their errors are recorded.
+		if singleIdentifier, ok := identifier.(*syntaxChildSingleIdentifier); ok && !container.isLenient() {
 			if _, ok := srcMap[singleIdentifier.identifier]; !ok {
 				continue
 			}
 		}
 
 		if err := identifier.retrieve(root, srcMap, container); err != nil {
-			if len(container.result) == 0 {
-				deepestTextLen, deepestError = i.addDeepestError(err, deepestTextLen, deepestError)
+			deepestTextLen, deepestError = i.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: i.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+func (i *syntaxChildMultiIdentifier) retrieveReflectObject(
+	root, current interface{}, srcObject *reflectObject, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	for _, identifier := range i.identifiers {
+		// The missing members are retrieved only in lenient mode, so that their errors are recorded.
+		if singleIdentifier, ok := identifier.(*syntaxChildSingleIdentifier); ok && !container.isLenient() {
+			if _, ok := srcObject.getValue(singleIdentifier.identifier); !ok {
+				continue
 			}
 		}
+
+		if err := identifier.retrieve(root, current, container); err != nil {
+			deepestTextLen, deepestError = i.addBranchError(err, deepestTextLen, deepestError, container)
+		}
 	}
 
 	if len(container.result) > 0 {
@@ -6,6 +6,8 @@ type syntaxChildSingleIdentifier struct {
 	*syntaxBasicNode
 
 	identifier string
+	// errorMemberRuntime is shared by the errors located at the missing member.
+	errorMemberRuntime *errorBasicRuntime
 }
 
 func (i *syntaxChildSingleIdentifier) retrieve(
@@ -13,6 +15,17 @@ func (i *syntaxChildSingleIdentifier) retrieve(
 
 	srcMap, ok := current.(map[string]interface{})
 	if !ok {
+		if current == nil && container.upsertMode && container.setter != nil {
+			parentSetter := container.setter
+			return i.retrieveMissingNext(root, func(value interface{}) {
+				parentSetter(map[string]interface{}{i.identifier: value})
+			}, container.getMapPointer(i.identifier), container.getMapPath(i.identifier), container)
+		}
+
+		if srcObject, ok := getReflectObject(current); ok {
+			return i.locateMissingMember(i.retrieveReflectObjectNext(root, srcObject, i.identifier, container))
+		}
+
 		foundType := msgTypeNull
 		if current != nil {
 			foundType = reflect.TypeOf(current).String()
@@ -24,5 +37,22 @@ func (i *syntaxChildSingleIdentifier) retrieve(
 		}
 	}
 
-	return i.retrieveMapNext(root, srcMap, i.identifier, container)
+	return i.locateMissingMember(i.retrieveMapNext(root, srcMap, i.identifier, container))
+}
+
+// locateMissingMember locates the error of the missing member at the member instead of the current value.
+// The error is built only for the identifier created at runtime, such as the result of the script.
+func (i *syntaxChildSingleIdentifier) locateMissingMember(err errorRuntime) errorRuntime {
+	if err == nil {
+		return nil
+	}
+	memberNotExist, ok := err.(ErrorMemberNotExist)
+	if !ok || memberNotExist.errorBasicRuntime != i.errorRuntime {
+		return err
+	}
+	if i.errorMemberRuntime == nil {
+		return addErrorMapSegment(err, i.identifier)
+	}
+	memberNotExist.errorBasicRuntime = i.errorMemberRuntime
+	return memberNotExist
 }
@@ -19,6 +19,13 @@ func (i *syntaxChildWildcardIdentifier) retrieve(
 		return i.retrieveList(root, typedNodes, container)
 
 	default:
+		if srcObject, ok := getReflectObject(current); ok {
+			return i.retrieveReflectObject(root, srcObject, container)
+		}
+		if srcList, ok := getReflectList(current); ok {
+			return i.retrieveReflectList(root, srcList, container)
+		}
+
 		foundType := msgTypeNull
 		if current != nil {
 			foundType = reflect.TypeOf(current).String()
@@ -41,9 +48,7 @@ func (i *syntaxChildWildcardIdentifier) retrieveMap(
 
 	for _, key := range *sortKeys {
 		if err := i.retrieveMapNext(root, srcMap, key, container); err != nil {
-			if len(container.result) == 0 {
-				deepestTextLen, deepestError = i.addDeepestError(err, deepestTextLen, deepestError)
-			}
+			deepestTextLen, deepestError = i.addBranchError(err, deepestTextLen, deepestError, container)
 		}
 	}
 
@@ -70,9 +75,57 @@ func (i *syntaxChildWildcardIdentifier) retrieveList(
 
 	for index := range srcList {
 		if err := i.retrieveListNext(root, srcList, index, container); err != nil {
-			if len(container.result) == 0 {
-				deepestTextLen, deepestError = i.addDeepestError(err, deepestTextLen, deepestError)
-			}
+			deepestTextLen, deepestError = i.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: i.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+func (i *syntaxChildWildcardIdentifier) retrieveReflectObject(
+	root interface{}, srcObject *reflectObject, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	for _, key := range srcObject.getKeys() {
+		if err := i.retrieveReflectObjectNext(root, srcObject, key, container); err != nil {
+			deepestTextLen, deepestError = i.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: i.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+func (i *syntaxChildWildcardIdentifier) retrieveReflectList(
+	root interface{}, srcList reflect.Value, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	for index := 0; index < srcList.Len(); index++ {
+		if err := i.retrieveReflectListNext(root, srcList, index, container); err != nil {
+			deepestTextLen, deepestError = i.addBranchError(err, deepestTextLen, deepestError, container)
 		}
 	}
 
@@ -1,8 +1,6 @@
 package jsonpath
 
-import (
-	"reflect"
-)
+import "reflect"
 
 type syntaxRecursiveChildIdentifier struct {
 	*syntaxBasicNode
@@ -11,12 +9,94 @@ type syntaxRecursiveChildIdentifier struct {
 	nextListRequired bool
 }
 
+// recursiveTargetPaths keeps the paths of the target nodes to set them in path mode and to locate the error.
+// Outside path mode, only the segments of the target nodes are kept until the first result is found,
+// since the error is returned only if no result is found, and the path is built only for the error.
+type recursiveTargetPaths struct {
+	container  *bufferContainer
+	parentPath string
+	paths      []string
+	segments   []recursiveTargetSegment
+	targets    []int
+	current    int
+}
+
+// recursiveTargetSegment is the member or the element of the target node linked to the segment of its parent.
+// The index is negative for the member.
+type recursiveTargetSegment struct {
+	parent int
+	key    string
+	index  int
+}
+
+func (t *recursiveTargetPaths) pop() {
+	if t.paths != nil {
+		t.container.path = t.paths[len(t.paths)-1]
+		t.paths = t.paths[:len(t.paths)-1]
+		return
+	}
+	if t.targets == nil {
+		return
+	}
+	if len(t.container.result) > 0 {
+		t.segments, t.targets = nil, nil
+		return
+	}
+	t.current = t.targets[len(t.targets)-1]
+	t.targets = t.targets[:len(t.targets)-1]
+}
+
+func (t *recursiveTargetPaths) pushMap(key string) {
+	if t.paths != nil {
+		t.paths = append(t.paths, t.container.getMapPath(key))
+		return
+	}
+	if t.targets != nil {
+		t.push(recursiveTargetSegment{parent: t.current, key: key, index: -1})
+	}
+}
+
+func (t *recursiveTargetPaths) pushList(index int) {
+	if t.paths != nil {
+		t.paths = append(t.paths, t.container.getListPath(index))
+		return
+	}
+	if t.targets != nil {
+		t.push(recursiveTargetSegment{parent: t.current, index: index})
+	}
+}
+
+func (t *recursiveTargetPaths) push(segment recursiveTargetSegment) {
+	t.segments = append(t.segments, segment)
+	t.targets = append(t.targets, len(t.segments)-1)
+}
+
+// getTargetPath returns the path of the current target node relative to the value of the recursive descent.
+func (t *recursiveTargetPaths) getTargetPath() string {
+	if t.container.pathMode {
+		return t.container.path[len(t.parentPath):]
+	}
+	var path string
+	for index := t.current; index > 0; index = t.segments[index].parent {
+		if segment := t.segments[index]; segment.index < 0 {
+			path = joinMapPath(``, segment.key) + path
+		} else {
+			path = joinListPath(``, segment.index) + path
+		}
+	}
+	return path
+}
+
+// recursiveTargetLocation is the setter and the JSON Pointer of the target value in accessor mode.
+type recursiveTargetLocation struct {
+	setter  func(interface{})
+	pointer func() string
+}
+
 func (i *syntaxRecursiveChildIdentifier) retrieve(
 	root, current interface{}, container *bufferContainer) errorRuntime {
 
-	switch current.(type) {
-	case map[string]interface{}, []interface{}:
-	default:
+	if !isContainerValue(current) {
 		foundType := msgTypeNull
 		if current != nil {
 			foundType = reflect.TypeOf(current).String()
@@ -34,25 +114,72 @@ func (i *syntaxRecursiveChildIdentifier) retrieve(
 	targetNodes := make([]interface{}, 1, 5)
 	targetNodes[0] = current
 
+	// The paths of the target nodes are built in path mode, which is also used by lenient mode.
+	parentPath := container.path
+	targetPaths := recursiveTargetPaths{container: container, parentPath: parentPath}
+	if container.pathMode {
+		targetPaths.paths = make([]string, 1, 5)
+		targetPaths.paths[0] = parentPath
+	} else if len(container.result) == 0 {
+		targetPaths.segments = make([]recursiveTargetSegment, 1, 5)
+		targetPaths.targets = make([]int, 1, 5)
+	}
+
+	var targetLocations []recursiveTargetLocation
+	parentSetter, parentPointer := container.setter, container.pointer
+	if i.accessorMode {
+		targetLocations = make([]recursiveTargetLocation, 1, 5)
+		targetLocations[0] = recursiveTargetLocation{setter: parentSetter, pointer: parentPointer}
+	}
+
+	// The depths of the target nodes are kept only if the retrieval is limited.
+	var targetDepths []int
+	if container.limiter != nil {
+		targetDepths = make([]int, 1, 5)
+	}
+
 	for len(targetNodes) > 0 {
 		currentNode := targetNodes[len(targetNodes)-1]
 		targetNodes = targetNodes[:len(targetNodes)-1]
+		var currentDepth int
+		if container.limiter != nil {
+			container.visit()
+			currentDepth = targetDepths[len(targetDepths)-1]
+			targetDepths = targetDepths[:len(targetDepths)-1]
+		}
+		targetPaths.pop()
+		if i.accessorMode {
+			location := targetLocations[len(targetLocations)-1]
+			container.setter, container.pointer = location.setter, location.pointer
+			targetLocations = targetLocations[:len(targetLocations)-1]
+		}
 		switch typedNodes := currentNode.(type) {
 		case map[string]interface{}:
 			if i.nextMapRequired {
 				if err := i.next.retrieve(root, typedNodes, container); err != nil {
-					if len(container.result) == 0 {
-						deepestTextLen, deepestError = i.addDeepestError(err, deepestTextLen, deepestError)
-					}
+					deepestTextLen, deepestError = i.addTargetError(
+						err, &targetPaths, deepestTextLen, deepestError, container)
 				}
 			}
 
 			sortKeys := container.getSortedKeys(typedNodes)
 			for index := len(typedNodes) - 1; index >= 0; index-- {
-				node := typedNodes[(*sortKeys)[index]]
-				switch node.(type) {
-				case map[string]interface{}, []interface{}:
+				key := (*sortKeys)[index]
+				node := typedNodes[key]
+				if isContainerValue(node) {
 					targetNodes = append(targetNodes, node)
+					targetPaths.pushMap(key)
+					if i.accessorMode {
+						srcMap := typedNodes
+						targetLocations = append(targetLocations, recursiveTargetLocation{
+							setter: func(value interface{}) {
+								if _, ok := srcMap[key]; ok {
+									srcMap[key] = value
+								}
+							},
+							pointer: container.getMapPointer(key),
+						})
+					}
 				}
 			}
 
@@ -61,22 +188,58 @@ func (i *syntaxRecursiveChildIdentifier) retrieve(
 		case []interface{}:
 			if i.nextListRequired {
 				if err := i.next.retrieve(root, typedNodes, container); err != nil {
-					if len(container.result) == 0 {
-						deepestTextLen, deepestError = i.addDeepestError(err, deepestTextLen, deepestError)
-					}
+					deepestTextLen, deepestError = i.addTargetError(
+						err, &targetPaths, deepestTextLen, deepestError, container)
 				}
 			}
 
 			for index := len(typedNodes) - 1; index >= 0; index-- {
 				node := typedNodes[index]
-				switch node.(type) {
-				case map[string]interface{}, []interface{}:
+				if isContainerValue(node) {
 					targetNodes = append(targetNodes, node)
+					targetPaths.pushList(index)
+					if i.accessorMode {
+						list, listIndex := container.getAccessorList(typedNodes), index
+						targetLocations = append(targetLocations, recursiveTargetLocation{
+							setter: func(value interface{}) {
+								list.set(listIndex, value)
+							},
+							pointer: container.getListPointer(list, listIndex),
+						})
+					}
 				}
 			}
+
+		default:
+			srcObject, isObject := getReflectObject(currentNode)
+			if (isObject && i.nextMapRequired) || (!isObject && i.nextListRequired) {
+				if err := i.next.retrieve(root, currentNode, container); err != nil {
+					deepestTextLen, deepestError = i.addTargetError(
+						err, &targetPaths, deepestTextLen, deepestError, container)
+				}
+			}
+
+			if isObject {
+				targetNodes, targetLocations = i.appendReflectObjectTargets(
+					srcObject, targetNodes, &targetPaths, targetLocations, container)
+			} else {
+				srcList, _ := getReflectList(currentNode)
+				targetNodes, targetLocations = i.appendReflectListTargets(
+					srcList, targetNodes, &targetPaths, targetLocations, container)
+			}
+		}
+
+		if container.limiter != nil && len(targetDepths) < len(targetNodes) {
+			container.limiter.checkRecursiveDepth(currentDepth+1, i.errorRuntime)
+			for len(targetDepths) < len(targetNodes) {
+				targetDepths = append(targetDepths, currentDepth+1)
+			}
 		}
 	}
 
+	container.path = parentPath
+	container.setter, container.pointer = parentSetter, parentPointer
+
 	if len(container.result) > 0 {
 		return nil
 	}
@@ -89,3 +252,75 @@ func (i *syntaxRecursiveChildIdentifier) retrieve(
 
 	return deepestError
 }
+
+// addTargetError adds the error of the next node applied to the target node.
+// The error is located at the target node, in the same way in all the modes.
+// In lenient mode, the missing member of the target node is not recorded as the failure,
+// since the recursive descent applies the next node to all the descendants.
+// It is returned only if no result and no failure is found, in the same way as the other modes.
+func (i *syntaxRecursiveChildIdentifier) addTargetError(
+	err errorRuntime, targetPaths *recursiveTargetPaths, deepestTextLen int, deepestError errorRuntime,
+	container *bufferContainer) (int, errorRuntime) {
+
+	if container.isLenient() {
+		if _, ok := err.(ErrorMemberNotExist); !ok ||
+			len(err.getSyntaxNode().getConnectedText()) != len(i.next.getConnectedText()) {
+			return i.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+		if _, ok := deepestError.(errorRuntimeRecorded); ok {
+			return deepestTextLen, deepestError
+		}
+	}
+
+	if len(container.result) > 0 || !i.isDeeperError(err, deepestTextLen, deepestError) {
+		return deepestTextLen, deepestError
+	}
+
+	err = addErrorParentPath(err, targetPaths.getTargetPath())
+	return len(err.getSyntaxNode().getConnectedText()), err
+}
+
+func (i *syntaxRecursiveChildIdentifier) appendReflectObjectTargets(
+	srcObject *reflectObject, targetNodes []interface{}, targetPaths *recursiveTargetPaths,
+	targetLocations []recursiveTargetLocation,
+	container *bufferContainer) ([]interface{}, []recursiveTargetLocation) {
+
+	keys := srcObject.getKeys()
+	for index := len(keys) - 1; index >= 0; index-- {
+		value, _ := srcObject.getValue(keys[index])
+		node := getReflectNextSrc(value)
+		if isContainerValue(node) {
+			targetNodes = append(targetNodes, node)
+			targetPaths.pushMap(keys[index])
+			if i.accessorMode {
+				targetLocations = append(targetLocations, recursiveTargetLocation{
+					setter:  getReflectValueAccessor(value).Set,
+					pointer: container.getMapPointer(keys[index]),
+				})
+			}
+		}
+	}
+	return targetNodes, targetLocations
+}
+
+func (i *syntaxRecursiveChildIdentifier) appendReflectListTargets(
+	srcList reflect.Value, targetNodes []interface{}, targetPaths *recursiveTargetPaths,
+	targetLocations []recursiveTargetLocation,
+	container *bufferContainer) ([]interface{}, []recursiveTargetLocation) {
+
+	for index := srcList.Len() - 1; index >= 0; index-- {
+		value := srcList.Index(index)
+		node := getReflectNextSrc(value)
+		if isContainerValue(node) {
+			targetNodes = append(targetNodes, node)
+			targetPaths.pushList(index)
+			if i.accessorMode {
+				targetLocations = append(targetLocations, recursiveTargetLocation{
+					setter:  getReflectValueAccessor(value).Set,
+					pointer: container.getIndexPointer(index),
+				})
+			}
+		}
+	}
+	return targetNodes, targetLocations
+}
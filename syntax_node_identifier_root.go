@@ -7,5 +7,21 @@ type syntaxRootIdentifier struct {
 func (i *syntaxRootIdentifier) retrieve(
 	root, _ interface{}, container *bufferContainer) errorRuntime {
 
+	if i.next == nil && i.accessorMode && container.setter != nil {
+		rootSetter := container.setter
+		accessor := Accessor{
+			Get: func() interface{} { return root },
+			Set: func(value interface{}) {
+				root = value
+				rootSetter(value)
+			},
+		}
+		if container.recorder != nil {
+			accessor = container.recorder.recordValueAccessor(accessor, container.pointer)
+		}
+		container.appendResult(accessor, container.path)
+		return nil
+	}
+
 	return i.retrieveAnyValueNext(root, root, container)
 }
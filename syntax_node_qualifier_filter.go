@@ -19,6 +19,13 @@ func (f *syntaxFilterQualifier) retrieve(
 		return f.retrieveList(root, typedNodes, container)
 
 	default:
+		if srcObject, ok := getReflectObject(current); ok {
+			return f.retrieveReflectObject(root, srcObject, container)
+		}
+		if srcList, ok := getReflectList(current); ok {
+			return f.retrieveReflectList(root, srcList, container)
+		}
+
 		foundType := msgTypeNull
 		if current != nil {
 			foundType = reflect.TypeOf(current).String()
@@ -44,7 +51,7 @@ func (f *syntaxFilterQualifier) retrieveMap(
 		valueList[index] = srcMap[(*sortKeys)[index]]
 	}
 
-	valueList = f.query.compute(root, valueList, container)
+	valueList = f.computeQuery(root, valueList, container)
 
 	isEachResult := len(valueList) == len(srcMap)
 
@@ -66,9 +73,7 @@ func (f *syntaxFilterQualifier) retrieveMap(
 			continue
 		}
 		if err := f.retrieveMapNext(root, srcMap, (*sortKeys)[index], container); err != nil {
-			if len(container.result) == 0 {
-				deepestTextLen, deepestError = f.addDeepestError(err, deepestTextLen, deepestError)
-			}
+			deepestTextLen, deepestError = f.addBranchError(err, deepestTextLen, deepestError, container)
 		}
 	}
 
@@ -93,7 +98,7 @@ func (f *syntaxFilterQualifier) retrieveList(
 	var deepestTextLen int
 	var deepestError errorRuntime
 
-	valueList := f.query.compute(root, srcList, container)
+	valueList := f.computeQuery(root, srcList, container)
 
 	isEachResult := len(valueList) == len(srcList)
 
@@ -115,12 +120,63 @@ func (f *syntaxFilterQualifier) retrieveList(
 			continue
 		}
 		if err := f.retrieveListNext(root, srcList, index, container); err != nil {
-			if len(container.result) == 0 {
-				deepestTextLen, deepestError = f.addDeepestError(err, deepestTextLen, deepestError)
+			deepestTextLen, deepestError = f.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: f.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+func (f *syntaxFilterQualifier) retrieveReflectObject(
+	root interface{}, srcObject *reflectObject, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	keys := srcObject.getKeys()
+
+	valueList := make([]interface{}, len(keys))
+	for index := range keys {
+		value, _ := srcObject.getValue(keys[index])
+		valueList[index] = getReflectNextSrc(value)
+	}
+
+	valueList = f.computeQuery(root, valueList, container)
+
+	isEachResult := len(valueList) == len(keys)
+
+	var nodeNotFound bool
+	if !isEachResult {
+		_, nodeNotFound = valueList[0].(struct{})
+		if nodeNotFound {
+			return ErrorMemberNotExist{
+				errorBasicRuntime: f.errorRuntime,
 			}
 		}
 	}
 
+	for index := range keys {
+		if isEachResult {
+			_, nodeNotFound = valueList[index].(struct{})
+		}
+		if nodeNotFound {
+			continue
+		}
+		if err := f.retrieveReflectObjectNext(root, srcObject, keys[index], container); err != nil {
+			deepestTextLen, deepestError = f.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
 	if len(container.result) > 0 {
 		return nil
 	}
@@ -133,3 +189,68 @@ func (f *syntaxFilterQualifier) retrieveList(
 
 	return deepestError
 }
+
+func (f *syntaxFilterQualifier) retrieveReflectList(
+	root interface{}, srcList reflect.Value, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	srcLength := srcList.Len()
+	valueList := make([]interface{}, srcLength)
+	for index := range valueList {
+		valueList[index] = getReflectNextSrc(srcList.Index(index))
+	}
+
+	valueList = f.computeQuery(root, valueList, container)
+
+	isEachResult := len(valueList) == srcLength
+
+	var nodeNotFound bool
+	if !isEachResult {
+		_, nodeNotFound = valueList[0].(struct{})
+		if nodeNotFound {
+			return ErrorMemberNotExist{
+				errorBasicRuntime: f.errorRuntime,
+			}
+		}
+	}
+
+	for index := 0; index < srcLength; index++ {
+		if isEachResult {
+			_, nodeNotFound = valueList[index].(struct{})
+		}
+		if nodeNotFound {
+			continue
+		}
+		if err := f.retrieveReflectListNext(root, srcList, index, container); err != nil {
+			deepestTextLen, deepestError = f.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: f.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+// computeQuery computes the query of the filter, counting the nesting of the filters if it is limited.
+func (f *syntaxFilterQualifier) computeQuery(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	if container.limiter == nil {
+		return f.query.compute(root, currentList, container)
+	}
+
+	container.limiter.enterFilter(f.errorRuntime)
+	valueList := f.query.compute(root, currentList, container)
+	container.limiter.leaveFilter()
+	return valueList
+}
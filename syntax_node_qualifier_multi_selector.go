@@ -0,0 +1,39 @@
+package jsonpath
+
+type syntaxMultiSelectorQualifier struct {
+	*syntaxBasicNode
+
+	selectors []syntaxNode
+}
+
+func (q *syntaxMultiSelectorQualifier) retrieve(
+	root, current interface{}, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	for _, selector := range q.selectors {
+		if err := selector.retrieve(root, current, container); err != nil {
+			deepestTextLen, deepestError = q.addBranchError(err, deepestTextLen, deepestError, container)
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: q.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+func (q *syntaxMultiSelectorQualifier) setAccessorMode(mode bool) {
+	q.syntaxBasicNode.setAccessorMode(mode)
+	for _, selector := range q.selectors {
+		selector.setAccessorMode(mode)
+	}
+}
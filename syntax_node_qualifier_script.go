@@ -0,0 +1,69 @@
+package jsonpath
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+type syntaxScriptQualifier struct {
+	*syntaxBasicNode
+
+	script syntaxScript
+}
+
+// retrieve uses the numeric result of the script as the index of the array, or as the key of the object,
+// and the string result as the key of the object.
+func (s *syntaxScriptQualifier) retrieve(
+	root, current interface{}, container *bufferContainer) errorRuntime {
+
+	value, ok := s.script.evaluate(root, current, container)
+	if !ok {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: s.errorRuntime,
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case float64:
+		if typedValue != math.Trunc(typedValue) ||
+			typedValue < math.MinInt32 || typedValue > math.MaxInt32 {
+			return ErrorMemberNotExist{
+				errorBasicRuntime: s.errorRuntime,
+			}
+		}
+		if isObjectValue(current) {
+			identifier := syntaxChildSingleIdentifier{
+				syntaxBasicNode: s.syntaxBasicNode,
+				identifier:      strconv.Itoa(int(typedValue)),
+			}
+			return identifier.retrieve(root, current, container)
+		}
+		union := syntaxUnionQualifier{
+			syntaxBasicNode: s.syntaxBasicNode,
+			subscripts: []syntaxSubscript{
+				&syntaxIndexSubscript{
+					syntaxBasicSubscript: &syntaxBasicSubscript{},
+					number:               int(typedValue),
+				},
+			},
+		}
+		return union.retrieve(root, current, container)
+	case string:
+		identifier := syntaxChildSingleIdentifier{
+			syntaxBasicNode: s.syntaxBasicNode,
+			identifier:      typedValue,
+		}
+		return identifier.retrieve(root, current, container)
+	}
+
+	foundType := msgTypeNull
+	if value != nil {
+		foundType = reflect.TypeOf(value).String()
+	}
+	return ErrorTypeUnmatched{
+		errorBasicRuntime: s.errorRuntime,
+		expectedType:      msgTypeNumberOrString,
+		foundType:         foundType,
+	}
+}
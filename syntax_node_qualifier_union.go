@@ -11,8 +11,18 @@ type syntaxUnionQualifier struct {
 func (u *syntaxUnionQualifier) retrieve(
 	root, current interface{}, container *bufferContainer) errorRuntime {
 
+	if container.upsertMode && container.setter != nil {
+		if index, ok := u.getUpsertIndex(current); ok {
+			return u.retrieveUpsertIndex(root, current, index, container)
+		}
+	}
+
 	srcArray, ok := current.([]interface{})
 	if !ok {
+		if srcList, ok := getReflectList(current); ok {
+			return u.retrieveReflectList(root, srcList, container)
+		}
+
 		foundType := msgTypeNull
 		if current != nil {
 			foundType = reflect.TypeOf(current).String()
@@ -28,11 +38,125 @@ func (u *syntaxUnionQualifier) retrieve(
 	var deepestError errorRuntime
 
 	for _, subscript := range u.subscripts {
-		for _, index := range subscript.getIndexes(srcArray) {
+		indexes := subscript.getIndexes(len(srcArray))
+		if len(indexes) == 0 {
+			deepestTextLen, deepestError = u.addIndexError(subscript, deepestTextLen, deepestError, container)
+		}
+		for _, index := range indexes {
 			if err := u.retrieveListNext(root, srcArray, index, container); err != nil {
-				if len(container.result) == 0 {
-					deepestTextLen, deepestError = u.addDeepestError(err, deepestTextLen, deepestError)
-				}
+				deepestTextLen, deepestError = u.addBranchError(err, deepestTextLen, deepestError, container)
+			}
+		}
+	}
+
+	if len(container.result) > 0 {
+		return nil
+	}
+
+	if deepestError == nil {
+		return ErrorMemberNotExist{
+			errorBasicRuntime: u.errorRuntime,
+		}
+	}
+
+	return deepestError
+}
+
+// addIndexError adds the error of the index out of the range of the array,
+// so that each index of the union is reported in the same way as the single index.
+// The non-negative index is located at the missing element, and the negative one at the array.
+// The slices selecting no element are not the errors.
+func (u *syntaxUnionQualifier) addIndexError(
+	subscript syntaxSubscript, deepestTextLen int, deepestError errorRuntime,
+	container *bufferContainer) (int, errorRuntime) {
+
+	indexSubscript, ok := subscript.(*syntaxIndexSubscript)
+	if !ok {
+		return deepestTextLen, deepestError
+	}
+	var err errorRuntime = ErrorMemberNotExist{
+		errorBasicRuntime: u.errorRuntime,
+	}
+	if indexSubscript.number >= 0 {
+		if indexSubscript.errorRuntime == nil {
+			err = addErrorListSegment(err, indexSubscript.number)
+		} else {
+			err = ErrorMemberNotExist{
+				errorBasicRuntime: indexSubscript.errorRuntime,
+			}
+		}
+	}
+	return u.addBranchError(err, deepestTextLen, deepestError, container)
+}
+
+// setIndexErrors creates the errors of the non-negative indexes in advance,
+// so that the missing elements are located without building the errors at runtime.
+// The error is built at runtime only for the index created at runtime, such as the result of the script.
+func (u *syntaxUnionQualifier) setIndexErrors(subscripts []syntaxSubscript) {
+	for _, subscript := range subscripts {
+		if indexSubscript, ok := subscript.(*syntaxIndexSubscript); ok && indexSubscript.number >= 0 {
+			indexSubscript.errorRuntime = &errorBasicRuntime{
+				node: u.syntaxBasicNode,
+				path: joinListPath(``, indexSubscript.number),
+			}
+		}
+	}
+}
+
+// getUpsertIndex returns the index to be created in upsert mode,
+// if the current value is null or the array shorter than the index.
+func (u *syntaxUnionQualifier) getUpsertIndex(current interface{}) (int, bool) {
+	if len(u.subscripts) != 1 {
+		return 0, false
+	}
+	subscript, ok := u.subscripts[0].(*syntaxIndexSubscript)
+	if !ok || subscript.number < 0 {
+		return 0, false
+	}
+
+	switch typedCurrent := current.(type) {
+	case nil:
+		return subscript.number, true
+	case []interface{}:
+		return subscript.number, subscript.number >= len(typedCurrent)
+	}
+	return 0, false
+}
+
+// retrieveUpsertIndex rejects the index creating too many elements before the array is allocated.
+func (u *syntaxUnionQualifier) retrieveUpsertIndex(
+	root, current interface{}, index int, container *bufferContainer) errorRuntime {
+
+	parentSetter := container.setter
+	srcArray, _ := current.([]interface{})
+	if index-len(srcArray) >= maxUpsertElements {
+		return ErrorUpsertIndexExceeded{
+			errorBasicRuntime: u.errorRuntime,
+			limit:             maxUpsertElements,
+		}
+	}
+	return u.retrieveMissingNext(root, func(value interface{}) {
+		newArray := make([]interface{}, index+1)
+		copy(newArray, srcArray)
+		newArray[index] = value
+		parentSetter(newArray)
+	}, container.getIndexPointer(index), container.getListPath(index), container)
+}
+
+func (u *syntaxUnionQualifier) retrieveReflectList(
+	root interface{}, srcList reflect.Value, container *bufferContainer) errorRuntime {
+
+	var deepestTextLen int
+	var deepestError errorRuntime
+
+	for _, subscript := range u.subscripts {
+		indexes := subscript.getIndexes(srcList.Len())
+		if len(indexes) == 0 {
+			deepestTextLen, deepestError = u.addIndexError(subscript, deepestTextLen, deepestError, container)
+		}
+		for _, index := range indexes {
+			if err := u.retrieveReflectListNext(root, srcList, index, container); err != nil {
+				deepestTextLen, deepestError = u.addBranchError(err, deepestTextLen, deepestError, container)
 			}
 		}
 	}
@@ -52,4 +176,5 @@ func (u *syntaxUnionQualifier) retrieve(
 
 func (u *syntaxUnionQualifier) merge(union *syntaxUnionQualifier) {
 	u.subscripts = append(u.subscripts, union.subscripts...)
+	u.setIndexErrors(union.subscripts)
 }
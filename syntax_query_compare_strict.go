@@ -0,0 +1,112 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+type syntaxStrictCompareQuery struct {
+	leftParam  *syntaxBasicCompareParameter
+	rightParam *syntaxBasicCompareParameter
+	isLess     bool
+	isEqual    bool
+	isGreater  bool
+}
+
+func (q *syntaxStrictCompareQuery) compute(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	leftValues := q.leftParam.compute(root, currentList, container)
+	rightValues := q.rightParam.compute(root, currentList, container)
+
+	resultLength := len(currentList)
+	if q.leftParam.isLiteral && q.rightParam.isLiteral {
+		resultLength = 1
+	}
+
+	result := make([]interface{}, resultLength)
+	for index := range result {
+		left := q.getValue(leftValues, index)
+		right := q.getValue(rightValues, index)
+		if (q.isEqual && q.equals(left, right)) ||
+			(q.isLess && q.less(left, right)) ||
+			(q.isGreater && q.less(right, left)) {
+			result[index] = true
+		} else {
+			result[index] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+func (q *syntaxStrictCompareQuery) getValue(values []interface{}, index int) interface{} {
+	switch len(values) {
+	case 0:
+		return struct{}{}
+	case 1:
+		return q.normalize(values[0])
+	}
+	return q.normalize(values[index])
+}
+
+func (q *syntaxStrictCompareQuery) normalize(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if floatNumber, err := typedValue.Float64(); err == nil {
+			return floatNumber
+		}
+	case []interface{}:
+		normalizedList := make([]interface{}, len(typedValue))
+		for index := range typedValue {
+			normalizedList[index] = q.normalize(typedValue[index])
+		}
+		return normalizedList
+	case map[string]interface{}:
+		normalizedMap := make(map[string]interface{}, len(typedValue))
+		for key := range typedValue {
+			normalizedMap[key] = q.normalize(typedValue[key])
+		}
+		return normalizedMap
+	case nil, string, float64, bool, struct{}:
+	default:
+		if scalar, ok := convertReflectScalar(typedValue); ok {
+			return scalar
+		}
+		if srcList, ok := getReflectList(typedValue); ok {
+			normalizedList := make([]interface{}, srcList.Len())
+			for index := range normalizedList {
+				normalizedList[index] = q.normalize(srcList.Index(index).Interface())
+			}
+			return normalizedList
+		}
+		if srcObject, ok := getReflectObject(typedValue); ok {
+			keys := srcObject.getKeys()
+			normalizedMap := make(map[string]interface{}, len(keys))
+			for _, key := range keys {
+				value, _ := srcObject.getValue(key)
+				normalizedMap[key] = q.normalize(value.Interface())
+			}
+			return normalizedMap
+		}
+	}
+	return value
+}
+
+func (q *syntaxStrictCompareQuery) equals(left, right interface{}) bool {
+	return reflect.DeepEqual(left, right)
+}
+
+func (q *syntaxStrictCompareQuery) less(left, right interface{}) bool {
+	switch typedLeft := left.(type) {
+	case float64:
+		if typedRight, ok := right.(float64); ok {
+			return typedLeft < typedRight
+		}
+	case string:
+		if typedRight, ok := right.(string); ok {
+			return typedLeft < typedRight
+		}
+	}
+	return false
+}
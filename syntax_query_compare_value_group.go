@@ -0,0 +1,94 @@
+package jsonpath
+
+type syntaxValueGroupCompareQuery struct {
+	leftParam         *syntaxBasicCompareParameter
+	rightParam        *syntaxBasicCompareParameter
+	isLeftValueGroup  bool
+	isRightValueGroup bool
+	comparator        syntaxComparator
+	isNegated         bool
+	isAll             bool
+}
+
+func (q *syntaxValueGroupCompareQuery) compute(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	leftValues := q.leftParam.compute(root, currentList, container)
+	rightValues := q.rightParam.compute(root, currentList, container)
+
+	resultLength := len(currentList)
+	if q.leftParam.isLiteral && q.rightParam.isLiteral {
+		resultLength = 1
+	}
+
+	result := make([]interface{}, resultLength)
+	for index := range result {
+		leftNodes := q.getNodes(leftValues, index, q.isLeftValueGroup)
+		rightNodes := q.getNodes(rightValues, index, q.isRightValueGroup)
+		if q.compareNodes(leftNodes, rightNodes) {
+			result[index] = true
+		} else {
+			result[index] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+func (q *syntaxValueGroupCompareQuery) getNodes(
+	values []interface{}, index int, isValueGroup bool) []interface{} {
+
+	var value interface{}
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		value = values[0]
+	default:
+		value = values[index]
+	}
+
+	if _, ok := value.(struct{}); ok {
+		return nil
+	}
+
+	var nodes []interface{}
+	if isValueGroup {
+		nodes = make([]interface{}, len(value.([]interface{})))
+		copy(nodes, value.([]interface{}))
+	} else {
+		nodes = []interface{}{value}
+	}
+	q.comparator.typeCast(nodes)
+	return nodes
+}
+
+func (q *syntaxValueGroupCompareQuery) compareNodes(leftNodes, rightNodes []interface{}) bool {
+	if len(leftNodes) == 0 || len(rightNodes) == 0 {
+		return false
+	}
+
+	for _, left := range leftNodes {
+		for _, right := range rightNodes {
+			matched := q.compare(left, right)
+			if matched && !q.isAll {
+				return true
+			}
+			if !matched && q.isAll {
+				return false
+			}
+		}
+	}
+
+	return q.isAll
+}
+
+func (q *syntaxValueGroupCompareQuery) compare(left, right interface{}) bool {
+	if _, ok := left.(struct{}); ok {
+		return false
+	}
+	if _, ok := right.(struct{}); ok {
+		return false
+	}
+	return q.comparator.comparator(left, right) != q.isNegated
+}
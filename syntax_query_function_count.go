@@ -0,0 +1,29 @@
+package jsonpath
+
+type syntaxFunctionCount struct {
+	*syntaxBasicFunctionQuery
+
+	isValueGroupParam bool
+}
+
+func (f *syntaxFunctionCount) compute(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	paramValues, resultLength := f.computeParams(root, currentList, container)
+
+	result := make([]interface{}, resultLength)
+	for index := range result {
+		value := f.getParamValue(paramValues[0], index)
+		if _, ok := value.(struct{}); ok {
+			result[index] = float64(0)
+			continue
+		}
+		if f.isValueGroupParam {
+			result[index] = float64(len(value.([]interface{})))
+			continue
+		}
+		result[index] = float64(1)
+	}
+
+	return result
+}
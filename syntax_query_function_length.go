@@ -0,0 +1,32 @@
+package jsonpath
+
+import "unicode/utf8"
+
+type syntaxFunctionLength struct {
+	*syntaxBasicFunctionQuery
+}
+
+func (f *syntaxFunctionLength) compute(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	paramValues, resultLength := f.computeParams(root, currentList, container)
+
+	result := make([]interface{}, resultLength)
+	for index := range result {
+		switch typedValue := f.getParamValue(paramValues[0], index).(type) {
+		case string:
+			result[index] = float64(utf8.RuneCountInString(typedValue))
+		case []interface{}:
+			result[index] = float64(len(typedValue))
+		case map[string]interface{}:
+			result[index] = float64(len(typedValue))
+		default:
+			result[index] = struct{}{}
+			if length, ok := getReflectLength(typedValue); ok {
+				result[index] = float64(length)
+			}
+		}
+	}
+
+	return result
+}
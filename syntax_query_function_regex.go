@@ -0,0 +1,75 @@
+package jsonpath
+
+import (
+	"regexp"
+	"strings"
+)
+
+type syntaxFunctionRegex struct {
+	*syntaxBasicFunctionQuery
+
+	regex       *regexp.Regexp
+	isFullMatch bool
+}
+
+func (f *syntaxFunctionRegex) compute(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	paramValues, resultLength := f.computeParams(root, currentList, container)
+
+	result := make([]interface{}, resultLength)
+	for index := range result {
+		result[index] = struct{}{}
+
+		value, ok := f.getParamValue(paramValues[0], index).(string)
+		if !ok {
+			continue
+		}
+
+		regex := f.regex
+		if regex == nil {
+			pattern, ok := f.getParamValue(paramValues[1], index).(string)
+			if !ok {
+				continue
+			}
+			var err error
+			if regex, err = regexp.Compile(convertIRegexp(pattern, f.isFullMatch)); err != nil {
+				continue
+			}
+		}
+
+		if regex.MatchString(value) {
+			result[index] = true
+		}
+	}
+
+	return result
+}
+
+// convertIRegexp converts the I-Regexp pattern defined in RFC 9535 into the Go regular expression.
+func convertIRegexp(pattern string, isFullMatch bool) string {
+	var builder strings.Builder
+	var isEscaped, isInClass bool
+
+	for _, char := range pattern {
+		switch {
+		case isEscaped:
+			isEscaped = false
+		case char == '\\':
+			isEscaped = true
+		case isInClass:
+			isInClass = char != ']'
+		case char == '[':
+			isInClass = true
+		case char == '.':
+			builder.WriteString(`[^\n\r]`)
+			continue
+		}
+		builder.WriteRune(char)
+	}
+
+	if isFullMatch {
+		return `^(?:` + builder.String() + `)$`
+	}
+	return builder.String()
+}
@@ -0,0 +1,28 @@
+package jsonpath
+
+type syntaxFunctionValue struct {
+	*syntaxBasicFunctionQuery
+
+	isValueGroupParam bool
+}
+
+func (f *syntaxFunctionValue) compute(
+	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
+
+	paramValues, resultLength := f.computeParams(root, currentList, container)
+
+	result := make([]interface{}, resultLength)
+	for index := range result {
+		value := f.getParamValue(paramValues[0], index)
+		if nodes, ok := value.([]interface{}); ok && f.isValueGroupParam {
+			if len(nodes) != 1 {
+				result[index] = struct{}{}
+				continue
+			}
+			value = nodes[0]
+		}
+		result[index] = value
+	}
+
+	return result
+}
@@ -14,7 +14,7 @@ func (e *syntaxQueryParamCurrentRoot) compute(
 	result := make([]interface{}, len(currentList))
 
 	for index := range currentList {
-		values := bufferContainer{}
+		values := bufferContainer{limiter: container.limiter}
 
 		if err := e.param.retrieve(root, currentList[index], &values); err != nil {
 			result[index] = struct{}{}
@@ -11,13 +11,15 @@ func (e *syntaxQueryParamRoot) isValueGroupParameter() bool {
 func (e *syntaxQueryParamRoot) compute(
 	root interface{}, currentList []interface{}, container *bufferContainer) []interface{} {
 
-	values := bufferContainer{}
+	values := bufferContainer{limiter: container.limiter}
 
 	if err := e.param.retrieve(root, root, &values); err != nil {
 		return []interface{}{}
 	}
 
-	// e.param.isValueGroup() should always be false.
+	if e.param.isValueGroup() {
+		return []interface{}{values.result}
+	}
 
 	return values.result[:1]
 }
@@ -0,0 +1,25 @@
+package jsonpath
+
+type syntaxScriptAdd struct {
+	*syntaxBasicScriptArithmetic
+}
+
+func (a *syntaxScriptAdd) evaluate(root, current interface{}, container *bufferContainer) (interface{}, bool) {
+	leftValue, rightValue, ok := a.evaluateOperands(root, current, container)
+	if !ok {
+		return nil, false
+	}
+
+	switch leftTyped := leftValue.(type) {
+	case float64:
+		if rightTyped, ok := rightValue.(float64); ok {
+			return leftTyped + rightTyped, true
+		}
+	case string:
+		if rightTyped, ok := rightValue.(string); ok {
+			return leftTyped + rightTyped, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,13 @@
+package jsonpath
+
+type syntaxScriptDivide struct {
+	*syntaxBasicScriptArithmetic
+}
+
+func (a *syntaxScriptDivide) evaluate(root, current interface{}, container *bufferContainer) (interface{}, bool) {
+	leftNumber, rightNumber, ok := a.evaluateNumbers(root, current, container)
+	if !ok {
+		return nil, false
+	}
+	return leftNumber / rightNumber, true
+}
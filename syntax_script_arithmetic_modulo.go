@@ -0,0 +1,15 @@
+package jsonpath
+
+import "math"
+
+type syntaxScriptModulo struct {
+	*syntaxBasicScriptArithmetic
+}
+
+func (a *syntaxScriptModulo) evaluate(root, current interface{}, container *bufferContainer) (interface{}, bool) {
+	leftNumber, rightNumber, ok := a.evaluateNumbers(root, current, container)
+	if !ok {
+		return nil, false
+	}
+	return math.Mod(leftNumber, rightNumber), true
+}
@@ -0,0 +1,17 @@
+package jsonpath
+
+type syntaxScriptNegate struct {
+	script syntaxScript
+}
+
+func (n *syntaxScriptNegate) evaluate(root, current interface{}, container *bufferContainer) (interface{}, bool) {
+	value, ok := n.script.evaluate(root, current, container)
+	if !ok {
+		return nil, false
+	}
+	number, ok := value.(float64)
+	if !ok {
+		return nil, false
+	}
+	return -number, true
+}
@@ -0,0 +1,67 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+)
+
+type syntaxScriptParamJSONPath struct {
+	param    syntaxNode
+	isRoot   bool
+	isLength bool
+}
+
+func (e *syntaxScriptParamJSONPath) evaluate(root, current interface{}, container *bufferContainer) (interface{}, bool) {
+	if e.isRoot {
+		current = root
+	}
+
+	values := bufferContainer{limiter: container.limiter}
+	if err := e.param.retrieve(root, current, &values); err != nil {
+		return nil, false
+	}
+
+	value := values.result[0]
+	if e.isLength {
+		switch typedValue := value.(type) {
+		case []interface{}:
+			return float64(len(typedValue)), true
+		case string:
+			return float64(utf8.RuneCountInString(typedValue)), true
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = typedValue[`length`]; !ok {
+				return nil, false
+			}
+		default:
+			if srcList, ok := getReflectList(value); ok {
+				return float64(srcList.Len()), true
+			}
+			srcObject, ok := getReflectObject(value)
+			if !ok {
+				return nil, false
+			}
+			lengthValue, ok := srcObject.getValue(`length`)
+			if !ok {
+				return nil, false
+			}
+			value = lengthValue.Interface()
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case json.Number:
+		floatNumber, err := typedValue.Float64()
+		if err != nil {
+			return nil, false
+		}
+		return floatNumber, true
+	case nil, string, float64, bool:
+	default:
+		if scalar, ok := convertReflectScalar(typedValue); ok {
+			return scalar, true
+		}
+	}
+
+	return value, true
+}
@@ -0,0 +1,9 @@
+package jsonpath
+
+type syntaxScriptParamLiteral struct {
+	literal interface{}
+}
+
+func (l *syntaxScriptParamLiteral) evaluate(_, _ interface{}, _ *bufferContainer) (interface{}, bool) {
+	return l.literal, true
+}
@@ -5,11 +5,12 @@ type syntaxIndexSubscript struct {
 
 	number    int
 	isOmitted bool
+	// errorRuntime is shared by the errors located at the missing element of the non-negative index.
+	errorRuntime *errorBasicRuntime
 }
 
-func (i *syntaxIndexSubscript) getIndexes(src []interface{}) []int {
+func (i *syntaxIndexSubscript) getIndexes(srcLength int) []int {
 	index := i.number
-	srcLength := len(src)
 
 	if index < 0 {
 		index += srcLength
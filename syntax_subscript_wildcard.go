@@ -4,9 +4,9 @@ type syntaxWildcardSubscript struct {
 	*syntaxBasicSubscript
 }
 
-func (*syntaxWildcardSubscript) getIndexes(src []interface{}) []int {
-	result := make([]int, len(src))
-	for index := range src {
+func (*syntaxWildcardSubscript) getIndexes(srcLength int) []int {
+	result := make([]int, srcLength)
+	for index := range result {
 		result[index] = index
 	}
 	return result
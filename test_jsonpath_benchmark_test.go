@@ -41,6 +41,32 @@ func execParserFunc(jsonPath, srcJSON string, b *testing.B) {
 	}
 }
 
+func execParseParallel(jsonPath string, b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := Parse(jsonPath); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
+
+func execRetrieveParallel(jsonPath, srcJSON string, b *testing.B) {
+	var src interface{}
+	if err := json.Unmarshal([]byte(srcJSON), &src); err != nil {
+		b.Error(err)
+		return
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := Retrieve(jsonPath, src); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
+
 func BenchmarkParserFunc_dotNotation(b *testing.B) {
 	jsonPath := `$.a`
 	srcJSON := `{"a":123.456}`
@@ -197,3 +223,34 @@ func BenchmarkParserFunc_recursive(b *testing.B) {
 
 	execParserFunc(jsonPath, srcJSON, b)
 }
+
+func BenchmarkParse_dotNotation(b *testing.B) {
+	jsonPath := `$.store.book[0].price`
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(jsonPath); err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func BenchmarkParse_parallel_dotNotation(b *testing.B) {
+	jsonPath := `$.store.book[0].price`
+	execParseParallel(jsonPath, b)
+}
+
+func BenchmarkParse_parallel_filter(b *testing.B) {
+	jsonPath := `$..book[?(@.price > $.store.bicycle.price && @.category == 'fiction')]`
+	execParseParallel(jsonPath, b)
+}
+
+func BenchmarkRetrieve_parallel_filter(b *testing.B) {
+	jsonPath := `$.book[?(@.price > 10)].title`
+	srcJSON := `{ "book": [
+		{ "title": "Sayings of the Century", "price": 8.95 },
+		{ "title": "Sword of Honour", "price": 12.99 },
+		{ "title": "Moby Dick", "price": 8.99 },
+		{ "title": "The Lord of the Rings", "price": 22.99 }
+	  ]
+	}`
+	execRetrieveParallel(jsonPath, srcJSON, b)
+}
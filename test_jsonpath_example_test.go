@@ -1,9 +1,13 @@
 package jsonpath_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/AsaiYusuke/jsonpath"
 )
@@ -34,6 +38,66 @@ func ExampleRetrieve() {
 	// ["value"]
 }
 
+func ExampleRetrieve_struct() {
+	type Book struct {
+		Title  string  `json:"title"`
+		Price  float64 `json:"price"`
+		Author string  `json:"author,omitempty"`
+	}
+	type Store struct {
+		Books []Book `json:"book"`
+	}
+	src := &Store{Books: []Book{{Title: `A`, Price: 8}, {Title: `B`, Price: 12, Author: `X`}}}
+	output, err := jsonpath.Retrieve(`$.book[?(@.price > 10)].title`, src)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// ["B"]
+}
+
+func ExampleRetrieveReader() {
+	jsonPath, srcJSON := `$.store.book[*].title`, `{"store":{"book":[{"title":"A"},{"title":"B"}]}}`
+	err := jsonpath.RetrieveReader(jsonPath, strings.NewReader(srcJSON), func(value interface{}) error {
+		fmt.Println(value)
+		return nil
+	})
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+	}
+	// Output:
+	// A
+	// B
+}
+
+func ExampleRetrieveBytes() {
+	jsonPath, srcJSON := `$.store.book[*].title`, `{"store":{"book":[{"title":"A"},{"title":"B"}]}}`
+	output, err := jsonpath.RetrieveBytes(jsonPath, []byte(srcJSON))
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// ["A","B"]
+}
+
+func ExampleRetrieveRawBytes() {
+	jsonPath, srcJSON := `$.store.book[0]`, `{"store":{"book":[{"title":"A"},{"title":"B"}]}}`
+	output, err := jsonpath.RetrieveRawBytes(jsonPath, []byte(srcJSON))
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	fmt.Println(string(output[0].(json.RawMessage)))
+	// Output:
+	// {"title":"A"}
+}
+
 func ExampleParse() {
 	jsonPath := `$.key`
 	srcJSON1 := `{"key":"value1"}`
@@ -155,6 +219,18 @@ func ExampleErrorTypeUnmatched() {
 	// jsonpath.ErrorTypeUnmatched, type unmatched (expected=object, found=[]interface {}, path=.a)
 }
 
+func ExampleErrorTypeUnmatched_NormalizedPath() {
+	jsonPath, srcJSON := `$.a[*].b.c`, `{"a":[{"b":[1]},{"b":"x"}]}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	_, err := jsonpath.Retrieve(jsonPath, src)
+	if typeUnmatched, ok := err.(jsonpath.ErrorTypeUnmatched); ok {
+		fmt.Println(typeUnmatched.NormalizedPath(), typeUnmatched.FoundType(), typeUnmatched.Path())
+	}
+	// Output:
+	// $['a'][1]['b'] string .c
+}
+
 func ExampleErrorFunctionFailed() {
 	config := jsonpath.Config{}
 	config.SetFilterFunction(`invalid`, func(param interface{}) (interface{}, error) {
@@ -174,6 +250,111 @@ func ExampleErrorFunctionFailed() {
 	// jsonpath.ErrorFunctionFailed, function failed (function=.invalid(), error=invalid function executed)
 }
 
+func ExampleErrorFunctionFailed_Unwrap() {
+	errInvalid := errors.New(`invalid function executed`)
+	config := jsonpath.Config{}
+	config.SetFilterFunction(`invalid`, func(param interface{}) (interface{}, error) {
+		return nil, errInvalid
+	})
+	jsonPath, srcJSON := `$.invalid()`, `{}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	_, err := jsonpath.Retrieve(jsonPath, src, config)
+	var functionFailed jsonpath.ErrorFunctionFailed
+	if errors.As(err, &functionFailed) {
+		fmt.Println(functionFailed.Function())
+	}
+	fmt.Println(errors.Is(err, jsonpath.ErrFunctionFailed), errors.Is(err, errInvalid))
+	// Output:
+	// .invalid()
+	// true true
+}
+
+func ExampleErrorVisitedNodesExceeded() {
+	config := jsonpath.Config{}
+	config.SetMaxVisitedNodes(3)
+	jsonPath, srcJSON := `$..*`, `{"a":{"b":{"c":1}}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// jsonpath.ErrorVisitedNodesExceeded, visited nodes exceeded (limit=3)
+}
+
+func ExampleErrorResultsExceeded() {
+	config := jsonpath.Config{}
+	config.SetMaxResults(2)
+	jsonPath, srcJSON := `$[*]`, `[1,2,3]`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// jsonpath.ErrorResultsExceeded, results exceeded (limit=2)
+}
+
+func ExampleErrorRecursiveDepthExceeded() {
+	config := jsonpath.Config{}
+	config.SetMaxRecursiveDepth(1)
+	jsonPath, srcJSON := `$..c`, `{"a":{"b":{"c":1}}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// jsonpath.ErrorRecursiveDepthExceeded, recursive depth exceeded (limit=1, path=..)
+}
+
+func ExampleErrorFilterNestingExceeded() {
+	config := jsonpath.Config{}
+	config.SetMaxFilterNesting(1)
+	jsonPath, srcJSON := `$[?(@.a[?(@.b==1)])]`, `[{"a":[{"b":1}]}]`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// jsonpath.ErrorFilterNestingExceeded, filter nesting exceeded (limit=1, path=[?(@.b==1)])
+}
+
+func ExampleErrorUpsertIndexExceeded() {
+	config := jsonpath.Config{}
+	config.SetUpsertMode()
+	jsonPath, srcJSON := `$.a[100000]`, `{"a":[]}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// jsonpath.ErrorUpsertIndexExceeded, upsert index exceeded (limit=65536, path=[100000])
+}
+
 func ExampleConfig_SetFilterFunction() {
 	config := jsonpath.Config{}
 	config.SetFilterFunction(`twice`, func(param interface{}) (interface{}, error) {
@@ -256,3 +437,420 @@ func ExampleConfig_SetAccessorMode() {
 	// Set -> Get : 3
 	// Src -> Get : 4
 }
+
+func ExampleSet() {
+	jsonPath, srcJSON := `$.a.b[1].c`, `{"a":{"d":0}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, count, err := jsonpath.Set(jsonPath, src, 1)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON), count)
+	// Output:
+	// {"a":{"b":[null,{"c":1}],"d":0}} 1
+}
+
+func ExampleUpdate() {
+	jsonPath, srcJSON := `$..price`, `{"book":[{"price":1},{"price":2}]}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, count, err := jsonpath.Update(jsonPath, src, func(value interface{}) (interface{}, error) {
+		return value.(float64) * 10, nil
+	})
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON), count)
+	// Output:
+	// {"book":[{"price":10},{"price":20}]} 2
+}
+
+func ExampleDelete() {
+	jsonPath, srcJSON := `$[?(@.price > 1)]`, `[{"price":1},{"price":2},{"price":3}]`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, count, err := jsonpath.Delete(jsonPath, src)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON), count)
+	// Output:
+	// [{"price":1}] 2
+}
+
+func ExampleConfig_SetPatchRecorder() {
+	recorder := &jsonpath.PatchRecorder{}
+	config := jsonpath.Config{}
+	config.SetPatchRecorder(recorder)
+	srcJSON := `{"users":[{"name":"a","password":"b"},{"name":"c"}]}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	src, _, err := jsonpath.Delete(`$..password`, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	src, _, err = jsonpath.Set(`$.users[1].role`, src, `admin`, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	patchJSON, _ := json.Marshal(recorder.Operations())
+	fmt.Println(string(patchJSON))
+	// Output:
+	// [{"op":"remove","path":"/users/0/password"},{"op":"add","path":"/users/1/role","value":"admin"}]
+}
+
+func ExampleApplyPatch() {
+	srcJSON, patchJSON := `{"a":[1,2]}`, `[{"op":"add","path":"/a/-","value":3},{"op":"remove","path":"/a/0"}]`
+	var src interface{}
+	var operations []jsonpath.PatchOperation
+	json.Unmarshal([]byte(srcJSON), &src)
+	json.Unmarshal([]byte(patchJSON), &operations)
+	output, err := jsonpath.ApplyPatch(src, operations)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// {"a":[2,3]}
+}
+
+func ExampleParseQuery() {
+	query, err := jsonpath.ParseQuery(`$.store.book[?(@.price<10)].title`)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	fmt.Println(query.String(), query.IsDefinite())
+	for _, segment := range query.AST().Children() {
+		fmt.Println(segment.Kind(), segment.Value())
+	}
+	var src interface{}
+	json.Unmarshal([]byte(`{"store":{"book":[{"title":"a","price":8.95},{"title":"b","price":12.99}]}}`), &src)
+	output, err := query.Execute(src)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	fmt.Println(output)
+	// Output:
+	// $.store.book[?(@.price<10)].title false
+	// Root <nil>
+	// Child store
+	// Child book
+	// Filter <nil>
+	// Child title
+	// [a]
+}
+
+func ExampleQueryCache() {
+	cache := jsonpath.NewQueryCache(2)
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":1,"b":2}`), &src)
+	for _, jsonPath := range []string{`$.a`, `$.b`, `$.a`} {
+		output, err := cache.Retrieve(jsonPath, src)
+		if err != nil {
+			fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+			return
+		}
+		fmt.Println(output)
+	}
+	stats := cache.Stats()
+	fmt.Println(stats.Hits, stats.Misses, stats.Len)
+	// Output:
+	// [1]
+	// [2]
+	// [1]
+	// 1 2 2
+}
+
+func ExampleFormat() {
+	for _, jsonPath := range []string{`$.a["b"][ 0:3:1 ]`, `a['b'][:3]`, `$[?(@.c == "x" && !@.d)]`} {
+		formatted, err := jsonpath.Format(jsonPath)
+		if err != nil {
+			fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+			return
+		}
+		fmt.Println(formatted)
+	}
+	// Output:
+	// $['a']['b'][0:3]
+	// $['a']['b'][:3]
+	// $[?(@['c']=='x' && !@['d'])]
+}
+
+type exampleVisitor struct {
+	jsonPath string
+	depth    int
+}
+
+func (v exampleVisitor) Visit(node *jsonpath.ASTNode) jsonpath.Visitor {
+	if node == nil {
+		return nil
+	}
+	fmt.Printf("%s%v %s\n", strings.Repeat(`  `, v.depth), node.Kind(), v.jsonPath[node.Position():node.End()])
+	return exampleVisitor{jsonPath: v.jsonPath, depth: v.depth + 1}
+}
+
+func ExampleWalk() {
+	jsonPath := `$.a[?(@.b>1)]`
+	query, err := jsonpath.ParseQuery(jsonPath)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	jsonpath.Walk(query.AST(), exampleVisitor{jsonPath: jsonPath})
+	// Output:
+	// Path $.a[?(@.b>1)]
+	//   Root $
+	//   Child .a
+	//   Filter [?(@.b>1)]
+	//     Comparison @.b>1
+	//       Path @.b
+	//         Current @
+	//         Child .b
+	//       Literal 1
+}
+
+func ExampleQuery_ExecuteContext() {
+	config := jsonpath.Config{}
+	config.SetMaxResults(100)
+	query, err := jsonpath.ParseQuery(`$..price`, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	var src interface{}
+	json.Unmarshal([]byte(`{"book":[{"price":8.95},{"price":12.99}]}`), &src)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	output, err := query.ExecuteContext(ctx, src)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	fmt.Println(output)
+	// Output:
+	// [8.95 12.99]
+}
+
+func ExampleQuery_ExecuteLenient() {
+	jsonPath, srcJSON := `$.items[*].price`, `{"items":[{"price":100},{"name":"x"},{"price":300},{"price":null}]}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	query, err := jsonpath.ParseQuery(jsonPath)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	output, failures := query.ExecuteLenient(src)
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	for _, failure := range failures {
+		if memberNotExist, ok := failure.(jsonpath.ErrorMemberNotExist); ok {
+			fmt.Println(memberNotExist.NormalizedPath(), failure)
+		}
+	}
+	// Output:
+	// [100,300,null]
+	// $['items'][1]['price'] member did not exist (path=.price)
+}
+
+func ExampleQuery_Cost() {
+	for _, jsonPath := range []string{`$.a[0]`, `$.a[*].b`, `$..b`, `$..a[?(@.b=~/x/ && $..c)]`} {
+		query, err := jsonpath.ParseQuery(jsonPath)
+		if err != nil {
+			fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+			return
+		}
+		cost := query.Cost()
+		fmt.Println(jsonPath, cost.Class, cost.RecursiveDescents, cost.Regexes)
+	}
+	// Output:
+	// $.a[0] Definite 0 0
+	// $.a[*].b Linear 0 0
+	// $..b Recursive 1 0
+	// $..a[?(@.b=~/x/ && $..c)] Superlinear 2 1
+}
+
+func ExampleQuerySet() {
+	jsonPaths := []string{`$.user.name`, `$.user.tags[0]`, `$.user.age`}
+	srcJSON := `{"user":{"name":"a","tags":["b","c"]}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	querySet, err := jsonpath.NewQuerySet(jsonPaths)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	results, errs := querySet.Retrieve(src)
+	for index := range jsonPaths {
+		fmt.Printf("%s : %v, %v\n", jsonPaths[index], results[index], errs[index])
+	}
+	// Output:
+	// $.user.name : [a], <nil>
+	// $.user.tags[0] : [b], <nil>
+	// $.user.age : [], member did not exist (path=.age)
+}
+
+func ExampleToPointer() {
+	pointer, err := jsonpath.ToPointer(`$.store['book/list'][0].title`)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	fmt.Println(pointer)
+	// Output:
+	// /store/book~1list/0/title
+}
+
+func ExampleFromPointer() {
+	jsonPath, err := jsonpath.FromPointer(`/store/book~1list/0/title`)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	fmt.Println(jsonPath)
+	// Output:
+	// $['store']['book/list'][0]['title']
+}
+
+func ExamplePathValue_Pointer() {
+	config := jsonpath.Config{}
+	config.SetPathMode()
+	jsonPath, srcJSON := `$.store.book[*].price`, `{"store":{"book":[{"price":8.95},{"price":12.99}]}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	for _, result := range output {
+		pathValue := result.(jsonpath.PathValue)
+		fmt.Printf("%s : %v\n", pathValue.Pointer(), pathValue.Value)
+	}
+	// Output:
+	// /store/book/0/price : 8.95
+	// /store/book/1/price : 12.99
+}
+
+func ExampleAccessor_Delete() {
+	config := jsonpath.Config{}
+	config.SetAccessorMode()
+	jsonPath, srcJSON := `$..password`, `{"password":"a","users":[{"name":"b","password":"c"}]}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	for _, result := range output {
+		result.(jsonpath.Accessor).Delete()
+	}
+	outputJSON, _ := json.Marshal(src)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// {"users":[{"name":"b"}]}
+}
+
+func ExampleConfig_SetUpsertMode() {
+	config := jsonpath.Config{}
+	config.SetUpsertMode()
+	jsonPath, srcJSON := `$.a.b[2].c`, `{"a":{"d":0}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	accessor := output[0].(jsonpath.Accessor)
+	accessor.Set(1)
+	outputJSON, _ := json.Marshal(src)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// {"a":{"b":[null,null,{"c":1}],"d":0}}
+}
+
+func ExampleConfig_SetPathMode() {
+	config := jsonpath.Config{}
+	config.SetPathMode()
+	jsonPath, srcJSON := `$.store.book[?(@.price<10)].price`, `{"store":{"book":[{"price":8.95},{"price":12.99},{"price":8.99}]}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	for _, result := range output {
+		pathValue := result.(jsonpath.PathValue)
+		fmt.Printf("%s : %v\n", pathValue.Path, pathValue.Value)
+	}
+	// Output:
+	// $['store']['book'][0]['price'] : 8.95
+	// $['store']['book'][2]['price'] : 8.99
+}
+
+func ExampleConfig_SetStrictMode() {
+	config := jsonpath.Config{}
+	config.SetStrictMode()
+	jsonPath, srcJSON := `$[?@.a == @.b]`, `[{"a":1,"b":1},{"a":1,"b":2},{"c":3}]`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// [{"a":1,"b":1},{"c":3}]
+}
+
+func ExampleConfig_SetValueGroupCompareAny() {
+	config := jsonpath.Config{}
+	config.SetValueGroupCompareAny()
+	jsonPath, srcJSON := `$[?(@.tags[*] == 'urgent')]`, `[{"tags":["a","urgent"]},{"tags":["b"]}]`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// [{"tags":["a","urgent"]}]
+}
+
+func ExampleConfig_SetValueGroupCompareAll() {
+	config := jsonpath.Config{}
+	config.SetValueGroupCompareAll()
+	jsonPath, srcJSON := `$[?(@.scores[*] >= 60)]`, `[{"scores":[70,80]},{"scores":[50,90]}]`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+	output, err := jsonpath.Retrieve(jsonPath, src, config)
+	if err != nil {
+		fmt.Printf(`%v, %v`, reflect.TypeOf(err), err)
+		return
+	}
+	outputJSON, _ := json.Marshal(output)
+	fmt.Println(string(outputJSON))
+	// Output:
+	// [{"scores":[70,80]}]
+}
@@ -0,0 +1,70 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+type TestSuiteRFC9535 struct {
+	Tests []TestCaseRFC9535 `json:"tests"`
+}
+
+type TestCaseRFC9535 struct {
+	Name            string          `json:"name"`
+	Selector        string          `json:"selector"`
+	Document        interface{}     `json:"document"`
+	Result          []interface{}   `json:"result"`
+	Results         [][]interface{} `json:"results"`
+	InvalidSelector bool            `json:"invalid_selector"`
+}
+
+// TestRetrieve_strictModeRFC9535 runs the test cases written for this library from RFC 9535.
+// They use the file format of the JSONPath Compliance Test Suite, but they are not the suite itself.
+func TestRetrieve_strictModeRFC9535(t *testing.T) {
+	suiteJSON, err := ioutil.ReadFile(`testdata/rfc9535_cases.json`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var suite TestSuiteRFC9535
+	if err := json.Unmarshal(suiteJSON, &suite); err != nil {
+		t.Fatal(err)
+	}
+
+	config := Config{}
+	config.SetStrictMode()
+
+	for _, testCase := range suite.Tests {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual, err := Retrieve(testCase.Selector, testCase.Document, config)
+
+			if testCase.InvalidSelector {
+				if err == nil {
+					t.Errorf("expected invalid selector <%s>, actual <%v>", testCase.Selector, actual)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error <%s> : %v", testCase.Selector, err)
+				return
+			}
+
+			expectedResults := testCase.Results
+			if testCase.Result != nil {
+				expectedResults = [][]interface{}{testCase.Result}
+			}
+			for _, expected := range expectedResults {
+				if reflect.DeepEqual(expected, actual) {
+					return
+				}
+			}
+
+			actualJSON, _ := json.Marshal(actual)
+			expectedJSON, _ := json.Marshal(expectedResults)
+			t.Errorf("selector <%s> : expected one of %s != actual %s", testCase.Selector, expectedJSON, actualJSON)
+		})
+	}
+}
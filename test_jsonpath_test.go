@@ -1,11 +1,15 @@
 package jsonpath
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -20,6 +24,10 @@ type TestCase struct {
 	filters         map[string]func(interface{}) (interface{}, error)
 	aggregates      map[string]func([]interface{}) (interface{}, error)
 	accessorMode    bool
+	upsertMode      bool
+	pathMode        bool
+	valueGroupAny   bool
+	valueGroupAll   bool
 	resultValidator func(interface{}, []interface{}) error
 }
 
@@ -45,6 +53,17 @@ func createErrorTypeUnmatched(text string, expected string, found string) ErrorT
 	}
 }
 
+func createErrorUpsertIndexExceeded(text string) ErrorUpsertIndexExceeded {
+	return ErrorUpsertIndexExceeded{
+		errorBasicRuntime: &errorBasicRuntime{
+			node: &syntaxBasicNode{
+				text: text,
+			},
+		},
+		limit: maxUpsertElements,
+	}
+}
+
 func createErrorFunctionFailed(text string, errorString string) ErrorFunctionFailed {
 	return ErrorFunctionFailed{
 		errorBasicRuntime: &errorBasicRuntime{
@@ -79,6 +98,22 @@ func execTestRetrieve(t *testing.T, inputJSON interface{}, testCase TestCase) ([
 		hasConfig = true
 		config.SetAccessorMode()
 	}
+	if testCase.upsertMode {
+		hasConfig = true
+		config.SetUpsertMode()
+	}
+	if testCase.pathMode {
+		hasConfig = true
+		config.SetPathMode()
+	}
+	if testCase.valueGroupAny {
+		hasConfig = true
+		config.SetValueGroupCompareAny()
+	}
+	if testCase.valueGroupAll {
+		hasConfig = true
+		config.SetValueGroupCompareAll()
+	}
 	if hasConfig {
 		actualObject, err = Retrieve(jsonPath, inputJSON, config)
 	} else {
@@ -4264,145 +4299,195 @@ func TestRetrieve_filterLogicalCombination(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Recursive_descent(t *testing.T) {
+func TestRetrieve_filterFunctionExtension(t *testing.T) {
 	testGroups := TestGroup{
-		`Recursive-descent`: []TestCase{
+		`bare filter`: []TestCase{
 			{
-				jsonpath:     `$..a..b`,
-				inputJSON:    `[{"a":{"a":{"b":1},"c":2}},{"b":{"a":{"d":3,"b":4}}}]`,
-				expectedJSON: `[1,1,4]`,
+				jsonpath:     `$[?@.a]`,
+				inputJSON:    `[{"a":1},{"b":2}]`,
+				expectedJSON: `[{"a":1}]`,
 			},
 			{
-				jsonpath:    `$..a..b`,
-				inputJSON:   `[{"a":{"a":{"x":1},"c":2}},{"b":{"a":{"d":3,"x":4}}}]`,
-				expectedErr: createErrorMemberNotExist(`b`),
+				jsonpath:     `$[? @.a==1 || @.b==2 ]`,
+				inputJSON:    `[{"a":1},{"b":2},{"b":3}]`,
+				expectedJSON: `[{"a":1},{"b":2}]`,
 			},
+		},
+		`length`: []TestCase{
 			{
-				jsonpath:    `$..a..b`,
-				inputJSON:   `[{"a":"b"},{"b":{"a":"b"}}]`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:     `$[?length(@.tags) > 2].id`,
+				inputJSON:    `[{"id":1,"tags":["a","b","c"]},{"id":2,"tags":["a"]},{"id":3}]`,
+				expectedJSON: `[1]`,
 			},
 			{
-				jsonpath:    `$..a..b`,
-				inputJSON:   `[{"x":{"x":{"b":1},"c":2}},{"b":{"x":{"d":3,"b":4}}}]`,
-				expectedErr: createErrorMemberNotExist(`a`),
+				jsonpath:     `$[?(length(@) == 2)]`,
+				inputJSON:    `["ab","日本","abc",[1,2],{"a":1,"b":2},2,null,true]`,
+				expectedJSON: `["ab","日本",[1,2],{"a":1,"b":2}]`,
 			},
 			{
-				jsonpath:    `$..a..b`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:     `$[?length(@) == length($[0])]`,
+				inputJSON:    `["ab","cd","e"]`,
+				expectedJSON: `["ab","cd"]`,
 			},
-		},
-		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$..['a','b']`,
-				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
-				expectedJSON: `[1,4]`,
+				jsonpath:     `$[?length('abc') == 3]`,
+				inputJSON:    `[1,2]`,
+				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:    `$..['a','b']`,
-				inputJSON:   `[{"x":1,"c":2},{"d":3,"x":4}]`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:    `$[?length(1) == 2]`,
+				inputJSON:   `[1,2]`,
+				expectedErr: createErrorMemberNotExist(`[?length(1) == 2]`),
 			},
 			{
-				jsonpath:    `$..['a','b']`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:    `$[?length(1) == 1]`,
+				inputJSON:   `[1,2]`,
+				expectedErr: createErrorMemberNotExist(`[?length(1) == 1]`),
 			},
 			{
-				jsonpath:    `$..['a','b']`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `[]interface {}`),
+				jsonpath:    `$[?1 == length(1)]`,
+				inputJSON:   `[1,2]`,
+				expectedErr: createErrorMemberNotExist(`[?1 == length(1)]`),
 			},
 			{
-				jsonpath:    `$..['a','b']`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:     `$[?length(1) != 1]`,
+				inputJSON:    `[1,2]`,
+				expectedJSON: `[1,2]`,
+			},
+			{
+				jsonpath:     `$[?length(value(@..a)) == 1]`,
+				inputJSON:    `[{"a":"x"},{"a":"xy"},{"b":{"a":[0]}}]`,
+				expectedJSON: `[{"a":"x"},{"b":{"a":[0]}}]`,
+			},
+			{
+				jsonpath:    `$[?length(@.a) > 0]`,
+				inputJSON:   `[{"a":1},{"b":"x"}]`,
+				expectedErr: createErrorMemberNotExist(`[?length(@.a) > 0]`),
 			},
 		},
-		`Wildcard-identifier`: []TestCase{
+		`count`: []TestCase{
 			{
-				jsonpath:     `$..*`,
-				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
-				expectedJSON: `[{"a":1,"c":2},{"b":4,"d":3},1,2,4,3]`,
+				jsonpath:     `$[?count(@.*) == 2]`,
+				inputJSON:    `[{"a":1,"b":2},{"a":1},[1,2],3]`,
+				expectedJSON: `[{"a":1,"b":2},[1,2]]`,
 			},
 			{
-				jsonpath:    `$..*`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`*`),
+				jsonpath:     `$[?count(@.a) == 0]`,
+				inputJSON:    `[{"a":1},{"b":2}]`,
+				expectedJSON: `[{"b":2}]`,
 			},
 			{
-				jsonpath:    `$..*`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:     `$[?count($..a) == 2]`,
+				inputJSON:    `[{"a":1},{"a":2}]`,
+				expectedJSON: `[{"a":1},{"a":2}]`,
+			},
+			{
+				jsonpath:     `$[?count($.x[*]) == 0]`,
+				inputJSON:    `{"x":[]}`,
+				expectedJSON: `[[]]`,
 			},
 		},
-		`Slice-qualifier`: []TestCase{
+		`value`: []TestCase{
 			{
-				jsonpath:     `$..[0:2]`,
-				inputJSON:    `{"a":[1,3,2],"b":{"a":[4,6,5]}}`,
-				expectedJSON: `[1,3,4,6]`,
+				jsonpath:     `$[?value(@..color) == 'red']`,
+				inputJSON:    `[{"color":"red"},{"a":{"color":"red"}},{"color":"red","a":{"color":"red"}}]`,
+				expectedJSON: `[{"color":"red"},{"a":{"color":"red"}}]`,
 			},
 			{
-				jsonpath:    `$..[0:2]`,
-				inputJSON:   `{"a":[],"b":{"a":[]}}`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:     `$[?value(@.a) == 1]`,
+				inputJSON:    `[{"a":1},{"a":2}]`,
+				expectedJSON: `[{"a":1}]`,
+			},
+		},
+		`match`: []TestCase{
+			{
+				jsonpath:     `$[?match(@.code, '[A-Z]{3}')].code`,
+				inputJSON:    `[{"code":"ABC"},{"code":"ABCD"},{"code":"ab"},{"code":1}]`,
+				expectedJSON: `["ABC"]`,
 			},
 			{
-				jsonpath:    `$..[0:2]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:     `$[?match(@, 'a.c')]`,
+				inputJSON:    `["abc","a\nc","a\rc","xabc"]`,
+				expectedJSON: `["abc"]`,
+			},
+			{
+				jsonpath:     `$[?match(@, '[.]')]`,
+				inputJSON:    `[".","a"]`,
+				expectedJSON: `["."]`,
+			},
+			{
+				jsonpath:     `$[?match(@.a, @.b)]`,
+				inputJSON:    `[{"a":"abc","b":"a.c"},{"a":"abc","b":"b"},{"a":"abc","b":"("},{"a":"abc","b":1}]`,
+				expectedJSON: `[{"a":"abc","b":"a.c"}]`,
+			},
+			{
+				jsonpath:     `$[?!match(@, 'a.*')]`,
+				inputJSON:    `["abc","bcd"]`,
+				expectedJSON: `["bcd"]`,
+			},
+			{
+				jsonpath:     `$[?match(@, 'a') && @ != 'b']`,
+				inputJSON:    `["a","b"]`,
+				expectedJSON: `["a"]`,
 			},
 		},
-		`Wildcard-qualifier`: []TestCase{
+		`search`: []TestCase{
 			{
-				jsonpath:     `$..[*]`,
-				inputJSON:    `[[1,3,2],[4,6,5]]`,
-				expectedJSON: `[[1,3,2],[4,6,5],1,3,2,4,6,5]`,
+				jsonpath:     `$[?search(@, 'b.')]`,
+				inputJSON:    `["abc","ab","bc",1]`,
+				expectedJSON: `["abc","bc"]`,
 			},
 			{
-				jsonpath:    `$..[*]`,
+				jsonpath:     `$[?search($.key, 'b')]`,
+				inputJSON:    `{"a":1,"key":"abc"}`,
+				expectedJSON: `[1,"abc"]`,
+			},
+		},
+		`invalid`: []TestCase{
+			{
+				jsonpath:    `$[?length(@.*) > 1]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `the type of function argument is unmatched`, near: `length(@.*) > 1]`},
 			},
 			{
-				jsonpath:    `$..a[*]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:    `$[?count(1) > 1]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `the type of function argument is unmatched`, near: `count(1) > 1]`},
 			},
-		},
-		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$..[0,1]`,
-				inputJSON:    `[[1,3,2],[4,6,5]]`,
-				expectedJSON: `[[1,3,2],[4,6,5],1,3,4,6]`,
+				jsonpath:    `$[?value(length(@)) > 1]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `the type of function argument is unmatched`, near: `value(length(@)) > 1]`},
 			},
 			{
-				jsonpath:    `$..[0,1]`,
+				jsonpath:    `$[?length(@, @) > 1]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `the number of function arguments is unmatched`, near: `length(@, @) > 1]`},
 			},
 			{
-				jsonpath:    `$..[0,1]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:    `$[?match(@)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `the number of function arguments is unmatched`, near: `match(@)]`},
 			},
-		},
-		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$..[?(@.b)]`,
-				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
-				expectedJSON: `[{"b":2},{"b":4}]`,
+				jsonpath:    `$[?length(@)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?length(@)]`},
 			},
 			{
-				jsonpath:    `$..[?(@.b)]`,
+				jsonpath:    `$[?match(@, 'a') == true]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?match(@, 'a') == true]`},
 			},
 			{
-				jsonpath:    `$..[?(@.b)]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:    `$[?length(@.a) == length(@.b)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `comparison between two current nodes is prohibited`, near: `length(@.a) == length(@.b)]`},
+			},
+			{
+				jsonpath:    `$[?match(@, 'a(')]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidArgument{argument: `a(`, err: fmt.Errorf("error parsing regexp: missing closing ): `^(?:a()$`")},
 			},
 		},
 	}
@@ -4410,165 +4495,271 @@ func TestRetrieve_valueGroupCombination_Recursive_descent(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Multiple_identifiler(t *testing.T) {
+func TestRetrieve_script(t *testing.T) {
 	testGroups := TestGroup{
-		`Recursive-descent`: []TestCase{
+		`length`: []TestCase{
 			{
-				jsonpath:     `$['a','b']..a`,
-				inputJSON:    `{"a":{"a":1,"c":2},"b":{"a":{"d":3,"a":4}}}`,
-				expectedJSON: `[1,{"a":4,"d":3},4]`,
+				jsonpath:     `$[(@.length-1)]`,
+				inputJSON:    `["first","second","third"]`,
+				expectedJSON: `["third"]`,
 			},
 			{
-				jsonpath:    `$['a','b']..a`,
-				inputJSON:   `{"a":{"x":1,"c":2},"b":{"x":{"d":3,"x":4}}}`,
-				expectedErr: createErrorMemberNotExist(`a`),
+				jsonpath:     `$[( @.length - 1 )]`,
+				inputJSON:    `["first","second","third"]`,
+				expectedJSON: `["third"]`,
 			},
 			{
-				jsonpath:    `$['a','b']..a`,
-				inputJSON:   `{"a":"a","b":"a"}`,
-				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
+				jsonpath:     `$[(@.length-3)]`,
+				inputJSON:    `["first","second","third"]`,
+				expectedJSON: `["first"]`,
 			},
 			{
-				jsonpath:    `$['a','b']..a`,
-				inputJSON:   `{"x":{"x":1,"c":2},"y":{"x":{"d":3,"x":4}}}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:    `$[(@.length-1)]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[(@.length-1)]`),
 			},
 			{
-				jsonpath:    `$['a','b']..a`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:    `$[(@.length)]`,
+				inputJSON:   `["first","second"]`,
+				expectedErr: createErrorMemberNotExist(`[(@.length)]`),
 			},
-		},
-		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$['a','b']['c','d']`,
-				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":3,"a":4}}`,
-				expectedJSON: `[2,3]`,
+				jsonpath:     `$.a[(@.length-1)]`,
+				inputJSON:    `{"a":[1,2,3]}`,
+				expectedJSON: `[3]`,
 			},
 			{
-				jsonpath:    `$['a','b']['c','d']`,
-				inputJSON:   `{"a":{"a":1,"x":2},"b":{"x":3,"a":4}}`,
-				expectedErr: createErrorMemberNotExist(`['c','d']`),
+				jsonpath:     `$.a[($.b.length)]`,
+				inputJSON:    `{"a":[1,2,3],"b":"xy"}`,
+				expectedJSON: `[3]`,
 			},
 			{
-				jsonpath:    `$['a','b']['c','d']`,
-				inputJSON:   `{"x":{"a":1,"c":2},"x":{"d":3,"a":4}}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:     `$.a[($.b.length)]`,
+				inputJSON:    `{"a":[1,2,3],"b":"日本"}`,
+				expectedJSON: `[3]`,
 			},
 			{
-				jsonpath:    `$['a','b']['c','d']`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:     `$.a[($.b.length)]`,
+				inputJSON:    `{"a":[1,2,3],"b":{"length":0}}`,
+				expectedJSON: `[1]`,
 			},
-		},
-		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$['a','b'].*`,
-				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":3,"a":4}}`,
-				expectedJSON: `[1,2,4,3]`,
+				jsonpath:    `$.a[($.b.length)]`,
+				inputJSON:   `{"a":[1,2,3],"b":{}}`,
+				expectedErr: createErrorMemberNotExist(`[($.b.length)]`),
 			},
 			{
-				jsonpath:    `$['a','b'].*`,
-				inputJSON:   `{"a":{},"b":{}}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
+				jsonpath:    `$.a[($.b.length)]`,
+				inputJSON:   `{"a":[1,2,3],"b":1}`,
+				expectedErr: createErrorMemberNotExist(`[($.b.length)]`),
 			},
 			{
-				jsonpath:    `$['a','b'].*`,
-				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:     `$.a[($.length.length)]`,
+				inputJSON:    `{"a":[1,2,3],"length":[1]}`,
+				expectedJSON: `[2]`,
 			},
 			{
-				jsonpath:    `$['a','b'].*`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:     `$[*][(@.length-1)]`,
+				inputJSON:    `[[1,2],[3],[4,5,6]]`,
+				expectedJSON: `[2,3,6]`,
+			},
+			{
+				jsonpath:     `$..[(@.length-1)]`,
+				inputJSON:    `{"a":[1,[2,3]]}`,
+				expectedJSON: `[[2,3],3]`,
 			},
 		},
-		`Slice-qualifier`: []TestCase{
+		`arithmetic`: []TestCase{
 			{
-				jsonpath:     `$['a','b'][0:2]`,
-				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
-				expectedJSON: `[1,3,4,6]`,
+				jsonpath:     `$[(1+1)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[2]`,
 			},
 			{
-				jsonpath:    `$['a','b'][0:2]`,
-				inputJSON:   `{"a":[],"b":[]}`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:     `$[(5-2)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[3]`,
 			},
 			{
-				jsonpath:    `$['a','b'][0:2]`,
-				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:     `$[(2*3)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[6]`,
 			},
 			{
-				jsonpath:    `$['a','b'][0:2]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:     `$[(9/3)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[3]`,
 			},
-		},
-		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:     `$['a','b'][*]`,
-				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
-				expectedJSON: `[1,3,2,4,6,5]`,
+				jsonpath:     `$[(9%4)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[1]`,
 			},
 			{
-				jsonpath:    `$['a','b'][*]`,
-				inputJSON:   `{"a":[],"b":[]}`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:     `$[(1+2*3)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[7]`,
 			},
 			{
-				jsonpath:    `$['a','b'][*]`,
-				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:     `$[((1+2)*3)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[9]`,
 			},
 			{
-				jsonpath:    `$['a','b'][*]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:     `$[(10-2-3)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[5]`,
 			},
-		},
-		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$['a','b'][0,1]`,
-				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
-				expectedJSON: `[1,3,4,6]`,
+				jsonpath:     `$[(-1)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[10]`,
 			},
 			{
-				jsonpath:    `$['a','b'][0,1]`,
-				inputJSON:   `{"a":[],"b":[]}`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				jsonpath:     `$[(-(1+1))]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[9]`,
 			},
 			{
-				jsonpath:    `$['a','b'][0,1]`,
-				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:     `$[(1.5*2)]`,
+				inputJSON:    `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedJSON: `[3]`,
 			},
 			{
-				jsonpath:    `$['a','b'][0,1]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:    `$[(3/2)]`,
+				inputJSON:   `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedErr: createErrorMemberNotExist(`[(3/2)]`),
 			},
-		},
-		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$['a','b'][?(@.b)]`,
-				inputJSON:    `{"a":[{"a":1},{"b":2}],"b":[{"a":3},{"b":4}]}`,
-				expectedJSON: `[{"b":2},{"b":4}]`,
+				jsonpath:    `$[(1/0)]`,
+				inputJSON:   `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedErr: createErrorMemberNotExist(`[(1/0)]`),
 			},
 			{
-				jsonpath:    `$['a','b'][?(@.b)]`,
-				inputJSON:   `{"a":[{"a":1},{"x":2}],"b":[{"a":3},{"x":4}]}`,
-				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
+				jsonpath:    `$[(1+'a')]`,
+				inputJSON:   `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedErr: createErrorMemberNotExist(`[(1+'a')]`),
 			},
 			{
-				jsonpath:    `$['a','b'][?(@.b)]`,
-				inputJSON:   `{"x":[{"a":1},{"b":2}],"y":[{"a":3},{"b":4}]}`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:    `$[('a'*2)]`,
+				inputJSON:   `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedErr: createErrorMemberNotExist(`[('a'*2)]`),
 			},
 			{
-				jsonpath:    `$['a','b'][?(@.b)]`,
-				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
+				jsonpath:    `$[(-'a')]`,
+				inputJSON:   `[0,1,2,3,4,5,6,7,8,9,10]`,
+				expectedErr: createErrorMemberNotExist(`[(-'a')]`),
+			},
+		},
+		`key`: []TestCase{
+			{
+				jsonpath:     `$[('a')]`,
+				inputJSON:    `{"a":1,"b":2}`,
+				expectedJSON: `[1]`,
+			},
+			{
+				jsonpath:     `$[('a'+"b")]`,
+				inputJSON:    `{"a":1,"ab":2}`,
+				expectedJSON: `[2]`,
+			},
+			{
+				jsonpath:     `$.a[($.key)]`,
+				inputJSON:    `{"a":{"x":1,"y":2},"key":"y"}`,
+				expectedJSON: `[2]`,
+			},
+			{
+				jsonpath:    `$[('c')]`,
+				inputJSON:   `{"a":1,"b":2}`,
+				expectedErr: createErrorMemberNotExist(`[('c')]`),
+			},
+			{
+				jsonpath:    `$[('a')]`,
+				inputJSON:   `[1,2]`,
+				expectedErr: createErrorTypeUnmatched(`[('a')]`, `object`, `[]interface {}`),
+			},
+			{
+				jsonpath:     `$[(1)]`,
+				inputJSON:    `{"1":1}`,
+				expectedJSON: `[1]`,
+			},
+			{
+				jsonpath:     `$[(@.length-1)]`,
+				inputJSON:    `{"length":2,"1":"x"}`,
+				expectedJSON: `["x"]`,
+			},
+			{
+				jsonpath:    `$[(1)]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: createErrorMemberNotExist(`[(1)]`),
+			},
+			{
+				jsonpath:    `$[(1)]`,
+				inputJSON:   `"ab"`,
+				expectedErr: createErrorTypeUnmatched(`[(1)]`, `array`, `string`),
+			},
+			{
+				jsonpath:    `$.a[($.key)]`,
+				inputJSON:   `{"a":[1],"key":true}`,
+				expectedErr: createErrorTypeUnmatched(`[($.key)]`, `number/string`, `bool`),
+			},
+			{
+				jsonpath:    `$.a[($.key)]`,
+				inputJSON:   `{"a":[1],"key":null}`,
+				expectedErr: createErrorTypeUnmatched(`[($.key)]`, `number/string`, `null`),
+			},
+		},
+		`reference`: []TestCase{
+			{
+				jsonpath:     `$.a[(@[0])]`,
+				inputJSON:    `{"a":[2,1,0]}`,
+				expectedJSON: `[0]`,
+			},
+			{
+				jsonpath:     `$.a[($.b['c'] + @.length - 4)]`,
+				inputJSON:    `{"a":[0,1,2],"b":{"c":3}}`,
+				expectedJSON: `[2]`,
+			},
+			{
+				jsonpath:     `$.a[(@[(@.length-1)])]`,
+				inputJSON:    `{"a":[2,1,0]}`,
+				expectedJSON: `[2]`,
+			},
+			{
+				jsonpath:     `$[?(@[(@.length-1)]==3)]`,
+				inputJSON:    `[[1,2],[2,3],[3]]`,
+				expectedJSON: `[[2,3],[3]]`,
+			},
+			{
+				jsonpath:    `$.a[($.none)]`,
+				inputJSON:   `{"a":[1]}`,
+				expectedErr: createErrorMemberNotExist(`[($.none)]`),
+			},
+			{
+				jsonpath:    `$.a[($.b[*])]`,
+				inputJSON:   `{"a":[1],"b":[0]}`,
+				expectedErr: ErrorInvalidSyntax{position: 5, reason: `JSONPath that returns a value group is prohibited`, near: `$.b[*])]`},
+			},
+			{
+				jsonpath:    `$[(@.*)]`,
+				inputJSON:   `[1]`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `JSONPath that returns a value group is prohibited`, near: `@.*)]`},
+			},
+			{
+				jsonpath:    `$[(@..a + 1)]`,
+				inputJSON:   `[1]`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `JSONPath that returns a value group is prohibited`, near: `@..a + 1)]`},
+			},
+		},
+		`not supported`: []TestCase{
+			{
+				jsonpath:    `$[(@.a())]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorNotSupported{feature: `script`, path: `[(@.a())]`},
+			},
+			{
+				jsonpath:    `$[(@.a-b)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorNotSupported{feature: `script`, path: `[(@.a-b)]`},
 			},
 		},
 	}
@@ -4576,165 +4767,145 @@ func TestRetrieve_valueGroupCombination_Multiple_identifiler(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Wildcard_identifiler(t *testing.T) {
+func TestRetrieve_valueGroupCombination_Recursive_descent(t *testing.T) {
 	testGroups := TestGroup{
 		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:     `$.*..a`,
-				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":{"e":3,"a":4}}}`,
-				expectedJSON: `[1,4]`,
+				jsonpath:     `$..a..b`,
+				inputJSON:    `[{"a":{"a":{"b":1},"c":2}},{"b":{"a":{"d":3,"b":4}}}]`,
+				expectedJSON: `[1,1,4]`,
 			},
 			{
-				jsonpath:    `$.*..a`,
-				inputJSON:   `{"x":{"x":1,"c":2},"b":{"d":{"e":3,"x":4}}}`,
-				expectedErr: createErrorMemberNotExist(`a`),
+				jsonpath:    `$..a..b`,
+				inputJSON:   `[{"a":{"a":{"x":1},"c":2}},{"b":{"a":{"d":3,"x":4}}}]`,
+				expectedErr: createErrorMemberNotExist(`b`),
 			},
 			{
-				jsonpath:    `$.*..a`,
-				inputJSON:   `{"a":"a","b":"b"}`,
+				jsonpath:    `$..a..b`,
+				inputJSON:   `[{"a":"b"},{"b":{"a":"b"}}]`,
 				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 			{
-				jsonpath:    `$.*..a`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
+				jsonpath:    `$..a..b`,
+				inputJSON:   `[{"x":{"x":{"b":1},"c":2}},{"b":{"x":{"d":3,"b":4}}}]`,
+				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$.*..a`,
+				jsonpath:    `$..a..b`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$.*['a','b']`,
-				inputJSON:    `{"a":{"a":1},"c":{"c":3},"b":{"b":2}}`,
-				expectedJSON: `[1,2]`,
+				jsonpath:     `$..['a','b']`,
+				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
+				expectedJSON: `[1,4]`,
 			},
 			{
-				jsonpath:    `$.*['a','b']`,
-				inputJSON:   `{"a":{"x":1},"c":{"c":3},"b":{"x":2}}`,
+				jsonpath:    `$..['a','b']`,
+				inputJSON:   `[{"x":1,"c":2},{"d":3,"x":4}]`,
 				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$.*['a','b']`,
+				jsonpath:    `$..['a','b']`,
 				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$.*['a','b']`,
+				jsonpath:    `$..['a','b']`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `[]interface {}`),
+			},
+			{
+				jsonpath:    `$..['a','b']`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$.*.*`,
-				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":3,"a":4}}`,
-				expectedJSON: `[1,2,4,3]`,
-			},
-			{
-				jsonpath:    `$.*.*`,
-				inputJSON:   `{"a":{},"b":{}}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
+				jsonpath:     `$..*`,
+				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
+				expectedJSON: `[{"a":1,"c":2},{"b":4,"d":3},1,2,4,3]`,
 			},
 			{
-				jsonpath:    `$.*.*`,
+				jsonpath:    `$..*`,
 				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
+				expectedErr: createErrorMemberNotExist(`*`),
 			},
 			{
-				jsonpath:    `$.*.*`,
+				jsonpath:    `$..*`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:     `$.*[0:2]`,
-				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
+				jsonpath:     `$..[0:2]`,
+				inputJSON:    `{"a":[1,3,2],"b":{"a":[4,6,5]}}`,
 				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$.*[0:2]`,
-				inputJSON:   `{"a":[],"b":[]}`,
+				jsonpath:    `$..[0:2]`,
+				inputJSON:   `{"a":[],"b":{"a":[]}}`,
 				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$.*[0:2]`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
-			},
-			{
-				jsonpath:    `$.*[0:2]`,
+				jsonpath:    `$..[0:2]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:     `$.*[*]`,
-				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
-				expectedJSON: `[1,3,2,4,6,5]`,
+				jsonpath:     `$..[*]`,
+				inputJSON:    `[[1,3,2],[4,6,5]]`,
+				expectedJSON: `[[1,3,2],[4,6,5],1,3,2,4,6,5]`,
 			},
 			{
-				jsonpath:    `$.*[*]`,
-				inputJSON:   `{"a":[],"b":[]}`,
+				jsonpath:    `$..[*]`,
+				inputJSON:   `[]`,
 				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$.*[*]`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
-			},
-			{
-				jsonpath:    `$.*[*]`,
+				jsonpath:    `$..a[*]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$.*[0,1]`,
-				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
-				expectedJSON: `[1,3,4,6]`,
+				jsonpath:     `$..[0,1]`,
+				inputJSON:    `[[1,3,2],[4,6,5]]`,
+				expectedJSON: `[[1,3,2],[4,6,5],1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$.*[0,1]`,
-				inputJSON:   `{"a":[],"b":[]}`,
+				jsonpath:    `$..[0,1]`,
+				inputJSON:   `[]`,
 				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$.*[0,1]`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
-			},
-			{
-				jsonpath:    `$.*[0,1]`,
+				jsonpath:    `$..[0,1]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$.*[?(@.b)]`,
-				inputJSON:    `{"a":[{"a":1},{"b":2}],"b":[{"a":3},{"b":4}]}`,
+				jsonpath:     `$..[?(@.b)]`,
+				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
 				expectedJSON: `[{"b":2},{"b":4}]`,
 			},
 			{
-				jsonpath:    `$.*[?(@.b)]`,
-				inputJSON:   `{"a":[{"a":1},{"x":2}],"b":[{"a":3},{"x":4}]}`,
+				jsonpath:    `$..[?(@.b)]`,
+				inputJSON:   `[]`,
 				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$.*[?(@.b)]`,
-				inputJSON:   `{}`,
-				expectedErr: createErrorMemberNotExist(`.*`),
-			},
-			{
-				jsonpath:    `$.*[?(@.b)]`,
+				jsonpath:    `$..[?(@.b)]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 		},
 	}
@@ -4742,165 +4913,165 @@ func TestRetrieve_valueGroupCombination_Wildcard_identifiler(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Slice_qualifier(t *testing.T) {
+func TestRetrieve_valueGroupCombination_Multiple_identifiler(t *testing.T) {
 	testGroups := TestGroup{
 		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:     `$[0:2]..a`,
-				inputJSON:    `[{"a":1},{"b":{"a":2}},{"a":3}]`,
-				expectedJSON: `[1,2]`,
+				jsonpath:     `$['a','b']..a`,
+				inputJSON:    `{"a":{"a":1,"c":2},"b":{"a":{"d":3,"a":4}}}`,
+				expectedJSON: `[1,{"a":4,"d":3},4]`,
 			},
 			{
-				jsonpath:    `$[0:2]..a`,
-				inputJSON:   `[{"x":1},{"b":{"x":2}},{"a":3}]`,
+				jsonpath:    `$['a','b']..a`,
+				inputJSON:   `{"a":{"x":1,"c":2},"b":{"x":{"d":3,"x":4}}}`,
 				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$[0:2]..a`,
-				inputJSON:   `["a","b",{"a":3}]`,
+				jsonpath:    `$['a','b']..a`,
+				inputJSON:   `{"a":"a","b":"a"}`,
 				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 			{
-				jsonpath:    `$[0:2]..a`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b']..a`,
+				inputJSON:   `{"x":{"x":1,"c":2},"y":{"x":{"d":3,"x":4}}}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2]..a`,
+				jsonpath:    `$['a','b']..a`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$[0:2]['a','b']`,
-				inputJSON:    `[{"a":1},{"b":2}]`,
-				expectedJSON: `[1,2]`,
+				jsonpath:     `$['a','b']['c','d']`,
+				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":3,"a":4}}`,
+				expectedJSON: `[2,3]`,
 			},
 			{
-				jsonpath:    `$[0:2]['a','b']`,
-				inputJSON:   `[{"x":1},{"x":2}]`,
-				expectedErr: createErrorMemberNotExist(`['a','b']`),
+				jsonpath:    `$['a','b']['c','d']`,
+				inputJSON:   `{"a":{"a":1,"x":2},"b":{"x":3,"a":4}}`,
+				expectedErr: createErrorMemberNotExist(`['c','d']`),
 			},
 			{
-				jsonpath:    `$[0:2]['a','b']`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b']['c','d']`,
+				inputJSON:   `{"x":{"a":1,"c":2},"x":{"d":3,"a":4}}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2]['a','b']`,
+				jsonpath:    `$['a','b']['c','d']`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$[0:2].*`,
-				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
+				jsonpath:     `$['a','b'].*`,
+				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":3,"a":4}}`,
 				expectedJSON: `[1,2,4,3]`,
 			},
 			{
-				jsonpath:    `$[0:2].*`,
-				inputJSON:   `[[],[]]`,
+				jsonpath:    `$['a','b'].*`,
+				inputJSON:   `{"a":{},"b":{}}`,
 				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[0:2].*`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b'].*`,
+				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2].*`,
+				jsonpath:    `$['a','b'].*`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0:2][0:2]`,
-				inputJSON:    `[[1,2,3],[4,5,6]]`,
-				expectedJSON: `[1,2,4,5]`,
+				jsonpath:     `$['a','b'][0:2]`,
+				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
+				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$[0:2][0:2]`,
-				inputJSON:   `[[],[]]`,
+				jsonpath:    `$['a','b'][0:2]`,
+				inputJSON:   `{"a":[],"b":[]}`,
 				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0:2][0:2]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b'][0:2]`,
+				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2][0:2]`,
+				jsonpath:    `$['a','b'][0:2]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0:2][*]`,
-				inputJSON:    `[{"a":1,"c":3},{"d":4,"b":2},{"e":5}]`,
-				expectedJSON: `[1,3,2,4]`,
+				jsonpath:     `$['a','b'][*]`,
+				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
+				expectedJSON: `[1,3,2,4,6,5]`,
 			},
 			{
-				jsonpath:    `$[0:2][*]`,
-				inputJSON:   `[{},{}]`,
+				jsonpath:    `$['a','b'][*]`,
+				inputJSON:   `{"a":[],"b":[]}`,
 				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[0:2][*]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b'][*]`,
+				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2][*]`,
+				jsonpath:    `$['a','b'][*]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0:2][0,1]`,
-				inputJSON:    `[[1,3,2],[4,6,5],[7]]`,
+				jsonpath:     `$['a','b'][0,1]`,
+				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
 				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$[0:2][0,1]`,
-				inputJSON:   `[[],[],[7]]`,
+				jsonpath:    `$['a','b'][0,1]`,
+				inputJSON:   `{"a":[],"b":[]}`,
 				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$[0:2][0,1]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b'][0,1]`,
+				inputJSON:   `{"x":[1,3,2],"y":[4,6,5]}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2][0,1]`,
+				jsonpath:    `$['a','b'][0,1]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0:2][?(@.b)]`,
-				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
+				jsonpath:     `$['a','b'][?(@.b)]`,
+				inputJSON:    `{"a":[{"a":1},{"b":2}],"b":[{"a":3},{"b":4}]}`,
 				expectedJSON: `[{"b":2},{"b":4}]`,
 			},
 			{
-				jsonpath:    `$[0:2][?(@.b)]`,
-				inputJSON:   `[[{"a":1},{"x":2}],[{"a":3},{"x":4}]]`,
+				jsonpath:    `$['a','b'][?(@.b)]`,
+				inputJSON:   `{"a":[{"a":1},{"x":2}],"b":[{"a":3},{"x":4}]}`,
 				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$[0:2][?(@.b)]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0:2]`),
+				jsonpath:    `$['a','b'][?(@.b)]`,
+				inputJSON:   `{"x":[{"a":1},{"b":2}],"y":[{"a":3},{"b":4}]}`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0:2][?(@.b)]`,
+				jsonpath:    `$['a','b'][?(@.b)]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`['a','b']`, `object`, `string`),
 			},
 		},
 	}
@@ -4908,165 +5079,165 @@ func TestRetrieve_valueGroupCombination_Slice_qualifier(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Wildcard_qualifier(t *testing.T) {
+func TestRetrieve_valueGroupCombination_Wildcard_identifiler(t *testing.T) {
 	testGroups := TestGroup{
 		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:     `$[*]..a`,
-				inputJSON:    `[{"a":1},{"b":{"a":2}},{"c":3}]`,
-				expectedJSON: `[1,2]`,
+				jsonpath:     `$.*..a`,
+				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":{"e":3,"a":4}}}`,
+				expectedJSON: `[1,4]`,
 			},
 			{
-				jsonpath:    `$[*]..a`,
-				inputJSON:   `[{"x":1},{"b":{"x":2}},{"c":3}]`,
+				jsonpath:    `$.*..a`,
+				inputJSON:   `{"x":{"x":1,"c":2},"b":{"d":{"e":3,"x":4}}}`,
 				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$[*]..a`,
-				inputJSON:   `["a","b","c"]`,
+				jsonpath:    `$.*..a`,
+				inputJSON:   `{"a":"a","b":"b"}`,
 				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 			{
-				jsonpath:    `$[*]..a`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*..a`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*]..a`,
+				jsonpath:    `$.*..a`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$[*]['a','b']`,
-				inputJSON:    `[{"c":4},{"b":2,"a":1},{"a":3}]`,
-				expectedJSON: `[1,2,3]`,
+				jsonpath:     `$.*['a','b']`,
+				inputJSON:    `{"a":{"a":1},"c":{"c":3},"b":{"b":2}}`,
+				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:    `$[*]['a','b']`,
-				inputJSON:   `[{"c":4},{"x":2},{"x":1}]`,
+				jsonpath:    `$.*['a','b']`,
+				inputJSON:   `{"a":{"x":1},"c":{"c":3},"b":{"x":2}}`,
 				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[*]['a','b']`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*['a','b']`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*]['a','b']`,
+				jsonpath:    `$.*['a','b']`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$[*].*`,
-				inputJSON:    `[{"c":4},{"b":2,"a":1},{"a":3}]`,
-				expectedJSON: `[4,1,2,3]`,
+				jsonpath:     `$.*.*`,
+				inputJSON:    `{"a":{"a":1,"c":2},"b":{"d":3,"a":4}}`,
+				expectedJSON: `[1,2,4,3]`,
 			},
 			{
-				jsonpath:    `$[*].*`,
-				inputJSON:   `[{},{},{}]`,
+				jsonpath:    `$.*.*`,
+				inputJSON:   `{"a":{},"b":{}}`,
 				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*].*`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*.*`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*].*`,
+				jsonpath:    `$.*.*`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[*][0:2]`,
-				inputJSON:    `[[1,2,3],[4,5],[6]]`,
-				expectedJSON: `[1,2,4,5,6]`,
+				jsonpath:     `$.*[0:2]`,
+				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
+				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$[*][0:2]`,
-				inputJSON:   `[[],[],[]]`,
+				jsonpath:    `$.*[0:2]`,
+				inputJSON:   `{"a":[],"b":[]}`,
 				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[*][0:2]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*[0:2]`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*][0:2]`,
+				jsonpath:    `$.*[0:2]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[*][*]`,
-				inputJSON:    `[[1,2,3],[4,5],[6]]`,
-				expectedJSON: `[1,2,3,4,5,6]`,
+				jsonpath:     `$.*[*]`,
+				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
+				expectedJSON: `[1,3,2,4,6,5]`,
 			},
 			{
-				jsonpath:    `$[*][*]`,
-				inputJSON:   `[[],[],[]]`,
+				jsonpath:    `$.*[*]`,
+				inputJSON:   `{"a":[],"b":[]}`,
 				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[*][*]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*[*]`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*][*]`,
+				jsonpath:    `$.*[*]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[*][0,1]`,
-				inputJSON:    `[[1,3,2],[4,6,5],[7]]`,
-				expectedJSON: `[1,3,4,6,7]`,
+				jsonpath:     `$.*[0,1]`,
+				inputJSON:    `{"a":[1,3,2],"b":[4,6,5]}`,
+				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$[*][0,1]`,
-				inputJSON:   `[[],[],[]]`,
+				jsonpath:    `$.*[0,1]`,
+				inputJSON:   `{"a":[],"b":[]}`,
 				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$[*][0,1]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*[0,1]`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*][0,1]`,
+				jsonpath:    `$.*[0,1]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[*][?(@.b)]`,
-				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
+				jsonpath:     `$.*[?(@.b)]`,
+				inputJSON:    `{"a":[{"a":1},{"b":2}],"b":[{"a":3},{"b":4}]}`,
 				expectedJSON: `[{"b":2},{"b":4}]`,
 			},
 			{
-				jsonpath:    `$[*][?(@.b)]`,
-				inputJSON:   `[[{"a":1},{"x":2}],[{"a":3},{"x":4}]]`,
+				jsonpath:    `$.*[?(@.b)]`,
+				inputJSON:   `{"a":[{"a":1},{"x":2}],"b":[{"a":3},{"x":4}]}`,
 				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$[*][?(@.b)]`,
-				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[*]`),
+				jsonpath:    `$.*[?(@.b)]`,
+				inputJSON:   `{}`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[*][?(@.b)]`,
+				jsonpath:    `$.*[?(@.b)]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`.*`, `object/array`, `string`),
 			},
 		},
 	}
@@ -5074,165 +5245,165 @@ func TestRetrieve_valueGroupCombination_Wildcard_qualifier(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Union_in_qualifier(t *testing.T) {
+func TestRetrieve_valueGroupCombination_Slice_qualifier(t *testing.T) {
 	testGroups := TestGroup{
 		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:     `$[0,1]..a`,
+				jsonpath:     `$[0:2]..a`,
 				inputJSON:    `[{"a":1},{"b":{"a":2}},{"a":3}]`,
 				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:    `$[0,1]..a`,
+				jsonpath:    `$[0:2]..a`,
 				inputJSON:   `[{"x":1},{"b":{"x":2}},{"a":3}]`,
 				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$[0,1]..a`,
+				jsonpath:    `$[0:2]..a`,
 				inputJSON:   `["a","b",{"a":3}]`,
 				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 			{
-				jsonpath:    `$[0,1]..a`,
+				jsonpath:    `$[0:2]..a`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1]..a`,
+				jsonpath:    `$[0:2]..a`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$[0,1]['a','b']`,
+				jsonpath:     `$[0:2]['a','b']`,
 				inputJSON:    `[{"a":1},{"b":2}]`,
 				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:    `$[0,1]['a','b']`,
+				jsonpath:    `$[0:2]['a','b']`,
 				inputJSON:   `[{"x":1},{"x":2}]`,
 				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[0,1]['a','b']`,
+				jsonpath:    `$[0:2]['a','b']`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1]['a','b']`,
+				jsonpath:    `$[0:2]['a','b']`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$[0,1].*`,
+				jsonpath:     `$[0:2].*`,
 				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
 				expectedJSON: `[1,2,4,3]`,
 			},
 			{
-				jsonpath:    `$[0,1].*`,
+				jsonpath:    `$[0:2].*`,
 				inputJSON:   `[[],[]]`,
 				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[0,1].*`,
+				jsonpath:    `$[0:2].*`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1].*`,
+				jsonpath:    `$[0:2].*`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0,1][0:2]`,
+				jsonpath:     `$[0:2][0:2]`,
 				inputJSON:    `[[1,2,3],[4,5,6]]`,
 				expectedJSON: `[1,2,4,5]`,
 			},
 			{
-				jsonpath:    `$[0,1][0:2]`,
+				jsonpath:    `$[0:2][0:2]`,
 				inputJSON:   `[[],[]]`,
 				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1][0:2]`,
+				jsonpath:    `$[0:2][0:2]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1][0:2]`,
+				jsonpath:    `$[0:2][0:2]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0,1][*]`,
+				jsonpath:     `$[0:2][*]`,
 				inputJSON:    `[{"a":1,"c":3},{"d":4,"b":2},{"e":5}]`,
 				expectedJSON: `[1,3,2,4]`,
 			},
 			{
-				jsonpath:    `$[0,1][*]`,
+				jsonpath:    `$[0:2][*]`,
 				inputJSON:   `[{},{}]`,
 				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[0,1][*]`,
+				jsonpath:    `$[0:2][*]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1][*]`,
+				jsonpath:    `$[0:2][*]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0,1][0,1]`,
+				jsonpath:     `$[0:2][0,1]`,
 				inputJSON:    `[[1,3,2],[4,6,5],[7]]`,
 				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$[0,1][0,1]`,
+				jsonpath:    `$[0:2][0,1]`,
 				inputJSON:   `[[],[],[7]]`,
 				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$[0,1][0,1]`,
+				jsonpath:    `$[0:2][0,1]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1][0,1]`,
+				jsonpath:    `$[0:2][0,1]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[0,1][?(@.b)]`,
+				jsonpath:     `$[0:2][?(@.b)]`,
 				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
 				expectedJSON: `[{"b":2},{"b":4}]`,
 			},
 			{
-				jsonpath:    `$[0,1][?(@.b)]`,
+				jsonpath:    `$[0:2][?(@.b)]`,
 				inputJSON:   `[[{"a":1},{"x":2}],[{"a":3},{"x":4}]]`,
 				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$[0,1][?(@.b)]`,
+				jsonpath:    `$[0:2][?(@.b)]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[0,1]`),
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0,1][?(@.b)]`,
+				jsonpath:    `$[0:2][?(@.b)]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[0:2]`, `array`, `string`),
 			},
 		},
 	}
@@ -5240,160 +5411,165 @@ func TestRetrieve_valueGroupCombination_Union_in_qualifier(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_valueGroupCombination_Filter_qualifier(t *testing.T) {
+func TestRetrieve_valueGroupCombination_Wildcard_qualifier(t *testing.T) {
 	testGroups := TestGroup{
 		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:     `$[?(@.b)]..a`,
-				inputJSON:    `[{"a":1},{"b":{"a":2}},{"c":3},{"b":[{"a":4}]}]`,
-				expectedJSON: `[2,4]`,
+				jsonpath:     `$[*]..a`,
+				inputJSON:    `[{"a":1},{"b":{"a":2}},{"c":3}]`,
+				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:    `$[?(@.b)]..a`,
-				inputJSON:   `[{"a":1},{"b":{"x":2}},{"c":3},{"b":[{"x":4}]}]`,
+				jsonpath:    `$[*]..a`,
+				inputJSON:   `[{"x":1},{"b":{"x":2}},{"c":3}]`,
 				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$[?(@.b)]..a`,
-				inputJSON:   `[{"a":1},{"b":"a"},{"c":3},{"b":"a"}]`,
-				expectedErr: createErrorMemberNotExist(`a`),
+				jsonpath:    `$[*]..a`,
+				inputJSON:   `["a","b","c"]`,
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 			{
-				jsonpath:    `$[?(@.b)]..a`,
-				inputJSON:   `[{"a":1},{"x":{"a":2}},{"c":3},{"x":[{"a":4}]}]`,
-				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
+				jsonpath:    `$[*]..a`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@.b)]..a`,
+				jsonpath:    `$[*]..a`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@.b)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$[?(@.b)]['a','c']`,
-				inputJSON:    `[{"a":1},{"b":2},{"a":3,"b":4},{"c":5},{"a":6,"c":7},{"b":8,"c":9},{"a":10,"b":11,"c":12}]`,
-				expectedJSON: `[3,9,10,12]`,
+				jsonpath:     `$[*]['a','b']`,
+				inputJSON:    `[{"c":4},{"b":2,"a":1},{"a":3}]`,
+				expectedJSON: `[1,2,3]`,
 			},
 			{
-				jsonpath:    `$[?(@.b)]['a','c']`,
-				inputJSON:   `[{"a":1},{"b":2},{"x":3,"b":4},{"c":5},{"a":6,"c":7},{"b":8,"z":9},{"x":10,"b":11,"z":12}]`,
-				expectedErr: createErrorMemberNotExist(`['a','c']`),
+				jsonpath:    `$[*]['a','b']`,
+				inputJSON:   `[{"c":4},{"x":2},{"x":1}]`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:    `$[?(@.b)]['a','c']`,
-				inputJSON:   `[{"a":1},{"x":2},{"a":3,"x":4},{"c":5},{"a":6,"c":7},{"x":8,"c":9},{"a":10,"x":11,"c":12}]`,
-				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
+				jsonpath:    `$[*]['a','b']`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@.b)]['a','c']`,
+				jsonpath:    `$[*]['a','b']`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@.b)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$[?(@.b)].*`,
-				inputJSON:    `[{"a":1},{"b":2},{"a":3,"b":4},{"c":5},{"a":6,"c":7},{"b":8,"c":9},{"a":10,"b":11,"c":12}]`,
-				expectedJSON: `[2,3,4,8,9,10,11,12]`,
+				jsonpath:     `$[*].*`,
+				inputJSON:    `[{"c":4},{"b":2,"a":1},{"a":3}]`,
+				expectedJSON: `[4,1,2,3]`,
 			},
 			{
-				jsonpath:    `$[?(@.b)].*`,
-				inputJSON:   `[{"a":1},{"x":2},{"a":3,"x":4},{"c":5},{"a":6,"c":7},{"x":8,"c":9},{"a":10,"x":11,"c":12}]`,
-				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
+				jsonpath:    `$[*].*`,
+				inputJSON:   `[{},{},{}]`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:    `$[?(@.b)].*`,
+				jsonpath:    `$[*].*`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[*]`),
+			},
+			{
+				jsonpath:    `$[*].*`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@.b)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[?(@)][0:2]`,
-				inputJSON:    `[[1,2],[3,4],[5,6,7]]`,
-				expectedJSON: `[1,2,3,4,5,6]`,
+				jsonpath:     `$[*][0:2]`,
+				inputJSON:    `[[1,2,3],[4,5],[6]]`,
+				expectedJSON: `[1,2,4,5,6]`,
 			},
 			{
-				jsonpath:    `$[?(@)][0:2]`,
+				jsonpath:    `$[*][0:2]`,
 				inputJSON:   `[[],[],[]]`,
 				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[?(@)][0:2]`,
+				jsonpath:    `$[*][0:2]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[?(@)]`),
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@)][0:2]`,
+				jsonpath:    `$[*][0:2]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[?(@)][*]`,
-				inputJSON:    `[[1,2],[3,4],[5,6,7]]`,
-				expectedJSON: `[1,2,3,4,5,6,7]`,
+				jsonpath:     `$[*][*]`,
+				inputJSON:    `[[1,2,3],[4,5],[6]]`,
+				expectedJSON: `[1,2,3,4,5,6]`,
 			},
 			{
-				jsonpath:    `$[?(@)][*]`,
+				jsonpath:    `$[*][*]`,
 				inputJSON:   `[[],[],[]]`,
 				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@)][*]`,
+				jsonpath:    `$[*][*]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[?(@)]`),
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@)][*]`,
+				jsonpath:    `$[*][*]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[?(@)][0,1]`,
-				inputJSON:    `[[1,2],[3,4],[5,6,7]]`,
-				expectedJSON: `[1,2,3,4,5,6]`,
+				jsonpath:     `$[*][0,1]`,
+				inputJSON:    `[[1,3,2],[4,6,5],[7]]`,
+				expectedJSON: `[1,3,4,6,7]`,
 			},
 			{
-				jsonpath:    `$[?(@)][0,1]`,
+				jsonpath:    `$[*][0,1]`,
 				inputJSON:   `[[],[],[]]`,
 				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$[?(@)][0,1]`,
+				jsonpath:    `$[*][0,1]`,
 				inputJSON:   `[]`,
-				expectedErr: createErrorMemberNotExist(`[?(@)]`),
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@)][0,1]`,
+				jsonpath:    `$[*][0,1]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a)][?(@.b)]`,
-				inputJSON:    `[{"a":{"b":2}},{"b":{"a":1}},{"a":{"a":3}}]`,
-				expectedJSON: `[{"b":2}]`,
+				jsonpath:     `$[*][?(@.b)]`,
+				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
+				expectedJSON: `[{"b":2},{"b":4}]`,
 			},
 			{
-				jsonpath:    `$[?(@.a)][?(@.b)]`,
-				inputJSON:   `[{"a":{"x":2}},{"b":{"a":1}},{"a":{"a":3}}]`,
+				jsonpath:    `$[*][?(@.b)]`,
+				inputJSON:   `[[{"a":1},{"x":2}],[{"a":3},{"x":4}]]`,
 				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$[?(@.a)][?(@.b)]`,
-				inputJSON:   `[{"x":{"b":2}},{"b":{"a":1}},{"x":{"a":3}}]`,
-				expectedErr: createErrorMemberNotExist(`[?(@.a)]`),
+				jsonpath:    `$[*][?(@.b)]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[?(@.a)][?(@.b)]`,
+				jsonpath:    `$[*][?(@.b)]`,
 				inputJSON:   `"x"`,
-				expectedErr: createErrorTypeUnmatched(`[?(@.a)]`, `object/array`, `string`),
+				expectedErr: createErrorTypeUnmatched(`[*]`, `object/array`, `string`),
 			},
 		},
 	}
@@ -5401,1395 +5577,1203 @@ func TestRetrieve_valueGroupCombination_Filter_qualifier(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func TestRetrieve_space(t *testing.T) {
+func TestRetrieve_valueGroupCombination_Union_in_qualifier(t *testing.T) {
 	testGroups := TestGroup{
-		`Space`: []TestCase{
+		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:     ` $.a `,
-				inputJSON:    `{"a":123}`,
-				expectedJSON: `[123]`,
+				jsonpath:     `$[0,1]..a`,
+				inputJSON:    `[{"a":1},{"b":{"a":2}},{"a":3}]`,
+				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:    "\t" + `$.a` + "\t",
-				inputJSON:   `{"a":123}`,
-				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: "\t" + `$.a` + "\t"},
+				jsonpath:    `$[0,1]..a`,
+				inputJSON:   `[{"x":1},{"b":{"x":2}},{"a":3}]`,
+				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$.a` + "\n",
-				inputJSON:   `{"a":123}`,
-				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: "\n"},
+				jsonpath:    `$[0,1]..a`,
+				inputJSON:   `["a","b",{"a":3}]`,
+				expectedErr: createErrorTypeUnmatched(`..`, `object/array`, `string`),
 			},
 			{
-				jsonpath:     `$[ "a" , "c" ]`,
-				inputJSON:    `{"a":1,"b":2,"c":3}`,
-				expectedJSON: `[1,3]`,
+				jsonpath:    `$[0,1]..a`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:     `$[ 0 , 2 : 4 , * ]`,
-				inputJSON:    `[1,2,3,4,5]`,
-				expectedJSON: `[1,3,4,1,2,3,4,5]`,
+				jsonpath:    `$[0,1]..a`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
+		},
+		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:     `$[ ?( @.a == 1 ) ]`,
-				inputJSON:    `[{"a":1}]`,
-				expectedJSON: `[{"a":1}]`,
+				jsonpath:     `$[0,1]['a','b']`,
+				inputJSON:    `[{"a":1},{"b":2}]`,
+				expectedJSON: `[1,2]`,
 			},
 			{
-				jsonpath:     `$[ ?( @.a != 1 ) ]`,
-				inputJSON:    `[{"a":2}]`,
-				expectedJSON: `[{"a":2}]`,
+				jsonpath:    `$[0,1]['a','b']`,
+				inputJSON:   `[{"x":1},{"x":2}]`,
+				expectedErr: createErrorMemberNotExist(`['a','b']`),
 			},
 			{
-				jsonpath:     `$[ ?( @.a <= 1 ) ]`,
-				inputJSON:    `[{"a":1}]`,
-				expectedJSON: `[{"a":1}]`,
+				jsonpath:    `$[0,1]['a','b']`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:     `$[ ?( @.a < 1 ) ]`,
-				inputJSON:    `[{"a":0}]`,
-				expectedJSON: `[{"a":0}]`,
+				jsonpath:    `$[0,1]['a','b']`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
+		},
+		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:     `$[ ?( @.a >= 1 ) ]`,
-				inputJSON:    `[{"a":1}]`,
-				expectedJSON: `[{"a":1}]`,
+				jsonpath:     `$[0,1].*`,
+				inputJSON:    `[{"a":1,"c":2},{"d":3,"b":4}]`,
+				expectedJSON: `[1,2,4,3]`,
 			},
 			{
-				jsonpath:     `$[ ?( @.a > 1 ) ]`,
-				inputJSON:    `[{"a":2}]`,
-				expectedJSON: `[{"a":2}]`,
+				jsonpath:    `$[0,1].*`,
+				inputJSON:   `[[],[]]`,
+				expectedErr: createErrorMemberNotExist(`.*`),
 			},
 			{
-				jsonpath:     `$[ ?( @.a =~ /a/ ) ]`,
-				inputJSON:    `[{"a":"abc"}]`,
-				expectedJSON: `[{"a":"abc"}]`,
+				jsonpath:    `$[0,1].*`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:     `$[ ?( @.a == 1 && @.b == 2 ) ]`,
-				inputJSON:    `[{"a":1,"b":2}]`,
-				expectedJSON: `[{"a":1,"b":2}]`,
+				jsonpath:    `$[0,1].*`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
+		},
+		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:     `$[ ?( @.a == 1 || @.b == 2 ) ]`,
-				inputJSON:    `[{"a":1},{"b":2}]`,
-				expectedJSON: `[{"a":1},{"b":2}]`,
+				jsonpath:     `$[0,1][0:2]`,
+				inputJSON:    `[[1,2,3],[4,5,6]]`,
+				expectedJSON: `[1,2,4,5]`,
 			},
 			{
-				jsonpath:     `$[ ?( ! @.a ) ]`,
-				inputJSON:    `[{"a":1},{"b":2}]`,
-				expectedJSON: `[{"b":2}]`,
+				jsonpath:    `$[0,1][0:2]`,
+				inputJSON:   `[[],[]]`,
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
-		},
-	}
-
-	execTestRetrieveTestGroups(t, testGroups)
-}
-
-func TestRetrieve_invalidSyntax(t *testing.T) {
-	testGroups := TestGroup{
-		`root-identifier`: []TestCase{
 			{
-				jsonpath:    ``,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: ``},
+				jsonpath:    `$[0,1][0:2]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$$`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `$`},
+				jsonpath:    `$[0,1][0:2]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
 		},
-		`dot-child-identifier`: []TestCase{
+		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:    `.c`,
-				inputJSON:   `{"a":"b","c":{"d":"e"}}`,
-				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: `.c`},
+				jsonpath:     `$[0,1][*]`,
+				inputJSON:    `[{"a":1,"c":3},{"d":4,"b":2},{"e":5}]`,
+				expectedJSON: `[1,3,2,4]`,
 			},
 			{
-				jsonpath:    `$a`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `a`},
+				jsonpath:    `$[0,1][*]`,
+				inputJSON:   `[{},{}]`,
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `.`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: `.`},
+				jsonpath:    `$[0,1][*]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$.`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.`},
+				jsonpath:    `$[0,1][*]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
+		},
+		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:    `..`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: `..`},
+				jsonpath:     `$[0,1][0,1]`,
+				inputJSON:    `[[1,3,2],[4,6,5],[7]]`,
+				expectedJSON: `[1,3,4,6]`,
 			},
 			{
-				jsonpath:    `$..`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `..`},
+				jsonpath:    `$[0,1][0,1]`,
+				inputJSON:   `[[],[],[7]]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$.a.`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `.`},
+				jsonpath:    `$[0,1][0,1]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$.a..`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `..`},
+				jsonpath:    `$[0,1][0,1]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
+		},
+		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:    `$..a.`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.`},
+				jsonpath:     `$[0,1][?(@.b)]`,
+				inputJSON:    `[[{"a":1},{"b":2}],[{"a":3},{"b":4}]]`,
+				expectedJSON: `[{"b":2},{"b":4}]`,
 			},
 			{
-				jsonpath:    `$..a..`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `..`},
+				jsonpath:    `$[0,1][?(@.b)]`,
+				inputJSON:   `[[{"a":1},{"x":2}],[{"a":3},{"x":4}]]`,
+				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$...a`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `...a`},
+				jsonpath:    `$[0,1][?(@.b)]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
-		},
-		`bracket-child-identifier`: []TestCase{
 			{
-				jsonpath:    `$['a]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a]`},
+				jsonpath:    `$[0,1][?(@.b)]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[0,1]`, `array`, `string`),
 			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_valueGroupCombination_Filter_qualifier(t *testing.T) {
+	testGroups := TestGroup{
+		`Recursive-descent`: []TestCase{
 			{
-				jsonpath:    `$["a]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `["a]`},
+				jsonpath:     `$[?(@.b)]..a`,
+				inputJSON:    `[{"a":1},{"b":{"a":2}},{"c":3},{"b":[{"a":4}]}]`,
+				expectedJSON: `[2,4]`,
 			},
 			{
-				jsonpath:    `$[a']`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a']`},
+				jsonpath:    `$[?(@.b)]..a`,
+				inputJSON:   `[{"a":1},{"b":{"x":2}},{"c":3},{"b":[{"x":4}]}]`,
+				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$[a"]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a"]`},
+				jsonpath:    `$[?(@.b)]..a`,
+				inputJSON:   `[{"a":1},{"b":"a"},{"c":3},{"b":"a"}]`,
+				expectedErr: createErrorMemberNotExist(`a`),
 			},
 			{
-				jsonpath:    `$[a]`,
-				inputJSON:   `["first","second","third"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a]`},
+				jsonpath:    `$[?(@.b)]..a`,
+				inputJSON:   `[{"a":1},{"x":{"a":2}},{"c":3},{"x":[{"a":4}]}]`,
+				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$.['a']`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.['a']`},
+				jsonpath:    `$[?(@.b)]..a`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@.b)]`, `object/array`, `string`),
 			},
+		},
+		`Multiple-identifiler`: []TestCase{
 			{
-				jsonpath:    `$.["a"]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.["a"]`},
+				jsonpath:     `$[?(@.b)]['a','c']`,
+				inputJSON:    `[{"a":1},{"b":2},{"a":3,"b":4},{"c":5},{"a":6,"c":7},{"b":8,"c":9},{"a":10,"b":11,"c":12}]`,
+				expectedJSON: `[3,9,10,12]`,
 			},
 			{
-				jsonpath:    `$.[a]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.[a]`},
+				jsonpath:    `$[?(@.b)]['a','c']`,
+				inputJSON:   `[{"a":1},{"b":2},{"x":3,"b":4},{"c":5},{"a":6,"c":7},{"b":8,"z":9},{"x":10,"b":11,"z":12}]`,
+				expectedErr: createErrorMemberNotExist(`['a','c']`),
 			},
 			{
-				jsonpath:    `$['a'.'b']`,
-				inputJSON:   `["a"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a'.'b']`},
+				jsonpath:    `$[?(@.b)]['a','c']`,
+				inputJSON:   `[{"a":1},{"x":2},{"a":3,"x":4},{"c":5},{"a":6,"c":7},{"x":8,"c":9},{"a":10,"x":11,"c":12}]`,
+				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$[a.b]`,
-				inputJSON:   `[{"a":{"b":1}}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a.b]`},
+				jsonpath:    `$[?(@.b)]['a','c']`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@.b)]`, `object/array`, `string`),
 			},
+		},
+		`Wildcard-identifier`: []TestCase{
 			{
-				jsonpath:    `$['a'b']`,
-				inputJSON:   `["a"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a'b']`},
+				jsonpath:     `$[?(@.b)].*`,
+				inputJSON:    `[{"a":1},{"b":2},{"a":3,"b":4},{"c":5},{"a":6,"c":7},{"b":8,"c":9},{"a":10,"b":11,"c":12}]`,
+				expectedJSON: `[2,3,4,8,9,10,11,12]`,
 			},
 			{
-				jsonpath:    `$['a\\'b']`,
-				inputJSON:   `["a"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a\\'b']`},
+				jsonpath:    `$[?(@.b)].*`,
+				inputJSON:   `[{"a":1},{"x":2},{"a":3,"x":4},{"c":5},{"a":6,"c":7},{"x":8,"c":9},{"a":10,"x":11,"c":12}]`,
+				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$['ab\']`,
-				inputJSON:   `["a"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['ab\']`},
+				jsonpath:    `$[?(@.b)].*`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@.b)]`, `object/array`, `string`),
 			},
 		},
-		`qualifier::index`: []TestCase{
+		`Slice-qualifier`: []TestCase{
 			{
-				jsonpath:    `$[0].[1]`,
-				inputJSON:   `[["a","b"],["c"],["d"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.[1]`},
+				jsonpath:     `$[?(@)][0:2]`,
+				inputJSON:    `[[1,2],[3,4],[5,6,7]]`,
+				expectedJSON: `[1,2,3,4,5,6]`,
 			},
 			{
-				jsonpath:    `$[0,1].[1]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1]`},
+				jsonpath:    `$[?(@)][0:2]`,
+				inputJSON:   `[[],[],[]]`,
+				expectedErr: createErrorMemberNotExist(`[0:2]`),
 			},
 			{
-				jsonpath:    `$[0:2].[1]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1]`},
-			},
-		},
-		`qualifier::union`: []TestCase{
-			{
-				jsonpath:    `$[0].[1,2]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.[1,2]`},
+				jsonpath:    `$[?(@)][0:2]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[?(@)]`),
 			},
 			{
-				jsonpath:    `$[0,1].[1,2]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1,2]`},
+				jsonpath:    `$[?(@)][0:2]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@)]`, `object/array`, `string`),
 			},
+		},
+		`Wildcard-qualifier`: []TestCase{
 			{
-				jsonpath:    `$[0:2].[1,2]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1,2]`},
+				jsonpath:     `$[?(@)][*]`,
+				inputJSON:    `[[1,2],[3,4],[5,6,7]]`,
+				expectedJSON: `[1,2,3,4,5,6,7]`,
 			},
-		},
-		`qualifier::slice`: []TestCase{
 			{
-				jsonpath:    `$[0].[1:3]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.[1:3]`},
+				jsonpath:    `$[?(@)][*]`,
+				inputJSON:   `[[],[],[]]`,
+				expectedErr: createErrorMemberNotExist(`[*]`),
 			},
 			{
-				jsonpath:    `$[0,1].[1:3]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1:3]`},
+				jsonpath:    `$[?(@)][*]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[?(@)]`),
 			},
 			{
-				jsonpath:    `$[0:1].[1:3]`,
-				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1:3]`},
+				jsonpath:    `$[?(@)][*]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@)]`, `object/array`, `string`),
 			},
 		},
-		`qualifier::filter`: []TestCase{
+		`Union-in-qualifier`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a),?(@.b)]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a),?(@.b)]`},
+				jsonpath:     `$[?(@)][0,1]`,
+				inputJSON:    `[[1,2],[3,4],[5,6,7]]`,
+				expectedJSON: `[1,2,3,4,5,6]`,
 			},
-		},
-		`qualifier::empty`: []TestCase{
 			{
-				jsonpath:    `$[]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[]`},
+				jsonpath:    `$[?(@)][0,1]`,
+				inputJSON:   `[[],[],[]]`,
+				expectedErr: createErrorMemberNotExist(`[0,1]`),
 			},
 			{
-				jsonpath:    `$.a[]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `[]`},
+				jsonpath:    `$[?(@)][0,1]`,
+				inputJSON:   `[]`,
+				expectedErr: createErrorMemberNotExist(`[?(@)]`),
 			},
 			{
-				jsonpath:    `$.a.b[]`,
-				inputJSON:   `{"a":1}`,
-				expectedErr: ErrorInvalidSyntax{position: 5, reason: `unrecognized input`, near: `[]`},
+				jsonpath:    `$[?(@)][0,1]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@)]`, `object/array`, `string`),
 			},
+		},
+		`Filter-qualifier`: []TestCase{
 			{
-				jsonpath:    `$[]`,
-				inputJSON:   `["first","second","third"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[]`},
+				jsonpath:     `$[?(@.a)][?(@.b)]`,
+				inputJSON:    `[{"a":{"b":2}},{"b":{"a":1}},{"a":{"a":3}}]`,
+				expectedJSON: `[{"b":2}]`,
 			},
 			{
-				jsonpath:    `$[?()]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?()]`},
+				jsonpath:    `$[?(@.a)][?(@.b)]`,
+				inputJSON:   `[{"a":{"x":2}},{"b":{"a":1}},{"a":{"a":3}}]`,
+				expectedErr: createErrorMemberNotExist(`[?(@.b)]`),
 			},
 			{
-				jsonpath:    `$[()]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[()]`},
+				jsonpath:    `$[?(@.a)][?(@.b)]`,
+				inputJSON:   `[{"x":{"b":2}},{"b":{"a":1}},{"x":{"a":3}}]`,
+				expectedErr: createErrorMemberNotExist(`[?(@.a)]`),
 			},
-		},
-		`qualifier::brace`: []TestCase{
 			{
-				jsonpath:    `$()`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `()`},
+				jsonpath:    `$[?(@.a)][?(@.b)]`,
+				inputJSON:   `"x"`,
+				expectedErr: createErrorTypeUnmatched(`[?(@.a)]`, `object/array`, `string`),
 			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_space(t *testing.T) {
+	testGroups := TestGroup{
+		`Space`: []TestCase{
 			{
-				jsonpath:    `$(a)`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `(a)`},
+				jsonpath:     ` $.a `,
+				inputJSON:    `{"a":123}`,
+				expectedJSON: `[123]`,
 			},
 			{
-				jsonpath:    `$[`,
-				inputJSON:   `["first","second","third"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[`},
+				jsonpath:    "\t" + `$.a` + "\t",
+				inputJSON:   `{"a":123}`,
+				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: "\t" + `$.a` + "\t"},
 			},
 			{
-				jsonpath:    `$[(`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[(`},
+				jsonpath:    `$.a` + "\n",
+				inputJSON:   `{"a":123}`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: "\n"},
 			},
 			{
-				jsonpath:    `$[(]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[(]`},
+				jsonpath:     `$[ "a" , "c" ]`,
+				inputJSON:    `{"a":1,"b":2,"c":3}`,
+				expectedJSON: `[1,3]`,
 			},
 			{
-				jsonpath:    `$[0`,
-				inputJSON:   `["first","second","third"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[0`},
+				jsonpath:     `$[ 0 , 2 : 4 , * ]`,
+				inputJSON:    `[1,2,3,4,5]`,
+				expectedJSON: `[1,3,4,1,2,3,4,5]`,
 			},
 			{
-				jsonpath:    `$[?@a]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?@a]`},
+				jsonpath:     `$[ ?( @.a == 1 ) ]`,
+				inputJSON:    `[{"a":1}]`,
+				expectedJSON: `[{"a":1}]`,
 			},
 			{
-				jsonpath:    `$[?(@.a=='abc`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=='abc`},
+				jsonpath:     `$[ ?( @.a != 1 ) ]`,
+				inputJSON:    `[{"a":2}]`,
+				expectedJSON: `[{"a":2}]`,
 			},
 			{
-				jsonpath:    `$[?(@.a=="abc`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=="abc`},
+				jsonpath:     `$[ ?( @.a <= 1 ) ]`,
+				inputJSON:    `[{"a":1}]`,
+				expectedJSON: `[{"a":1}]`,
 			},
 			{
-				jsonpath:    `$[?((@.a>1 )]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a>1 )]`},
+				jsonpath:     `$[ ?( @.a < 1 ) ]`,
+				inputJSON:    `[{"a":0}]`,
+				expectedJSON: `[{"a":0}]`,
 			},
 			{
-				jsonpath:    `$[?((@.a>1`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a>1`},
+				jsonpath:     `$[ ?( @.a >= 1 ) ]`,
+				inputJSON:    `[{"a":1}]`,
+				expectedJSON: `[{"a":1}]`,
 			},
-		},
-		`qualifier::big-number`: []TestCase{
 			{
-				jsonpath:    `$[0,10000000000000000000,]`,
-				inputJSON:   `["first","second","third"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[0,10000000000000000000,]`},
+				jsonpath:     `$[ ?( @.a > 1 ) ]`,
+				inputJSON:    `[{"a":2}]`,
+				expectedJSON: `[{"a":2}]`,
 			},
 			{
-				jsonpath:    `$[0:10000000000000000000:a]`,
-				inputJSON:   `["first","second","third"]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[0:10000000000000000000:a]`},
+				jsonpath:     `$[ ?( @.a =~ /a/ ) ]`,
+				inputJSON:    `[{"a":"abc"}]`,
+				expectedJSON: `[{"a":"abc"}]`,
 			},
-		},
-		`qualifier::comparator`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a!!=1)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a!!=1)]`},
+				jsonpath:     `$[ ?( @.a == 1 && @.b == 2 ) ]`,
+				inputJSON:    `[{"a":1,"b":2}]`,
+				expectedJSON: `[{"a":1,"b":2}]`,
 			},
 			{
-				jsonpath:    `$[?(@.a==)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==)]`},
+				jsonpath:     `$[ ?( @.a == 1 || @.b == 2 ) ]`,
+				inputJSON:    `[{"a":1},{"b":2}]`,
+				expectedJSON: `[{"a":1},{"b":2}]`,
 			},
 			{
-				jsonpath:    `$[?(@.a!=)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a!=)]`},
+				jsonpath:     `$[ ?( ! @.a ) ]`,
+				inputJSON:    `[{"a":1},{"b":2}]`,
+				expectedJSON: `[{"b":2}]`,
 			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_invalidSyntax(t *testing.T) {
+	testGroups := TestGroup{
+		`root-identifier`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a<=)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a<=)]`},
+				jsonpath:    ``,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: ``},
 			},
 			{
-				jsonpath:    `$[?(@.a<)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a<)]`},
+				jsonpath:    `$$`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `$`},
 			},
+		},
+		`dot-child-identifier`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a>=)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>=)]`},
+				jsonpath:    `.c`,
+				inputJSON:   `{"a":"b","c":{"d":"e"}}`,
+				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: `.c`},
 			},
 			{
-				jsonpath:    `$[?(@.a>)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>)]`},
+				jsonpath:    `$a`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `a`},
 			},
 			{
-				jsonpath:    `$[?(==@.a)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(==@.a)]`},
+				jsonpath:    `.`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: `.`},
 			},
 			{
-				jsonpath:    `$[?(!=@.a)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(!=@.a)]`},
+				jsonpath:    `$.`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.`},
 			},
 			{
-				jsonpath:    `$[?(<=@.a)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(<=@.a)]`},
+				jsonpath:    `..`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 0, reason: `unrecognized input`, near: `..`},
 			},
 			{
-				jsonpath:    `$[?(<@.a)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(<@.a)]`},
+				jsonpath:    `$..`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `..`},
 			},
 			{
-				jsonpath:    `$[?(>=@.a)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(>=@.a)]`},
+				jsonpath:    `$.a.`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `.`},
 			},
 			{
-				jsonpath:    `$[?(>@.a)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(>@.a)]`},
+				jsonpath:    `$.a..`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `..`},
 			},
 			{
-				jsonpath:    `$[?(@.a===1)]`,
-				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a===1)]`},
+				jsonpath:    `$..a.`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.`},
 			},
 			{
-				jsonpath:    `$[?(@.a=2)]`,
-				inputJSON:   `[{"a":2}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=2)]`},
+				jsonpath:    `$..a..`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `..`},
 			},
 			{
-				jsonpath:    `$[?(@.a<>2)]`,
-				inputJSON:   `[{"a":2}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a<>2)]`},
+				jsonpath:    `$...a`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `...a`},
 			},
+		},
+		`bracket-child-identifier`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a=<2)]`,
-				inputJSON:   `[{"a":2}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=<2)]`},
+				jsonpath:    `$['a]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a]`},
 			},
-		},
-		`qualifier::literal`: []TestCase{
 			{
-				jsonpath:     `$[?(false)]`,
-				inputJSON:    `[0,1,false,true,null,{},[]]`,
-				expectedJSON: `[]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(false)]`},
+				jsonpath:    `$["a]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `["a]`},
 			},
 			{
-				jsonpath:     `$[?(true)]`,
-				inputJSON:    `[0,1,false,true,null,{},[]]`,
-				expectedJSON: `[]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(true)]`},
+				jsonpath:    `$[a']`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a']`},
 			},
 			{
-				jsonpath:     `$[?(null)]`,
-				inputJSON:    `[0,1,false,true,null,{},[]]`,
-				expectedJSON: `[]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(null)]`},
+				jsonpath:    `$[a"]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a"]`},
 			},
 			{
-				jsonpath:    `$[?(@.a==["b"])]`,
-				inputJSON:   `[{"a":["b"]}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==["b"])]`},
+				jsonpath:    `$[a]`,
+				inputJSON:   `["first","second","third"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a]`},
 			},
 			{
-				jsonpath:    `$[?(@[0:1]==[1])]`,
-				inputJSON:   `[[1,2,3],[1],[2,3],1,2]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@[0:1]==[1])]`},
+				jsonpath:    `$.['a']`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.['a']`},
 			},
 			{
-				jsonpath:    `$[?(@.*==[1,2])]`,
-				inputJSON:   `[[1,2],[2,3],[1],[2],[1,2,3],1,2,3]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.*==[1,2])]`},
+				jsonpath:    `$.["a"]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.["a"]`},
 			},
 			{
-				jsonpath:    `$[?(@.*==['1','2'])]`,
-				inputJSON:   `[[1,2],[2,3],[1],[2],[1,2,3],1,2,3]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.*==['1','2'])]`},
+				jsonpath:    `$.[a]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `.[a]`},
 			},
 			{
-				jsonpath:    `$[?(@=={"k":"v"})]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@=={"k":"v"})]`},
+				jsonpath:    `$['a'.'b']`,
+				inputJSON:   `["a"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a'.'b']`},
 			},
 			{
-				jsonpath:     `$[?(@.a==fAlse)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==fAlse)]`},
+				jsonpath:    `$[a.b]`,
+				inputJSON:   `[{"a":{"b":1}}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[a.b]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==faLse)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==faLse)]`},
+				jsonpath:    `$['a'b']`,
+				inputJSON:   `["a"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a'b']`},
 			},
 			{
-				jsonpath:     `$[?(@.a==falSe)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==falSe)]`},
+				jsonpath:    `$['a\\'b']`,
+				inputJSON:   `["a"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['a\\'b']`},
 			},
 			{
-				jsonpath:     `$[?(@.a==falsE)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==falsE)]`},
+				jsonpath:    `$['ab\']`,
+				inputJSON:   `["a"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `['ab\']`},
 			},
+		},
+		`qualifier::index`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a==FaLse)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FaLse)]`},
+				jsonpath:    `$[0].[1]`,
+				inputJSON:   `[["a","b"],["c"],["d"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.[1]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==FalSe)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FalSe)]`},
+				jsonpath:    `$[0,1].[1]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==FalsE)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FalsE)]`},
+				jsonpath:    `$[0:2].[1]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1]`},
 			},
+		},
+		`qualifier::union`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a==FaLSE)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FaLSE)]`},
+				jsonpath:    `$[0].[1,2]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.[1,2]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==FAlSE)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FAlSE)]`},
+				jsonpath:    `$[0,1].[1,2]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1,2]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==FALsE)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FALsE)]`},
+				jsonpath:    `$[0:2].[1,2]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1,2]`},
 			},
+		},
+		`qualifier::slice`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a==FALSe)]`,
-				inputJSON:    `[{"a":false}]`,
-				expectedJSON: `[{"a":false}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FALSe)]`},
+				jsonpath:    `$[0].[1:3]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 4, reason: `unrecognized input`, near: `.[1:3]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==tRue)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==tRue)]`},
+				jsonpath:    `$[0,1].[1:3]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1:3]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==trUe)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==trUe)]`},
+				jsonpath:    `$[0:1].[1:3]`,
+				inputJSON:   `[["11","12","13"],["21","22","23"],["31","32","33"]]`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `.[1:3]`},
 			},
+		},
+		`qualifier::filter`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a==truE)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==truE)]`},
+				jsonpath:    `$[?(@.a),?(@.b)]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a),?(@.b)]`},
 			},
+		},
+		`qualifier::empty`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a==TrUe)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TrUe)]`},
+				jsonpath:    `$[]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==TruE)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TruE)]`},
+				jsonpath:    `$.a[]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `[]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==TrUE)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TrUE)]`},
+				jsonpath:    `$.a.b[]`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 5, reason: `unrecognized input`, near: `[]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==TRuE)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TRuE)]`},
+				jsonpath:    `$[]`,
+				inputJSON:   `["first","second","third"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==TRUe)]`,
-				inputJSON:    `[{"a":true}]`,
-				expectedJSON: `[{"a":true}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TRUe)]`},
+				jsonpath:    `$[?()]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?()]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==nUll)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==nUll)]`},
+				jsonpath:    `$[()]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[()]`},
 			},
+		},
+		`qualifier::brace`: []TestCase{
 			{
-				jsonpath:     `$[?(@.a==nuLl)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==nuLl)]`},
+				jsonpath:    `$()`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `()`},
 			},
 			{
-				jsonpath:     `$[?(@.a==nulL)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==nulL)]`},
+				jsonpath:    `$(a)`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `(a)`},
 			},
 			{
-				jsonpath:     `$[?(@.a==NuLl)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NuLl)]`},
+				jsonpath:    `$[`,
+				inputJSON:   `["first","second","third"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[`},
 			},
 			{
-				jsonpath:     `$[?(@.a==NulL)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NulL)]`},
+				jsonpath:    `$[(`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[(`},
 			},
 			{
-				jsonpath:     `$[?(@.a==NuLL)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NuLL)]`},
+				jsonpath:    `$[(]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[(]`},
 			},
 			{
-				jsonpath:     `$[?(@.a==NUlL)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NUlL)]`},
+				jsonpath:    `$[0`,
+				inputJSON:   `["first","second","third"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[0`},
 			},
 			{
-				jsonpath:     `$[?(@.a==NULl)]`,
-				inputJSON:    `[{"a":null}]`,
-				expectedJSON: `[{"a":null}]`,
-				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NULl)]`},
+				jsonpath:    `$[?@a]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?@a]`},
 			},
-		},
-		`qualifier::sub-expression`: []TestCase{
 			{
-				jsonpath:    `$[?((@.a<2)==false)]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a<2)==false)]`},
+				jsonpath:    `$[?(@.a=='abc`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=='abc`},
 			},
 			{
-				jsonpath:    `$[?((@.a<2)==true)]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a<2)==true)]`},
+				jsonpath:    `$[?(@.a=="abc`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=="abc`},
 			},
 			{
-				jsonpath:    `$[?((@.a<2)==1)]`,
+				jsonpath:    `$[?((@.a>1 )]`,
 				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a<2)==1)]`},
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a>1 )]`},
 			},
-		},
-		`qualifier::logical-operator`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a>1 && )]`,
+				jsonpath:    `$[?((@.a>1`,
 				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && )]`},
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a>1`},
 			},
+		},
+		`qualifier::big-number`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a>1 || )]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 || )]`},
+				jsonpath:    `$[0,10000000000000000000,]`,
+				inputJSON:   `["first","second","third"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[0,10000000000000000000,]`},
 			},
 			{
-				jsonpath:    `$[?( && @.a>1 )]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?( && @.a>1 )]`},
+				jsonpath:    `$[0:10000000000000000000:a]`,
+				inputJSON:   `["first","second","third"]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[0:10000000000000000000:a]`},
 			},
+		},
+		`qualifier::comparator`: []TestCase{
 			{
-				jsonpath:    `$[?( || @.a>1 )]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?( || @.a>1 )]`},
+				jsonpath:    `$[?(@.a!!=1)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a!!=1)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a>1 && false)]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && false)]`},
+				jsonpath:    `$[?(@.a==)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a>1 && true)]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && true)]`},
+				jsonpath:    `$[?(@.a!=)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a!=)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a>1 || false)]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 || false)]`},
+				jsonpath:    `$[?(@.a<=)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a<=)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a>1 || true)]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 || true)]`},
+				jsonpath:    `$[?(@.a<)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a<)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a>1 && ())]`,
-				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && ())]`},
+				jsonpath:    `$[?(@.a>=)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>=)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a & @.b)]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a & @.b)]`},
+				jsonpath:    `$[?(@.a>)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a | @.b)]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a | @.b)]`},
+				jsonpath:    `$[?(==@.a)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(==@.a)]`},
 			},
 			{
-				jsonpath:    `$[?(!(@.a==2))]`,
-				inputJSON:   `[{"a":1.9999},{"a":2},{"a":2.0001},{"a":"2"},{"a":true},{"a":{}},{"a":[]},{"a":["b"]},{"a":{"a":"value"}},{"b":"value"}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(!(@.a==2))]`},
+				jsonpath:    `$[?(!=@.a)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(!=@.a)]`},
 			},
 			{
-				jsonpath:    `$[?(!(@.a<2))]`,
-				inputJSON:   `[{"a":1.9999},{"a":2},{"a":2.0001},{"a":"2"},{"a":true},{"a":{}},{"a":[]},{"a":["b"]},{"a":{"a":"value"}},{"b":"value"}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(!(@.a<2))]`},
+				jsonpath:    `$[?(<=@.a)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(<=@.a)]`},
 			},
-		},
-		`qualifier::regular-expression`: []TestCase{
 			{
-				jsonpath:    `$[?(@.a=~/abc)]`,
+				jsonpath:    `$[?(<@.a)]`,
 				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~/abc)]`},
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(<@.a)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a=~///)]`,
+				jsonpath:    `$[?(>=@.a)]`,
 				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~///)]`},
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(>=@.a)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a=~s/a/b/)]`,
+				jsonpath:    `$[?(>@.a)]`,
 				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~s/a/b/)]`},
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(>@.a)]`},
 			},
 			{
-				jsonpath:    `$[?(@.a=~@abc@)]`,
+				jsonpath:    `$[?(@.a===1)]`,
 				inputJSON:   `[]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~@abc@)]`},
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a===1)]`},
 			},
 			{
-				jsonpath:    `$[?(a=~/123/)]`,
-				inputJSON:   `[{"a":"123"},{"a":123}]`,
-				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(a=~/123/)]`},
+				jsonpath:    `$[?(@.a=2)]`,
+				inputJSON:   `[{"a":2}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=2)]`},
 			},
-		},
-		`function`: []TestCase{
 			{
-				jsonpath:    `$.func(`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `(`},
+				jsonpath:    `$[?(@.a<>2)]`,
+				inputJSON:   `[{"a":2}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a<>2)]`},
 			},
 			{
-				jsonpath:    `$.func(a`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `(a`},
+				jsonpath:    `$[?(@.a=<2)]`,
+				inputJSON:   `[{"a":2}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=<2)]`},
 			},
+		},
+		`qualifier::literal`: []TestCase{
 			{
-				jsonpath:    `$.func(a)`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `(a)`},
+				jsonpath:     `$[?(false)]`,
+				inputJSON:    `[0,1,false,true,null,{},[]]`,
+				expectedJSON: `[]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(false)]`},
 			},
 			{
-				jsonpath:    `$.func()(`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorFunctionNotFound{function: `.func()`},
+				jsonpath:     `$[?(true)]`,
+				inputJSON:    `[0,1,false,true,null,{},[]]`,
+				expectedJSON: `[]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(true)]`},
 			},
 			{
-				jsonpath:    `$.func(){}`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorFunctionNotFound{function: `.func()`},
+				jsonpath:     `$[?(null)]`,
+				inputJSON:    `[0,1,false,true,null,{},[]]`,
+				expectedJSON: `[]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(null)]`},
 			},
-		},
-	}
-
-	execTestRetrieveTestGroups(t, testGroups)
-}
-
-func TestRetrieve_invalidArgument(t *testing.T) {
-	testGroups := TestGroup{
-		`number-overflow`: []TestCase{
 			{
-				jsonpath:  `$[10000000000000000000]`,
-				inputJSON: `["first","second","third"]`,
-				expectedErr: ErrorInvalidArgument{
-					argument: `10000000000000000000`,
-					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
-				},
+				jsonpath:    `$[?(@.a==["b"])]`,
+				inputJSON:   `[{"a":["b"]}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==["b"])]`},
 			},
 			{
-				jsonpath:  `$[0,10000000000000000000]`,
-				inputJSON: `["first","second","third"]`,
-				expectedErr: ErrorInvalidArgument{
-					argument: `10000000000000000000`,
-					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
-				},
+				jsonpath:    `$[?(@[0:1]==[1])]`,
+				inputJSON:   `[[1,2,3],[1],[2,3],1,2]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@[0:1]==[1])]`},
 			},
 			{
-				jsonpath:  `$[10000000000000000000:1]`,
-				inputJSON: `["first","second","third"]`,
-				expectedErr: ErrorInvalidArgument{
-					argument: `10000000000000000000`,
-					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
-				},
+				jsonpath:    `$[?(@.*==[1,2])]`,
+				inputJSON:   `[[1,2],[2,3],[1],[2],[1,2,3],1,2,3]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.*==[1,2])]`},
 			},
 			{
-				jsonpath:  `$[1:10000000000000000000]`,
-				inputJSON: `["first","second","third"]`,
-				expectedErr: ErrorInvalidArgument{
-					argument: `10000000000000000000`,
-					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
-				},
+				jsonpath:    `$[?(@.*==['1','2'])]`,
+				inputJSON:   `[[1,2],[2,3],[1],[2],[1,2,3],1,2,3]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.*==['1','2'])]`},
 			},
 			{
-				jsonpath:  `$[0:3:10000000000000000000]`,
-				inputJSON: `["first","second","third"]`,
-				expectedErr: ErrorInvalidArgument{
-					argument: `10000000000000000000`,
-					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
-				},
+				jsonpath:    `$[?(@=={"k":"v"})]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@=={"k":"v"})]`},
 			},
-		},
-		`number-syntax`: []TestCase{
 			{
-				jsonpath:  `$[?(@.a==1e1abc)]`,
-				inputJSON: `{}`,
-				expectedErr: ErrorInvalidArgument{
-					argument: `1e1abc`,
-					err:      fmt.Errorf(`strconv.ParseFloat: parsing "1e1abc": invalid syntax`),
-				},
+				jsonpath:     `$[?(@.a==fAlse)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==fAlse)]`},
 			},
-		},
-	}
-
-	execTestRetrieveTestGroups(t, testGroups)
-}
-
-func TestRetrieve_notSupported(t *testing.T) {
-	testGroups := TestGroup{
-		`Not supported`: []TestCase{
 			{
-				jsonpath:    `$[(command)]`,
-				inputJSON:   `{}`,
-				expectedErr: ErrorNotSupported{feature: `script`, path: `[(command)]`},
+				jsonpath:     `$[?(@.a==faLse)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==faLse)]`},
 			},
-		},
-	}
-
-	execTestRetrieveTestGroups(t, testGroups)
-}
-
-var useJSONNumberDecoderFunction = func(srcJSON string, src *interface{}) error {
-	reader := strings.NewReader(srcJSON)
-	decoder := json.NewDecoder(reader)
-	decoder.UseNumber()
-	return decoder.Decode(src)
-}
-
-func TestRetrieve_jsonNumber(t *testing.T) {
-	testGroups := TestGroup{
-		`filter`: []TestCase{
 			{
-				jsonpath:      `$[?(@.a > 123)].a`,
-				inputJSON:     `[{"a":123.456}]`,
-				expectedJSON:  `[123.456]`,
-				unmarshalFunc: useJSONNumberDecoderFunction,
+				jsonpath:     `$[?(@.a==falSe)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==falSe)]`},
 			},
 			{
-				jsonpath:      `$[?(@.a > 123.46)].a`,
-				inputJSON:     `[{"a":123.456}]`,
-				expectedJSON:  `[]`,
-				expectedErr:   createErrorMemberNotExist(`[?(@.a > 123.46)]`),
-				unmarshalFunc: useJSONNumberDecoderFunction,
+				jsonpath:     `$[?(@.a==falsE)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==falsE)]`},
 			},
 			{
-				jsonpath:      `$[?(@.a > 122)].a`,
-				inputJSON:     `[{"a":123}]`,
-				expectedJSON:  `[123]`,
-				unmarshalFunc: useJSONNumberDecoderFunction,
+				jsonpath:     `$[?(@.a==FaLse)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FaLse)]`},
 			},
 			{
-				jsonpath:      `$[?(123 < @.a)].a`,
-				inputJSON:     `[{"a":123.456}]`,
-				expectedJSON:  `[123.456]`,
-				unmarshalFunc: useJSONNumberDecoderFunction,
+				jsonpath:     `$[?(@.a==FalSe)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FalSe)]`},
 			},
 			{
-				jsonpath:      `$[?(@.a==-0.123e2)]`,
-				inputJSON:     `[{"a":-12.3,"b":1},{"a":-0.123e2,"b":2},{"a":-0.123},{"a":-12},{"a":12.3},{"a":2},{"a":"-0.123e2"}]`,
-				expectedJSON:  `[{"a":-12.3,"b":1},{"a":-0.123e2,"b":2}]`,
-				unmarshalFunc: useJSONNumberDecoderFunction,
+				jsonpath:     `$[?(@.a==FalsE)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FalsE)]`},
 			},
 			{
-				jsonpath:      `$[?(@.a==11)]`,
-				inputJSON:     `[{"a":10.999},{"a":11.00},{"a":11.10}]`,
-				expectedJSON:  `[{"a":11.00}]`,
-				unmarshalFunc: useJSONNumberDecoderFunction,
+				jsonpath:     `$[?(@.a==FaLSE)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FaLSE)]`},
 			},
-		},
-	}
-
-	execTestRetrieveTestGroups(t, testGroups)
-}
-
-var twiceFunc = func(param interface{}) (interface{}, error) {
-	if input, ok := param.(float64); ok {
-		return input * 2, nil
-	}
-	return nil, fmt.Errorf(`type error`)
-}
-var quarterFunc = func(param interface{}) (interface{}, error) {
-	if input, ok := param.(float64); ok {
-		return input / 4, nil
-	}
-	return nil, fmt.Errorf(`type error`)
-}
-var maxFunc = func(param []interface{}) (interface{}, error) {
-	var result float64
-	for _, value := range param {
-		if result < value.(float64) {
-			result = value.(float64)
-		}
-	}
-	return result, nil
-}
-var minFunc = func(param []interface{}) (interface{}, error) {
-	var result float64 = 999
-	for _, value := range param {
-		if result > value.(float64) {
-			result = value.(float64)
-		}
-	}
-	return result, nil
-}
-var errAggregateFunc = func(param []interface{}) (interface{}, error) {
-	return nil, fmt.Errorf(`aggregate error`)
-}
-var errFilterFunc = func(param interface{}) (interface{}, error) {
-	return nil, fmt.Errorf(`filter error`)
-}
-
-func TestRetrieve_configFunction(t *testing.T) {
-	testGroups := TestGroup{
-		`filter-function`: []TestCase{
 			{
-				jsonpath:     `$.*.twice()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[246.912,512]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
+				jsonpath:     `$[?(@.a==FAlSE)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FAlSE)]`},
 			},
 			{
-				jsonpath:     `$.*.twice().twice()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[493.824,1024]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
+				jsonpath:     `$[?(@.a==FALsE)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FALsE)]`},
 			},
 			{
-				jsonpath:     `$.*.twice().quarter()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[61.728,128]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`:   twiceFunc,
-					`quarter`: quarterFunc,
-				},
+				jsonpath:     `$[?(@.a==FALSe)]`,
+				inputJSON:    `[{"a":false}]`,
+				expectedJSON: `[{"a":false}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==FALSe)]`},
 			},
 			{
-				jsonpath:     `$.*.quarter().twice()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[61.728,128]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`:   twiceFunc,
-					`quarter`: quarterFunc,
-				},
+				jsonpath:     `$[?(@.a==tRue)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==tRue)]`},
 			},
 			{
-				jsonpath:     `$[?(@.twice())]`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[123.456,256]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
+				jsonpath:     `$[?(@.a==trUe)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==trUe)]`},
 			},
 			{
-				jsonpath:     `$[?(@.twice() == 512)]`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[256]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
+				jsonpath:     `$[?(@.a==truE)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==truE)]`},
 			},
 			{
-				jsonpath:     `$[?(512 != @.twice())]`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
+				jsonpath:     `$[?(@.a==TrUe)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TrUe)]`},
 			},
 			{
-				jsonpath:     `$[?(@.twice() == $[0].twice())]`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
+				jsonpath:     `$[?(@.a==TruE)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TruE)]`},
 			},
-		},
-		`aggregate-function`: []TestCase{
 			{
-				jsonpath:     `$.*.max()`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==TrUE)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TrUE)]`},
 			},
 			{
-				jsonpath:     `$.*.max().max()`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==TRuE)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TRuE)]`},
 			},
 			{
-				jsonpath:     `$.*.max().min()`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-					`min`: minFunc,
-				},
+				jsonpath:     `$[?(@.a==TRUe)]`,
+				inputJSON:    `[{"a":true}]`,
+				expectedJSON: `[{"a":true}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==TRUe)]`},
 			},
 			{
-				jsonpath:     `$.*.min().max()`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[122.345]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-					`min`: minFunc,
-				},
+				jsonpath:     `$[?(@.a==nUll)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==nUll)]`},
 			},
 			{
-				jsonpath:     `$[?(@.max())]`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[122.345,123.45,123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==nuLl)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==nuLl)]`},
 			},
 			{
-				jsonpath:     `$[?(@.max() == 123.45)]`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[123.45]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==nulL)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==nulL)]`},
 			},
 			{
-				jsonpath:     `$[?(123.45 != @.max())]`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[122.345,123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==NuLl)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NuLl)]`},
 			},
 			{
-				jsonpath:     `$[?(@.max() != 123.45)]`,
-				inputJSON:    `[[122.345,123.45,123.456],[122.345,123.45]]`,
-				expectedJSON: `[[122.345,123.45,123.456]]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==NulL)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NulL)]`},
 			},
 			{
-				jsonpath:     `$[?(@.max() == $[1].max())]`,
-				inputJSON:    `[[122.345,123.45,123.456],[122.345,123.45]]`,
-				expectedJSON: `[[122.345,123.45]]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==NuLL)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NuLL)]`},
 			},
-		},
-		`aggregate-filter-mix`: []TestCase{
 			{
-				jsonpath:     `$.*.max().twice()`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[246.912]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==NUlL)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NUlL)]`},
 			},
 			{
-				jsonpath:     `$.*.twice().max()`,
-				inputJSON:    `[122.345,123.45,123.456]`,
-				expectedJSON: `[246.912]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
+				jsonpath:     `$[?(@.a==NULl)]`,
+				inputJSON:    `[{"a":null}]`,
+				expectedJSON: `[{"a":null}]`,
+				expectedErr:  ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a==NULl)]`},
 			},
 		},
-		`filter-error`: []TestCase{
+		`qualifier::sub-expression`: []TestCase{
 			{
-				jsonpath:  `$.errFilter()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-				},
-
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+				jsonpath:    `$[?((@.a<2)==false)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a<2)==false)]`},
 			},
 			{
-				jsonpath:  `$.*.errFilter()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-				},
-
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+				jsonpath:    `$[?((@.a<2)==true)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a<2)==true)]`},
 			},
 			{
-				jsonpath:  `$.*.max().errFilter()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+				jsonpath:    `$[?((@.a<2)==1)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?((@.a<2)==1)]`},
 			},
+		},
+		`qualifier::logical-operator`: []TestCase{
 			{
-				jsonpath:  `$.*.twice().errFilter()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-					`twice`:     twiceFunc,
-				},
-
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
-			}, {
-				jsonpath:  `$.errFilter().twice()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-					`twice`:     twiceFunc,
-				},
-
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+				jsonpath:    `$[?(@.a>1 && )]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && )]`},
 			},
 			{
-				jsonpath:  `$.*.errFilter().twice()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-					`twice`:     twiceFunc,
-				},
-
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+				jsonpath:    `$[?(@.a>1 || )]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 || )]`},
 			},
 			{
-				jsonpath:  `$.*.max().errFilter().twice()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-					`twice`:     twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+				jsonpath:    `$[?( && @.a>1 )]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?( && @.a>1 )]`},
 			},
-		},
-		`aggregate-error`: []TestCase{
 			{
-				jsonpath:  `$.*.errAggregate()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+				jsonpath:    `$[?( || @.a>1 )]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?( || @.a>1 )]`},
 			},
 			{
-				jsonpath:  `$.*.max().errAggregate()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-					`max`:          maxFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+				jsonpath:    `$[?(@.a>1 && false)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && false)]`},
 			},
 			{
-				jsonpath:  `$.*.twice().errAggregate()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+				jsonpath:    `$[?(@.a>1 && true)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && true)]`},
 			},
 			{
-				jsonpath:  `$.*.errAggregate().twice()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+				jsonpath:    `$[?(@.a>1 || false)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 || false)]`},
 			},
 			{
-				jsonpath:  `$.*.max().errAggregate().twice()`,
-				inputJSON: `[122.345,123.45,123.456]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`twice`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-					`max`:          maxFunc,
-				},
-				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+				jsonpath:    `$[?(@.a>1 || true)]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 || true)]`},
 			},
 			{
-				jsonpath:  `$.a.max()`,
-				inputJSON: `{}`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`max`: maxFunc,
-				},
-				expectedErr: createErrorMemberNotExist(`.a`),
+				jsonpath:    `$[?(@.a>1 && ())]`,
+				inputJSON:   `[{"a":1},{"a":2},{"a":3}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a>1 && ())]`},
 			},
-		},
-		`jsonpath-error-with-filter`: []TestCase{
 			{
-				jsonpath:  `$.x.*.errAggregate()`,
-				inputJSON: `{"a":[122.345,123.45,123.456]}`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorMemberNotExist(`.x`),
+				jsonpath:    `$[?(@.a & @.b)]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a & @.b)]`},
 			},
 			{
-				jsonpath:  `$.*.a.b.c.errFilter()`,
-				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: errFilterFunc,
-				},
-
-				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+				jsonpath:    `$[?(@.a | @.b)]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a | @.b)]`},
 			},
 			{
-				jsonpath:  `$.*.a.b.c.errFilter1().errFilter2()`,
-				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter1`: errFilterFunc,
-					`errFilter2`: errFilterFunc,
-				},
-
-				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+				jsonpath:    `$[?(!(@.a==2))]`,
+				inputJSON:   `[{"a":1.9999},{"a":2},{"a":2.0001},{"a":"2"},{"a":true},{"a":{}},{"a":[]},{"a":["b"]},{"a":{"a":"value"}},{"b":"value"}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(!(@.a==2))]`},
 			},
 			{
-				jsonpath:  `$.*.a.b.c.errAggregate()`,
-				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+				jsonpath:    `$[?(!(@.a<2))]`,
+				inputJSON:   `[{"a":1.9999},{"a":2},{"a":2.0001},{"a":"2"},{"a":true},{"a":{}},{"a":[]},{"a":["b"]},{"a":{"a":"value"}},{"b":"value"}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(!(@.a<2))]`},
 			},
+		},
+		`qualifier::regular-expression`: []TestCase{
 			{
-				jsonpath:  `$.*.a.b.c.errAggregate1().errAggregate2()`,
-				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate1`: errAggregateFunc,
-					`errAggregate2`: errAggregateFunc,
-				},
-				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+				jsonpath:    `$[?(@.a=~/abc)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~/abc)]`},
 			},
 			{
-				jsonpath:  `$.*.a.b.c.errAggregate().errFilter()`,
-				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+				jsonpath:    `$[?(@.a=~///)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~///)]`},
 			},
 			{
-				jsonpath:  `$.*.a.b.c.errFilter().errAggregate()`,
-				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`errFilter`: twiceFunc,
-				},
-				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`errAggregate`: errAggregateFunc,
-				},
-				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+				jsonpath:    `$[?(@.a=~s/a/b/)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~s/a/b/)]`},
+			},
+			{
+				jsonpath:    `$[?(@.a=~@abc@)]`,
+				inputJSON:   `[]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(@.a=~@abc@)]`},
+			},
+			{
+				jsonpath:    `$[?(a=~/123/)]`,
+				inputJSON:   `[{"a":"123"},{"a":123}]`,
+				expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[?(a=~/123/)]`},
 			},
 		},
-		`function-syntax-check`: []TestCase{
+		`function`: []TestCase{
 			{
-				jsonpath:     `$.*.TWICE()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[246.912,512]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`TWICE`: twiceFunc,
+				jsonpath:    `$.func(`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `(`},
+			},
+			{
+				jsonpath:    `$.func(a`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `(a`},
+			},
+			{
+				jsonpath:    `$.func(a)`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorInvalidSyntax{position: 6, reason: `unrecognized input`, near: `(a)`},
+			},
+			{
+				jsonpath:    `$.func()(`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorFunctionNotFound{function: `.func()`},
+			},
+			{
+				jsonpath:    `$.func(){}`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorFunctionNotFound{function: `.func()`},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_invalidArgument(t *testing.T) {
+	testGroups := TestGroup{
+		`number-overflow`: []TestCase{
+			{
+				jsonpath:  `$[10000000000000000000]`,
+				inputJSON: `["first","second","third"]`,
+				expectedErr: ErrorInvalidArgument{
+					argument: `10000000000000000000`,
+					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
 				},
 			},
 			{
-				jsonpath:     `$.*.123()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[246.912,512]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`123`: twiceFunc,
+				jsonpath:  `$[0,10000000000000000000]`,
+				inputJSON: `["first","second","third"]`,
+				expectedErr: ErrorInvalidArgument{
+					argument: `10000000000000000000`,
+					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
 				},
 			},
 			{
-				jsonpath:     `$.*.--()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[246.912,512]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`--`: twiceFunc,
+				jsonpath:  `$[10000000000000000000:1]`,
+				inputJSON: `["first","second","third"]`,
+				expectedErr: ErrorInvalidArgument{
+					argument: `10000000000000000000`,
+					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
 				},
 			},
 			{
-				jsonpath:     `$.*.__()`,
-				inputJSON:    `[123.456,256]`,
-				expectedJSON: `[246.912,512]`,
-				filters: map[string]func(interface{}) (interface{}, error){
-					`__`: twiceFunc,
+				jsonpath:  `$[1:10000000000000000000]`,
+				inputJSON: `["first","second","third"]`,
+				expectedErr: ErrorInvalidArgument{
+					argument: `10000000000000000000`,
+					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
 				},
 			},
 			{
-				jsonpath:    `$.*.unknown()`,
-				inputJSON:   `[123.456,256]`,
-				expectedErr: ErrorFunctionNotFound{function: `.unknown()`},
+				jsonpath:  `$[0:3:10000000000000000000]`,
+				inputJSON: `["first","second","third"]`,
+				expectedErr: ErrorInvalidArgument{
+					argument: `10000000000000000000`,
+					err:      fmt.Errorf(`strconv.Atoi: parsing "10000000000000000000": value out of range`),
+				},
+			},
+		},
+		`number-syntax`: []TestCase{
+			{
+				jsonpath:  `$[?(@.a==1e1abc)]`,
+				inputJSON: `{}`,
+				expectedErr: ErrorInvalidArgument{
+					argument: `1e1abc`,
+					err:      fmt.Errorf(`strconv.ParseFloat: parsing "1e1abc": invalid syntax`),
+				},
 			},
 		},
 	}
@@ -6797,485 +6781,4395 @@ func TestRetrieve_configFunction(t *testing.T) {
 	execTestRetrieveTestGroups(t, testGroups)
 }
 
-func createAccessorModeValidator(
-	resultIndex int,
-	expectedValue1, expectedValue2, expectedValue3 interface{},
-	srcGetter func(interface{}) interface{},
-	srcSetter func(interface{}, interface{})) func(interface{}, []interface{}) error {
-	return func(src interface{}, actualObject []interface{}) error {
-		accessor := actualObject[resultIndex].(Accessor)
-
-		getValue := accessor.Get()
-		if getValue != expectedValue1 {
-			return fmt.Errorf(`Get : expect<%f> != actual<%f>`, expectedValue1, getValue)
-		}
-
-		accessor.Set(expectedValue2)
-
-		newSrcValue := srcGetter(src)
+func TestRetrieve_notSupported(t *testing.T) {
+	testGroups := TestGroup{
+		`Not supported`: []TestCase{
+			{
+				jsonpath:    `$[(command)]`,
+				inputJSON:   `{}`,
+				expectedErr: ErrorNotSupported{feature: `script`, path: `[(command)]`},
+			},
+		},
+	}
 
-		if newSrcValue != expectedValue2 {
-			return fmt.Errorf(`Set : expect<%f> != actual<%f>`, expectedValue2, newSrcValue)
-		}
+	execTestRetrieveTestGroups(t, testGroups)
+}
 
-		getValue = accessor.Get()
-		if getValue != expectedValue2 {
-			return fmt.Errorf(`Set -> Get : expect<%f> != actual<%f>`, expectedValue2, getValue)
-		}
+var useJSONNumberDecoderFunction = func(srcJSON string, src *interface{}) error {
+	reader := strings.NewReader(srcJSON)
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	return decoder.Decode(src)
+}
 
-		srcSetter(src, expectedValue3)
+func TestRetrieve_jsonNumber(t *testing.T) {
+	testGroups := TestGroup{
+		`filter`: []TestCase{
+			{
+				jsonpath:      `$[?(@.a > 123)].a`,
+				inputJSON:     `[{"a":123.456}]`,
+				expectedJSON:  `[123.456]`,
+				unmarshalFunc: useJSONNumberDecoderFunction,
+			},
+			{
+				jsonpath:      `$[?(@.a > 123.46)].a`,
+				inputJSON:     `[{"a":123.456}]`,
+				expectedJSON:  `[]`,
+				expectedErr:   createErrorMemberNotExist(`[?(@.a > 123.46)]`),
+				unmarshalFunc: useJSONNumberDecoderFunction,
+			},
+			{
+				jsonpath:      `$[?(@.a > 122)].a`,
+				inputJSON:     `[{"a":123}]`,
+				expectedJSON:  `[123]`,
+				unmarshalFunc: useJSONNumberDecoderFunction,
+			},
+			{
+				jsonpath:      `$[?(123 < @.a)].a`,
+				inputJSON:     `[{"a":123.456}]`,
+				expectedJSON:  `[123.456]`,
+				unmarshalFunc: useJSONNumberDecoderFunction,
+			},
+			{
+				jsonpath:      `$[?(@.a==-0.123e2)]`,
+				inputJSON:     `[{"a":-12.3,"b":1},{"a":-0.123e2,"b":2},{"a":-0.123},{"a":-12},{"a":12.3},{"a":2},{"a":"-0.123e2"}]`,
+				expectedJSON:  `[{"a":-12.3,"b":1},{"a":-0.123e2,"b":2}]`,
+				unmarshalFunc: useJSONNumberDecoderFunction,
+			},
+			{
+				jsonpath:      `$[?(@.a==11)]`,
+				inputJSON:     `[{"a":10.999},{"a":11.00},{"a":11.10}]`,
+				expectedJSON:  `[{"a":11.00}]`,
+				unmarshalFunc: useJSONNumberDecoderFunction,
+			},
+		},
+	}
 
-		getValue = accessor.Get()
-		if getValue != expectedValue3 {
-			return fmt.Errorf(`Src -> Get : expect<%f> != actual<%f>`, expectedValue3, getValue)
-		}
+	execTestRetrieveTestGroups(t, testGroups)
+}
 
-		return nil
+var twiceFunc = func(param interface{}) (interface{}, error) {
+	if input, ok := param.(float64); ok {
+		return input * 2, nil
 	}
+	return nil, fmt.Errorf(`type error`)
 }
-
-var getOnlyValidator = func(src interface{}, actualObject []interface{}) error {
-	accessor := actualObject[0].(Accessor)
-
-	if accessor.Set != nil {
-		return fmt.Errorf(`Set != nil`)
-	}
-
-	if !reflect.DeepEqual(accessor.Get(), src) {
-		return fmt.Errorf(`Get != src`)
+var quarterFunc = func(param interface{}) (interface{}, error) {
+	if input, ok := param.(float64); ok {
+		return input / 4, nil
 	}
-
-	return nil
-}
-
-var echoAggregateFunc = func(param []interface{}) (interface{}, error) {
-	return param, nil
+	return nil, fmt.Errorf(`type error`)
 }
-
-var sliceStructChangedResultValidator = func(src interface{}, actualObject []interface{}) error {
-	srcArray := src.([]interface{})
-	accessor := actualObject[0].(Accessor)
-
-	accessor.Set(4) // srcArray:[1,4,3] , accessor:[1,4,3]
-	if len(srcArray) != 3 || srcArray[1] != 4 {
-		return fmt.Errorf(`Set -> Src : expect<%d> != actual<%d>`, 4, srcArray[1])
-	}
-	srcArray = append(srcArray[:1], srcArray[2:]...) // srcArray:[1,3] , accessor:[1,3,3]
-	if len(srcArray) != 2 || accessor.Get() != 3.0 { // Go's marshal returns float value
-		return fmt.Errorf(`Del -> Get : expect<%f> != actual<%f>`, 3.0, accessor.Get())
-	}
-	accessor.Set(5) // srcArray:[1,5] , accessor:[1,5,3]
-	if len(srcArray) != 2 || srcArray[1] != 5 {
-		return fmt.Errorf(`Del -> Set -> Src : expect<%d> != actual<%d>`, 5, srcArray[1])
-	}
-	srcArray = append(srcArray[:1], srcArray[2:]...) // srcArray:[1] , accessor:[1,5,3]
-	if len(srcArray) != 1 || accessor.Get() != 5 {
-		return fmt.Errorf(`Delx2 -> Get : expect<%d> != actual<%d>`, 5, accessor.Get())
-	}
-	accessor.Set(6) // srcArray:[1] , accessor:[1,6,3]
-	if len(srcArray) != 1 {
-		return fmt.Errorf(`Delx2 -> Set -> Len : expect<%d> != actual<%d>`, 1, len(srcArray))
-	}
-	srcArray = append(srcArray, 7) // srcArray:[1,7] , accessor:[1,7,3]
-	if len(srcArray) != 2 || accessor.Get() != 7 {
-		return fmt.Errorf(`Delx2 -> Add -> Get : expect<%d> != actual<%d>`, 7, accessor.Get())
-	}
-	srcArray = append(srcArray, 8) // srcArray:[1,7,8]    , accessor:[1,7,8]
-	srcArray = append(srcArray, 9) // srcArray:[1,7,8,9]  , accessor:[1,7,8,9]
-	srcArray[1] = 10               // srcArray:[1,10,8,9] , accessor:[1,10,8,9]
-	if len(srcArray) != 4 || accessor.Get() != 10 {
-		return fmt.Errorf(`Delx2 -> Addx3 -> Update -> Get : expect<%d> != actual<%d>`, 10, accessor.Get())
+var maxFunc = func(param []interface{}) (interface{}, error) {
+	var result float64
+	for _, value := range param {
+		if result < value.(float64) {
+			result = value.(float64)
+		}
 	}
-	return nil
+	return result, nil
 }
-
-var mapStructChangedResultValidator = func(src interface{}, actualObject []interface{}) error {
-	srcMap := src.(map[string]interface{})
-	accessor := actualObject[0].(Accessor)
-
-	accessor.Set(2) // srcMap:{"a":2} , accessor:{"a":2}
-	if len(srcMap) != 1 || srcMap[`a`] != 2 {
-		return fmt.Errorf(`Set -> Src : expect<%d> != actual<%d>`, 2, srcMap[`a`])
-	}
-	delete(srcMap, `a`) // srcMap:{} , accessor:{}
-	if accessor.Get() != nil {
-		return fmt.Errorf(`Del -> Get : expect<%v> != actual<%d>`, nil, accessor.Get())
-	}
-	accessor.Set(3) // srcMap:{"a":3} , accessor:{"a":3}
-	if len(srcMap) != 1 || srcMap[`a`] != 3 {
-		return fmt.Errorf(`Del -> Set -> Len : expect<%d> != actual<%d>`, 0, len(srcMap))
-	}
-	delete(srcMap, `a`) // srcMap:{} , accessor:{}
-	srcMap[`a`] = 4     // srcMap:{"a":4} , accessor:{"a":4}
-	if accessor.Get() != 4 {
-		return fmt.Errorf(`Del -> Update -> Get : expect<%v> != actual<%d>`, 4, accessor.Get())
+var minFunc = func(param []interface{}) (interface{}, error) {
+	var result float64 = 999
+	for _, value := range param {
+		if result > value.(float64) {
+			result = value.(float64)
+		}
 	}
-	return nil
+	return result, nil
+}
+var errAggregateFunc = func(param []interface{}) (interface{}, error) {
+	return nil, fmt.Errorf(`aggregate error`)
+}
+var errFilterFunc = func(param interface{}) (interface{}, error) {
+	return nil, fmt.Errorf(`filter error`)
 }
 
-func TestRetrieve_configAccessorMode(t *testing.T) {
+func TestRetrieve_configFunction(t *testing.T) {
 	testGroups := TestGroup{
-		`getter-setter`: []TestCase{
+		`filter-function`: []TestCase{
 			{
-				jsonpath:     `$.b`,
-				inputJSON:    `{"a":11,"b":22,"c":33}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 22.0, 33.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`b`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`b`] = value
-					}),
+				jsonpath:     `$.*.twice()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[246.912,512]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 			},
 			{
-				jsonpath:     `$['b']`,
-				inputJSON:    `{"a":123,"b":456,"c":789}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 456.0, 246.0, 369.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`b`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`b`] = value
-					}),
+				jsonpath:     `$.*.twice().twice()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[493.824,1024]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 			},
 			{
-				jsonpath:     `b`,
-				inputJSON:    `{"a":11,"b":22,"c":33}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 22.0, 33.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`b`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`b`] = value
-					}),
+				jsonpath:     `$.*.twice().quarter()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[61.728,128]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`:   twiceFunc,
+					`quarter`: quarterFunc,
+				},
 			},
 			{
-				jsonpath:     `$['a','b','c']`,
-				inputJSON:    `{"a":11,"b":22}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 22.0, 44.0, 55.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`b`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`b`] = value
-					}),
+				jsonpath:     `$.*.quarter().twice()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[61.728,128]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`:   twiceFunc,
+					`quarter`: quarterFunc,
+				},
 			},
 			{
-				jsonpath:     `$.*`,
-				inputJSON:    `{"a":11,"b":22,"c":33}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 22.0, 33.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`b`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`b`] = value
-					}),
+				jsonpath:     `$[?(@.twice())]`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[123.456,256]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 			},
 			{
-				jsonpath:     `$.*`,
-				inputJSON:    `[11,22,33]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 22.0, 33.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[1]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[1] = value
-					}),
+				jsonpath:     `$[?(@.twice() == 512)]`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[256]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 			},
 			{
-				jsonpath:     `$..a`,
-				inputJSON:    `{"b":{"a":11}, "c":66, "a":77}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 11.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`b`].(map[string]interface{})[`a`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`b`].(map[string]interface{})[`a`] = value
-					}),
+				jsonpath:     `$[?(512 != @.twice())]`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 			},
 			{
-				jsonpath:     `$[1]`,
-				inputJSON:    `[123.456,256,789]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 256.0, 512.0, 1024.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[1]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[1] = value
-					}),
+				jsonpath:     `$[?(@.twice() == $[0].twice())]`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 			},
+		},
+		`aggregate-function`: []TestCase{
 			{
-				jsonpath:     `$[2,1]`,
-				inputJSON:    `[123.456,256,789]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 256.0, 512.0, 1024.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[1]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[1] = value
-					}),
+				jsonpath:     `$.*.max()`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 			{
-				jsonpath:     `$[0:2]`,
-				inputJSON:    `[11,22,33,44]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 11.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[0]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[0] = value
-					}),
+				jsonpath:     `$.*.max().max()`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 			{
-				jsonpath:     `$[*]`,
-				inputJSON:    `[11,22]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 11.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[0]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[0] = value
-					}),
+				jsonpath:     `$.*.max().min()`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+					`min`: minFunc,
+				},
 			},
 			{
-				jsonpath:     `$[0:2].a`,
-				inputJSON:    `[{"a":11},{"a":22},{"a":33}]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 11.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[0].(map[string]interface{})[`a`]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[0].(map[string]interface{})[`a`] = value
-					}),
+				jsonpath:     `$.*.min().max()`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[122.345]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+					`min`: minFunc,
+				},
 			},
 			{
-				jsonpath:     `$[?(@==11||@==33)]`,
-				inputJSON:    `{"a":11,"b":22,"c":33}`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 33.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.(map[string]interface{})[`c`]
-					},
-					func(src, value interface{}) {
-						src.(map[string]interface{})[`c`] = value
-					}),
+				jsonpath:     `$[?(@.max())]`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[122.345,123.45,123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 			{
-				jsonpath:     `$[?(@==11||@==33)]`,
-				inputJSON:    `[11,22,33]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 33.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[2]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[2] = value
-					}),
+				jsonpath:     `$[?(@.max() == 123.45)]`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[123.45]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 			{
-				jsonpath:     `$[?(@.a==11||@.a==33)].a`,
-				inputJSON:    `[{"a":11},{"a":22},{"a":33}]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					1, 33.0, 22.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[2].(map[string]interface{})[`a`]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[2].(map[string]interface{})[`a`] = value
-					}),
+				jsonpath:     `$[?(123.45 != @.max())]`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[122.345,123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 			{
-				jsonpath:     `$[?(@==$[1])]`,
-				inputJSON:    `[11,22,33]`,
-				accessorMode: true,
-				resultValidator: createAccessorModeValidator(
-					0, 22.0, 33.0, 44.0,
-					func(src interface{}) interface{} {
-						return src.([]interface{})[1]
-					},
-					func(src, value interface{}) {
-						src.([]interface{})[1] = value
-					}),
+				jsonpath:     `$[?(@.max() != 123.45)]`,
+				inputJSON:    `[[122.345,123.45,123.456],[122.345,123.45]]`,
+				expectedJSON: `[[122.345,123.45,123.456]]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
+			},
+			{
+				jsonpath:     `$[?(@.max() == $[1].max())]`,
+				inputJSON:    `[[122.345,123.45,123.456],[122.345,123.45]]`,
+				expectedJSON: `[[122.345,123.45]]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 		},
-		`get-only`: []TestCase{
+		`aggregate-filter-mix`: []TestCase{
 			{
-				jsonpath:        `$`,
-				inputJSON:       `[1,2,3]`,
-				accessorMode:    true,
-				resultValidator: getOnlyValidator,
+				jsonpath:     `$.*.max().twice()`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				expectedJSON: `[246.912]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
 			},
 			{
-				jsonpath:     `$.echo()`,
+				jsonpath:     `$.*.twice().max()`,
 				inputJSON:    `[122.345,123.45,123.456]`,
-				accessorMode: true,
+				expectedJSON: `[246.912]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
 				aggregates: map[string]func([]interface{}) (interface{}, error){
-					`echo`: echoAggregateFunc,
+					`max`: maxFunc,
 				},
-				resultValidator: getOnlyValidator,
 			},
 		},
-		`convert-srcJSON`: []TestCase{
-			{
-				jsonpath:        `$[1]`,
-				inputJSON:       `[1,2,3]`,
-				accessorMode:    true,
-				resultValidator: sliceStructChangedResultValidator,
-			},
-			{
-				jsonpath:        `$.a`,
-				inputJSON:       `{"a":1}`,
-				accessorMode:    true,
-				resultValidator: mapStructChangedResultValidator,
-			},
+		`filter-error`: []TestCase{
+			{
+				jsonpath:  `$.errFilter()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+				},
+
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			},
+			{
+				jsonpath:  `$.*.errFilter()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+				},
+
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			},
+			{
+				jsonpath:  `$.*.max().errFilter()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			},
+			{
+				jsonpath:  `$.*.twice().errFilter()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+					`twice`:     twiceFunc,
+				},
+
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			}, {
+				jsonpath:  `$.errFilter().twice()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+					`twice`:     twiceFunc,
+				},
+
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			},
+			{
+				jsonpath:  `$.*.errFilter().twice()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+					`twice`:     twiceFunc,
+				},
+
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			},
+			{
+				jsonpath:  `$.*.max().errFilter().twice()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+					`twice`:     twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errFilter()`, `filter error`),
+			},
+		},
+		`aggregate-error`: []TestCase{
+			{
+				jsonpath:  `$.*.errAggregate()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+			},
+			{
+				jsonpath:  `$.*.max().errAggregate()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+					`max`:          maxFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+			},
+			{
+				jsonpath:  `$.*.twice().errAggregate()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+			},
+			{
+				jsonpath:  `$.*.errAggregate().twice()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+			},
+			{
+				jsonpath:  `$.*.max().errAggregate().twice()`,
+				inputJSON: `[122.345,123.45,123.456]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+					`max`:          maxFunc,
+				},
+				expectedErr: createErrorFunctionFailed(`.errAggregate()`, `aggregate error`),
+			},
+			{
+				jsonpath:  `$.a.max()`,
+				inputJSON: `{}`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
+				expectedErr: createErrorMemberNotExist(`.a`),
+			},
+		},
+		`jsonpath-error-with-filter`: []TestCase{
+			{
+				jsonpath:  `$.x.*.errAggregate()`,
+				inputJSON: `{"a":[122.345,123.45,123.456]}`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorMemberNotExist(`.x`),
+			},
+			{
+				jsonpath:  `$.*.a.b.c.errFilter()`,
+				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: errFilterFunc,
+				},
+
+				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+			},
+			{
+				jsonpath:  `$.*.a.b.c.errFilter1().errFilter2()`,
+				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter1`: errFilterFunc,
+					`errFilter2`: errFilterFunc,
+				},
+
+				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+			},
+			{
+				jsonpath:  `$.*.a.b.c.errAggregate()`,
+				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+			},
+			{
+				jsonpath:  `$.*.a.b.c.errAggregate1().errAggregate2()`,
+				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate1`: errAggregateFunc,
+					`errAggregate2`: errAggregateFunc,
+				},
+				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+			},
+			{
+				jsonpath:  `$.*.a.b.c.errAggregate().errFilter()`,
+				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+			},
+			{
+				jsonpath:  `$.*.a.b.c.errFilter().errAggregate()`,
+				inputJSON: `[{"a":{"b":1}},{"a":2}]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`errFilter`: twiceFunc,
+				},
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`errAggregate`: errAggregateFunc,
+				},
+				expectedErr: createErrorTypeUnmatched(`.c`, `object`, `float64`),
+			},
+		},
+		`function-syntax-check`: []TestCase{
+			{
+				jsonpath:     `$.*.TWICE()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[246.912,512]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`TWICE`: twiceFunc,
+				},
+			},
+			{
+				jsonpath:     `$.*.123()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[246.912,512]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`123`: twiceFunc,
+				},
+			},
+			{
+				jsonpath:     `$.*.--()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[246.912,512]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`--`: twiceFunc,
+				},
+			},
+			{
+				jsonpath:     `$.*.__()`,
+				inputJSON:    `[123.456,256]`,
+				expectedJSON: `[246.912,512]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`__`: twiceFunc,
+				},
+			},
+			{
+				jsonpath:    `$.*.unknown()`,
+				inputJSON:   `[123.456,256]`,
+				expectedErr: ErrorFunctionNotFound{function: `.unknown()`},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func createAccessorModeValidator(
+	resultIndex int,
+	expectedValue1, expectedValue2, expectedValue3 interface{},
+	srcGetter func(interface{}) interface{},
+	srcSetter func(interface{}, interface{})) func(interface{}, []interface{}) error {
+	return func(src interface{}, actualObject []interface{}) error {
+		accessor := actualObject[resultIndex].(Accessor)
+
+		getValue := accessor.Get()
+		if getValue != expectedValue1 {
+			return fmt.Errorf(`Get : expect<%f> != actual<%f>`, expectedValue1, getValue)
+		}
+
+		accessor.Set(expectedValue2)
+
+		newSrcValue := srcGetter(src)
+
+		if newSrcValue != expectedValue2 {
+			return fmt.Errorf(`Set : expect<%f> != actual<%f>`, expectedValue2, newSrcValue)
+		}
+
+		getValue = accessor.Get()
+		if getValue != expectedValue2 {
+			return fmt.Errorf(`Set -> Get : expect<%f> != actual<%f>`, expectedValue2, getValue)
+		}
+
+		srcSetter(src, expectedValue3)
+
+		getValue = accessor.Get()
+		if getValue != expectedValue3 {
+			return fmt.Errorf(`Src -> Get : expect<%f> != actual<%f>`, expectedValue3, getValue)
+		}
+
+		return nil
+	}
+}
+
+var getOnlyValidator = func(src interface{}, actualObject []interface{}) error {
+	accessor := actualObject[0].(Accessor)
+
+	if accessor.Set != nil {
+		return fmt.Errorf(`Set != nil`)
+	}
+
+	if !reflect.DeepEqual(accessor.Get(), src) {
+		return fmt.Errorf(`Get != src`)
+	}
+
+	return nil
+}
+
+var echoAggregateFunc = func(param []interface{}) (interface{}, error) {
+	return param, nil
+}
+
+var sliceStructChangedResultValidator = func(src interface{}, actualObject []interface{}) error {
+	srcArray := src.([]interface{})
+	accessor := actualObject[0].(Accessor)
+
+	accessor.Set(4) // srcArray:[1,4,3] , accessor:[1,4,3]
+	if len(srcArray) != 3 || srcArray[1] != 4 {
+		return fmt.Errorf(`Set -> Src : expect<%d> != actual<%d>`, 4, srcArray[1])
+	}
+	srcArray = append(srcArray[:1], srcArray[2:]...) // srcArray:[1,3] , accessor:[1,3,3]
+	if len(srcArray) != 2 || accessor.Get() != 3.0 { // Go's marshal returns float value
+		return fmt.Errorf(`Del -> Get : expect<%f> != actual<%f>`, 3.0, accessor.Get())
+	}
+	accessor.Set(5) // srcArray:[1,5] , accessor:[1,5,3]
+	if len(srcArray) != 2 || srcArray[1] != 5 {
+		return fmt.Errorf(`Del -> Set -> Src : expect<%d> != actual<%d>`, 5, srcArray[1])
+	}
+	srcArray = append(srcArray[:1], srcArray[2:]...) // srcArray:[1] , accessor:[1,5,3]
+	if len(srcArray) != 1 || accessor.Get() != 5 {
+		return fmt.Errorf(`Delx2 -> Get : expect<%d> != actual<%d>`, 5, accessor.Get())
+	}
+	accessor.Set(6) // srcArray:[1] , accessor:[1,6,3]
+	if len(srcArray) != 1 {
+		return fmt.Errorf(`Delx2 -> Set -> Len : expect<%d> != actual<%d>`, 1, len(srcArray))
+	}
+	srcArray = append(srcArray, 7) // srcArray:[1,7] , accessor:[1,7,3]
+	if len(srcArray) != 2 || accessor.Get() != 7 {
+		return fmt.Errorf(`Delx2 -> Add -> Get : expect<%d> != actual<%d>`, 7, accessor.Get())
+	}
+	srcArray = append(srcArray, 8) // srcArray:[1,7,8]    , accessor:[1,7,8]
+	srcArray = append(srcArray, 9) // srcArray:[1,7,8,9]  , accessor:[1,7,8,9]
+	srcArray[1] = 10               // srcArray:[1,10,8,9] , accessor:[1,10,8,9]
+	if len(srcArray) != 4 || accessor.Get() != 10 {
+		return fmt.Errorf(`Delx2 -> Addx3 -> Update -> Get : expect<%d> != actual<%d>`, 10, accessor.Get())
+	}
+	return nil
+}
+
+var mapStructChangedResultValidator = func(src interface{}, actualObject []interface{}) error {
+	srcMap := src.(map[string]interface{})
+	accessor := actualObject[0].(Accessor)
+
+	accessor.Set(2) // srcMap:{"a":2} , accessor:{"a":2}
+	if len(srcMap) != 1 || srcMap[`a`] != 2 {
+		return fmt.Errorf(`Set -> Src : expect<%d> != actual<%d>`, 2, srcMap[`a`])
+	}
+	delete(srcMap, `a`) // srcMap:{} , accessor:{}
+	if accessor.Get() != nil {
+		return fmt.Errorf(`Del -> Get : expect<%v> != actual<%d>`, nil, accessor.Get())
+	}
+	accessor.Set(3) // srcMap:{"a":3} , accessor:{"a":3}
+	if len(srcMap) != 1 || srcMap[`a`] != 3 {
+		return fmt.Errorf(`Del -> Set -> Len : expect<%d> != actual<%d>`, 0, len(srcMap))
+	}
+	delete(srcMap, `a`) // srcMap:{} , accessor:{}
+	srcMap[`a`] = 4     // srcMap:{"a":4} , accessor:{"a":4}
+	if accessor.Get() != 4 {
+		return fmt.Errorf(`Del -> Update -> Get : expect<%v> != actual<%d>`, 4, accessor.Get())
+	}
+	return nil
+}
+
+func TestRetrieve_configAccessorMode(t *testing.T) {
+	testGroups := TestGroup{
+		`getter-setter`: []TestCase{
+			{
+				jsonpath:     `$.b`,
+				inputJSON:    `{"a":11,"b":22,"c":33}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 22.0, 33.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`b`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`b`] = value
+					}),
+			},
+			{
+				jsonpath:     `$['b']`,
+				inputJSON:    `{"a":123,"b":456,"c":789}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 456.0, 246.0, 369.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`b`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`b`] = value
+					}),
+			},
+			{
+				jsonpath:     `b`,
+				inputJSON:    `{"a":11,"b":22,"c":33}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 22.0, 33.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`b`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`b`] = value
+					}),
+			},
+			{
+				jsonpath:     `$['a','b','c']`,
+				inputJSON:    `{"a":11,"b":22}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 22.0, 44.0, 55.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`b`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`b`] = value
+					}),
+			},
+			{
+				jsonpath:     `$.*`,
+				inputJSON:    `{"a":11,"b":22,"c":33}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 22.0, 33.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`b`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`b`] = value
+					}),
+			},
+			{
+				jsonpath:     `$.*`,
+				inputJSON:    `[11,22,33]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 22.0, 33.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[1]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[1] = value
+					}),
+			},
+			{
+				jsonpath:     `$..a`,
+				inputJSON:    `{"b":{"a":11}, "c":66, "a":77}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 11.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`b`].(map[string]interface{})[`a`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`b`].(map[string]interface{})[`a`] = value
+					}),
+			},
+			{
+				jsonpath:     `$[1]`,
+				inputJSON:    `[123.456,256,789]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 256.0, 512.0, 1024.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[1]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[1] = value
+					}),
+			},
+			{
+				jsonpath:     `$[2,1]`,
+				inputJSON:    `[123.456,256,789]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 256.0, 512.0, 1024.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[1]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[1] = value
+					}),
+			},
+			{
+				jsonpath:     `$[0:2]`,
+				inputJSON:    `[11,22,33,44]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 11.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[0]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[0] = value
+					}),
+			},
+			{
+				jsonpath:     `$[*]`,
+				inputJSON:    `[11,22]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 11.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[0]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[0] = value
+					}),
+			},
+			{
+				jsonpath:     `$[0:2].a`,
+				inputJSON:    `[{"a":11},{"a":22},{"a":33}]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 11.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[0].(map[string]interface{})[`a`]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[0].(map[string]interface{})[`a`] = value
+					}),
+			},
+			{
+				jsonpath:     `$[?(@==11||@==33)]`,
+				inputJSON:    `{"a":11,"b":22,"c":33}`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 33.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.(map[string]interface{})[`c`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]interface{})[`c`] = value
+					}),
+			},
+			{
+				jsonpath:     `$[?(@==11||@==33)]`,
+				inputJSON:    `[11,22,33]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 33.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[2]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[2] = value
+					}),
+			},
+			{
+				jsonpath:     `$[?(@.a==11||@.a==33)].a`,
+				inputJSON:    `[{"a":11},{"a":22},{"a":33}]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					1, 33.0, 22.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[2].(map[string]interface{})[`a`]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[2].(map[string]interface{})[`a`] = value
+					}),
+			},
+			{
+				jsonpath:     `$[?(@==$[1])]`,
+				inputJSON:    `[11,22,33]`,
+				accessorMode: true,
+				resultValidator: createAccessorModeValidator(
+					0, 22.0, 33.0, 44.0,
+					func(src interface{}) interface{} {
+						return src.([]interface{})[1]
+					},
+					func(src, value interface{}) {
+						src.([]interface{})[1] = value
+					}),
+			},
+		},
+		`get-only`: []TestCase{
+			{
+				jsonpath:        `$`,
+				inputJSON:       `[1,2,3]`,
+				accessorMode:    true,
+				resultValidator: getOnlyValidator,
+			},
+			{
+				jsonpath:     `$.echo()`,
+				inputJSON:    `[122.345,123.45,123.456]`,
+				accessorMode: true,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`echo`: echoAggregateFunc,
+				},
+				resultValidator: getOnlyValidator,
+			},
+		},
+		`convert-srcJSON`: []TestCase{
+			{
+				jsonpath:        `$[1]`,
+				inputJSON:       `[1,2,3]`,
+				accessorMode:    true,
+				resultValidator: sliceStructChangedResultValidator,
+			},
+			{
+				jsonpath:        `$.a`,
+				inputJSON:       `{"a":1}`,
+				accessorMode:    true,
+				resultValidator: mapStructChangedResultValidator,
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_configPathMode(t *testing.T) {
+	testGroups := TestGroup{
+		`identifier`: []TestCase{
+			{
+				jsonpath:     `$`,
+				inputJSON:    `{"a":1}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$","Value":{"a":1}}]`,
+			},
+			{
+				jsonpath:     `$.a.b`,
+				inputJSON:    `{"a":{"b":1}}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a']['b']","Value":1}]`,
+			},
+			{
+				jsonpath:     `$['a','b']`,
+				inputJSON:    `{"a":1,"b":2}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a']","Value":1},{"Path":"$['b']","Value":2}]`,
+			},
+			{
+				jsonpath:     `$.*`,
+				inputJSON:    `{"b":2,"a":1}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a']","Value":1},{"Path":"$['b']","Value":2}]`,
+			},
+			{
+				jsonpath:     `$[*].a`,
+				inputJSON:    `[{"a":1},{"b":2},{"a":3}]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[0]['a']","Value":1},{"Path":"$[2]['a']","Value":3}]`,
+			},
+			{
+				jsonpath:     `$..a`,
+				inputJSON:    `{"a":1,"b":{"a":2},"c":[{"a":3}]}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a']","Value":1},{"Path":"$['b']['a']","Value":2},{"Path":"$['c'][0]['a']","Value":3}]`,
+			},
+			{
+				jsonpath:     `$..[1]`,
+				inputJSON:    `[[1,2],[3,4]]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[1]","Value":[3,4]},{"Path":"$[0][1]","Value":2},{"Path":"$[1][1]","Value":4}]`,
+			},
+			{
+				jsonpath:     `$['a\'b','c\\d']`,
+				inputJSON:    `{"a'b":1,"c\\d":2}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a\\'b']","Value":1},{"Path":"$['c\\\\d']","Value":2}]`,
+			},
+			{
+				jsonpath:     `$['a\nb']`,
+				inputJSON:    `{"a\nb":1}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a\\nb']","Value":1}]`,
+			},
+		},
+		`qualifier`: []TestCase{
+			{
+				jsonpath:     `$[0,2]`,
+				inputJSON:    `[1,2,3]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[0]","Value":1},{"Path":"$[2]","Value":3}]`,
+			},
+			{
+				jsonpath:     `$[-1]`,
+				inputJSON:    `[1,2,3]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[2]","Value":3}]`,
+			},
+			{
+				jsonpath:     `$[2:0:-1]`,
+				inputJSON:    `[1,2,3]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[2]","Value":3},{"Path":"$[1]","Value":2}]`,
+			},
+			{
+				jsonpath:     `$[?(@.a>1)].a`,
+				inputJSON:    `[{"a":1},{"a":2},{"a":3}]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[1]['a']","Value":2},{"Path":"$[2]['a']","Value":3}]`,
+			},
+			{
+				jsonpath:     `$.a[?(@==2)]`,
+				inputJSON:    `{"a":{"x":1,"y":2}}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a']['y']","Value":2}]`,
+			},
+		},
+		`function`: []TestCase{
+			{
+				jsonpath:     `$[*].twice()`,
+				inputJSON:    `[1,2]`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$[0]","Value":2},{"Path":"$[1]","Value":4}]`,
+				filters: map[string]func(interface{}) (interface{}, error){
+					`twice`: twiceFunc,
+				},
+			},
+			{
+				jsonpath:     `$.a[*].max()`,
+				inputJSON:    `{"a":[1,3,2]}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$","Value":3}]`,
+				aggregates: map[string]func([]interface{}) (interface{}, error){
+					`max`: maxFunc,
+				},
+			},
+		},
+		`error`: []TestCase{
+			{
+				jsonpath:    `$.a.b`,
+				inputJSON:   `{"a":{}}`,
+				pathMode:    true,
+				expectedErr: createErrorMemberNotExist(`.b`),
+			},
+		},
+		`accessor`: []TestCase{
+			{
+				jsonpath:     `$[1].a`,
+				inputJSON:    `[{"a":11},{"a":22}]`,
+				pathMode:     true,
+				accessorMode: true,
+				resultValidator: func(src interface{}, actualObject []interface{}) error {
+					pathValue := actualObject[0].(PathValue)
+					if pathValue.Path != `$[1]['a']` {
+						return fmt.Errorf(`Path : expect<%s> != actual<%s>`, `$[1]['a']`, pathValue.Path)
+					}
+					return createAccessorModeValidator(
+						0, 22.0, 33.0, 44.0,
+						func(src interface{}) interface{} {
+							return src.([]interface{})[1].(map[string]interface{})[`a`]
+						},
+						func(src, value interface{}) {
+							src.([]interface{})[1].(map[string]interface{})[`a`] = value
+						})(src, []interface{}{pathValue.Value})
+				},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func createUpsertModeValidator(setValue interface{}, expectedSrcJSON string) func(interface{}, []interface{}) error {
+	return func(src interface{}, actualObject []interface{}) error {
+		if len(actualObject) != 1 {
+			return fmt.Errorf(`expected length<1> != actual length<%d>`, len(actualObject))
+		}
+
+		accessor := actualObject[0].(Accessor)
+		accessor.Set(setValue)
+
+		if !reflect.DeepEqual(accessor.Get(), setValue) {
+			return fmt.Errorf(`Set -> Get : expect<%v> != actual<%v>`, setValue, accessor.Get())
+		}
+
+		srcJSON, err := json.Marshal(src)
+		if err != nil {
+			return err
+		}
+		if string(srcJSON) != expectedSrcJSON {
+			return fmt.Errorf(`Set : expect<%s> != actual<%s>`, expectedSrcJSON, srcJSON)
+		}
+
+		return nil
+	}
+}
+
+func createAccessorDeleteValidator(expectedSrcJSON string) func(interface{}, []interface{}) error {
+	return func(src interface{}, actualObject []interface{}) error {
+		for _, result := range actualObject {
+			accessor := result.(Accessor)
+			if accessor.Delete == nil {
+				return fmt.Errorf(`Delete == nil`)
+			}
+			accessor.Delete()
+		}
+
+		srcJSON, err := json.Marshal(src)
+		if err != nil {
+			return err
+		}
+		if string(srcJSON) != expectedSrcJSON {
+			return fmt.Errorf(`Delete : expect<%s> != actual<%s>`, expectedSrcJSON, srcJSON)
+		}
+
+		return nil
+	}
+}
+
+func TestRetrieve_configAccessorModeDelete(t *testing.T) {
+	testGroups := TestGroup{
+		`object`: []TestCase{
+			{
+				jsonpath:        `$.a`,
+				inputJSON:       `{"a":1,"b":2}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"b":2}`),
+			},
+			{
+				jsonpath:        `$..password`,
+				inputJSON:       `{"password":1,"a":[{"password":2,"b":3}],"c":{"d":{"password":4}}}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[{"b":3}],"c":{"d":{}}}`),
+			},
+			{
+				jsonpath:        `$.*`,
+				inputJSON:       `{"a":1,"b":2}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{}`),
+			},
+			{
+				jsonpath:        `$.a`,
+				inputJSON:       `{"a":"x","b":"y"}`,
+				unmarshalFunc:   useStringMapFunction,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"b":"y"}`),
+			},
+		},
+		`array`: []TestCase{
+			{
+				jsonpath:        `$.a[1]`,
+				inputJSON:       `{"a":[1,2,3]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[1,3]}`),
+			},
+			{
+				jsonpath:        `$.a[0,2]`,
+				inputJSON:       `{"a":[1,2,3,4]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[2,4]}`),
+			},
+			{
+				jsonpath:        `$.a[3,0,1]`,
+				inputJSON:       `{"a":[1,2,3,4]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[3]}`),
+			},
+			{
+				jsonpath:        `$.a[0,0]`,
+				inputJSON:       `{"a":[1,2]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[2]}`),
+			},
+			{
+				jsonpath:        `$.a[*]`,
+				inputJSON:       `{"a":[1,2]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[]}`),
+			},
+			{
+				jsonpath:        `$.a[?(@.b)]`,
+				inputJSON:       `{"a":[{"b":1},{"c":2},{"b":3}]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[{"c":2}]}`),
+			},
+			{
+				jsonpath:        `$..[0]`,
+				inputJSON:       `{"a":[[1,2],3],"b":[4,5]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[3],"b":[5]}`),
+			},
+			{
+				jsonpath:        `$.a[0][1]`,
+				inputJSON:       `{"a":[[1,2,3]]}`,
+				accessorMode:    true,
+				resultValidator: createAccessorDeleteValidator(`{"a":[[1,3]]}`),
+			},
+		},
+		`not-deletable`: []TestCase{
+			{
+				jsonpath:     `$[0]`,
+				inputJSON:    `[1,2]`,
+				accessorMode: true,
+				resultValidator: func(src interface{}, actualObject []interface{}) error {
+					if actualObject[0].(Accessor).Delete != nil {
+						return fmt.Errorf(`Delete != nil`)
+					}
+					return nil
+				},
+			},
+			{
+				jsonpath:     `$`,
+				inputJSON:    `{"a":1}`,
+				accessorMode: true,
+				resultValidator: func(src interface{}, actualObject []interface{}) error {
+					if actualObject[0].(Accessor).Delete != nil {
+						return fmt.Errorf(`Delete != nil`)
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_configAccessorModeDeleteAndSet(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[1,2,3]}`), &src)
+
+	config := Config{}
+	config.SetAccessorMode()
+	actualObject, err := Retrieve(`$.a[*]`, src, config)
+	if err != nil {
+		t.Errorf("%s", err)
+		return
+	}
+
+	accessors := make([]Accessor, len(actualObject))
+	for index := range actualObject {
+		accessors[index] = actualObject[index].(Accessor)
+	}
+
+	accessors[0].Delete()
+	if accessors[2].Get() != float64(3) {
+		t.Errorf("Delete -> Get : expect<3> != actual<%v>", accessors[2].Get())
+	}
+	accessors[2].Set(float64(4))
+	if accessors[0].Get() != nil {
+		t.Errorf("Delete -> Get deleted : expect<nil> != actual<%v>", accessors[0].Get())
+	}
+
+	srcJSON, _ := json.Marshal(src)
+	if string(srcJSON) != `{"a":[2,4]}` {
+		t.Errorf("Delete -> Set : expect<%s> != actual<%s>", `{"a":[2,4]}`, srcJSON)
+	}
+}
+
+func TestRetrieve_configUpsertMode(t *testing.T) {
+	testGroups := TestGroup{
+		`create-object`: []TestCase{
+			{
+				jsonpath:        `$.b`,
+				inputJSON:       `{"a":1}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":1,"b":"x"}`),
+			},
+			{
+				jsonpath:        `$.b.c.d`,
+				inputJSON:       `{"a":1}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":1,"b":{"c":{"d":"x"}}}`),
+			},
+			{
+				jsonpath:        `$.a.c`,
+				inputJSON:       `{"a":{"b":1}}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":{"b":1,"c":"x"}}`),
+			},
+			{
+				jsonpath:        `$.a.b`,
+				inputJSON:       `{"a":null}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":{"b":"x"}}`),
+			},
+			{
+				jsonpath:        `$.a`,
+				inputJSON:       `{"a":1}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":"x"}`),
+			},
+		},
+		`create-array`: []TestCase{
+			{
+				jsonpath:        `$.a.b[2].c`,
+				inputJSON:       `{}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":{"b":[null,null,{"c":"x"}]}}`),
+			},
+			{
+				jsonpath:        `$.a[2]`,
+				inputJSON:       `{"a":[1]}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":[1,null,"x"]}`),
+			},
+			{
+				jsonpath:        `$.a[0].b`,
+				inputJSON:       `{"a":[{"c":1}]}`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `{"a":[{"b":"x","c":1}]}`),
+			},
+			{
+				jsonpath:        `$[0][1]`,
+				inputJSON:       `[[]]`,
+				upsertMode:      true,
+				resultValidator: createUpsertModeValidator(`x`, `[[null,"x"]]`),
+			},
+		},
+		`not-creatable`: []TestCase{
+			{
+				jsonpath:    `$[1]`,
+				inputJSON:   `[0]`,
+				upsertMode:  true,
+				expectedErr: createErrorMemberNotExist(`[1]`),
+			},
+			{
+				jsonpath:    `$.a[-2]`,
+				inputJSON:   `{"a":[1]}`,
+				upsertMode:  true,
+				expectedErr: createErrorMemberNotExist(`[-2]`),
+			},
+			{
+				jsonpath:    `$.a.b`,
+				inputJSON:   `{"a":"c"}`,
+				upsertMode:  true,
+				expectedErr: createErrorTypeUnmatched(`.b`, `object`, `string`),
+			},
+			{
+				jsonpath:    `$.a[0]`,
+				inputJSON:   `{"a":{}}`,
+				upsertMode:  true,
+				expectedErr: createErrorTypeUnmatched(`[0]`, `array`, `map[string]interface {}`),
+			},
+			{
+				jsonpath:    `$.a.*`,
+				inputJSON:   `{"a":{}}`,
+				upsertMode:  true,
+				expectedErr: ErrorNotSupported{feature: `upsert mode with value group`, path: `$.a.*`},
+			},
+			{
+				jsonpath:    `$[?(@.a)]`,
+				inputJSON:   `[]`,
+				upsertMode:  true,
+				expectedErr: ErrorNotSupported{feature: `upsert mode with value group`, path: `$[?(@.a)]`},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieve_configValueGroupCompare(t *testing.T) {
+	testGroups := TestGroup{
+		`any`: []TestCase{
+			{
+				jsonpath:      `$[?(@.tags[*]=='urgent')]`,
+				inputJSON:     `[{"tags":["a","urgent"]},{"tags":["b"]},{"tags":[]},{"a":1}]`,
+				valueGroupAny: true,
+				expectedJSON:  `[{"tags":["a","urgent"]}]`,
+			},
+			{
+				jsonpath:      `$[?('urgent'==@.tags[*])]`,
+				inputJSON:     `[{"tags":["a","urgent"]},{"tags":["b"]},{"tags":[]},{"a":1}]`,
+				valueGroupAny: true,
+				expectedJSON:  `[{"tags":["a","urgent"]}]`,
+			},
+			{
+				jsonpath:      `$[?(@.tags[*]!='urgent')]`,
+				inputJSON:     `[{"tags":["a","urgent"]},{"tags":["b"]},{"tags":[]},{"a":1}]`,
+				valueGroupAny: true,
+				expectedJSON:  `[{"tags":["a","urgent"]},{"tags":["b"]}]`,
+			},
+			{
+				jsonpath:      `$[?(@..x==1)]`,
+				inputJSON:     `[{"x":{"x":1}},{"x":{"x":2}},{"y":[{"x":1}]}]`,
+				valueGroupAny: true,
+				expectedJSON:  `[{"x":{"x":1}},{"y":[{"x":1}]}]`,
+			},
+			{
+				jsonpath:      `$[?(@[0:2]>2)]`,
+				inputJSON:     `[[1,2,3],[1,3],[3]]`,
+				valueGroupAny: true,
+				expectedJSON:  `[[1,3],[3]]`,
+			},
+			{
+				jsonpath:      `$[?(@.a[*]==$.b[*])]`,
+				inputJSON:     `{"a":{"a":[1,2]},"b":[2,3],"c":{"a":[4]}}`,
+				valueGroupAny: true,
+				expectedJSON:  `[{"a":[1,2]}]`,
+			},
+			{
+				jsonpath:      `$[?(@.tags[*]=~/^u/)]`,
+				inputJSON:     `[{"tags":["a","urgent"]},{"tags":["b"]}]`,
+				valueGroupAny: true,
+				expectedJSON:  `[{"tags":["a","urgent"]}]`,
+			},
+			{
+				jsonpath:      `$[?(@.tags[*]=='x')]`,
+				inputJSON:     `[{"tags":["a","urgent"]},{"tags":["b"]}]`,
+				valueGroupAny: true,
+				expectedErr:   createErrorMemberNotExist(`[?(@.tags[*]=='x')]`),
+			},
+		},
+		`all`: []TestCase{
+			{
+				jsonpath:      `$[?(@.tags[*]=='urgent')]`,
+				inputJSON:     `[{"tags":["urgent","urgent"]},{"tags":["a","urgent"]},{"tags":[]},{"a":1}]`,
+				valueGroupAll: true,
+				expectedJSON:  `[{"tags":["urgent","urgent"]}]`,
+			},
+			{
+				jsonpath:      `$[?(@.tags[*]!='urgent')]`,
+				inputJSON:     `[{"tags":["urgent","urgent"]},{"tags":["a","urgent"]},{"tags":["a","b"]},{"tags":[]}]`,
+				valueGroupAll: true,
+				expectedJSON:  `[{"tags":["a","b"]}]`,
+			},
+			{
+				jsonpath:      `$[?(@.n[*]>0)]`,
+				inputJSON:     `[{"n":[1,2,3]},{"n":[0,1]},{"n":[]}]`,
+				valueGroupAll: true,
+				expectedJSON:  `[{"n":[1,2,3]}]`,
+			},
+		},
+		`not-configured`: []TestCase{
+			{
+				jsonpath:    `$[?(@.tags[*]=='urgent')]`,
+				inputJSON:   `[{"tags":["a","urgent"]}]`,
+				expectedErr: ErrorInvalidSyntax{position: 4, reason: `JSONPath that returns a value group is prohibited`, near: `@.tags[*]=='urgent')]`},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func TestRetrieveExecTwice(t *testing.T) {
+	jsonpath1 := `$.a`
+	srcJSON1 := `{"a":123}`
+	expectedOutput1 := "[123]"
+	jsonpath2 := `$[1].b`
+	srcJSON2 := `[123,{"b":456}]`
+	expectedOutput2 := "[456]"
+
+	var src1 interface{}
+	if err := json.Unmarshal([]byte(srcJSON1), &src1); err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+	var src2 interface{}
+	if err := json.Unmarshal([]byte(srcJSON2), &src2); err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+
+	actualObject1, err := Retrieve(jsonpath1, src1)
+	if err != nil {
+		t.Errorf("expected error<nil> != actual error<%s>\n", err)
+		return
+	}
+	actualObject2, err := Retrieve(jsonpath2, src2)
+	if err != nil {
+		t.Errorf("expected error<nil> != actual error<%s>\n", err)
+		return
+	}
+
+	actualOutputJSON1, err := json.Marshal(actualObject1)
+	if err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+	actualOutputJSON2, err := json.Marshal(actualObject2)
+	if err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+
+	if string(actualOutputJSON1) != string(expectedOutput1) || string(actualOutputJSON2) != string(expectedOutput2) {
+		t.Errorf("actualOutputJSON1<%s> != expectedOutput1<%s> || actualOutputJSON2<%s> != expectedOutput2<%s>\n",
+			string(actualOutputJSON1), string(expectedOutput1), string(actualOutputJSON2), string(expectedOutput2))
+		return
+	}
+}
+
+func TestParserFuncExecTwice(t *testing.T) {
+	jsonpath := `$.a`
+	srcJSON1 := `{"a":1}`
+	expectedOutput1 := "[1]"
+	srcJSON2 := `{"a":2}`
+	expectedOutput2 := "[2]"
+
+	var src1 interface{}
+	if err := json.Unmarshal([]byte(srcJSON1), &src1); err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+	var src2 interface{}
+	if err := json.Unmarshal([]byte(srcJSON2), &src2); err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+
+	parserFunc, err := Parse(jsonpath)
+	if err != nil {
+		t.Errorf("expected error<nil> != actual error<%s>\n", err)
+		return
+	}
+
+	actualObject1, err := parserFunc(src1)
+	if err != nil {
+		t.Errorf("expected error<nil> != actual error<%s>\n", err)
+		return
+	}
+	actualObject2, err := parserFunc(src2)
+	if err != nil {
+		t.Errorf("expected error<nil> != actual error<%s>\n", err)
+		return
+	}
+
+	actualOutputJSON1, err := json.Marshal(actualObject1)
+	if err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+	actualOutputJSON2, err := json.Marshal(actualObject2)
+	if err != nil {
+		t.Errorf("%w", err)
+		return
+	}
+
+	if string(actualOutputJSON1) != string(expectedOutput1) || string(actualOutputJSON2) != string(expectedOutput2) {
+		t.Errorf("actualOutputJSON1<%s> != expectedOutput1<%s> || actualOutputJSON2<%s> != expectedOutput2<%s>\n",
+			string(actualOutputJSON1), string(expectedOutput1), string(actualOutputJSON2), string(expectedOutput2))
+		return
+	}
+}
+
+func TestParse_concurrent(t *testing.T) {
+	testCases := []struct {
+		jsonpath       string
+		srcJSON        string
+		expectedOutput string
+	}{
+		{jsonpath: `$.a`, srcJSON: `{"a":1}`, expectedOutput: `[1]`},
+		{jsonpath: `$[1,0]`, srcJSON: `[1,2]`, expectedOutput: `[2,1]`},
+		{jsonpath: `$..b`, srcJSON: `{"a":{"b":2}}`, expectedOutput: `[2]`},
+		{jsonpath: `$[?(@.a>1)].a`, srcJSON: `[{"a":1},{"a":2}]`, expectedOutput: `[2]`},
+	}
+
+	var waitGroup sync.WaitGroup
+	for index := 0; index < 100; index++ {
+		testCase := testCases[index%len(testCases)]
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			var src interface{}
+			if err := json.Unmarshal([]byte(testCase.srcJSON), &src); err != nil {
+				t.Error(err)
+				return
+			}
+			actualObject, err := Retrieve(testCase.jsonpath, src)
+			if err != nil {
+				t.Errorf("expected error<nil> != actual error<%s>\n", err)
+				return
+			}
+			actualOutputJSON, err := json.Marshal(actualObject)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if string(actualOutputJSON) != testCase.expectedOutput {
+				t.Errorf("expectedOutput<%s> != actualOutputJSON<%s>\n",
+					testCase.expectedOutput, string(actualOutputJSON))
+			}
+		}()
+	}
+	waitGroup.Wait()
+}
+
+type testStructBase struct {
+	ID int `json:"id"`
+}
+
+type testStructAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type testStructUser struct {
+	testStructBase
+	Name     string             `json:"name"`
+	Age      int                `json:"age"`
+	Nickname string             `json:"nickname,omitempty"`
+	Password string             `json:"-"`
+	Address  *testStructAddress `json:"address,omitempty"`
+	Tags     []string           `json:"tags"`
+	Scores   [2]float64         `json:"scores"`
+	Plain    string
+	secret   string
+}
+
+var useStructUserFunction = func(srcJSON string, src *interface{}) error {
+	user := &testStructUser{}
+	if err := json.Unmarshal([]byte(srcJSON), user); err != nil {
+		return err
+	}
+	*src = user
+	return nil
+}
+
+var useStructUserValueFunction = func(srcJSON string, src *interface{}) error {
+	user := testStructUser{}
+	if err := json.Unmarshal([]byte(srcJSON), &user); err != nil {
+		return err
+	}
+	*src = user
+	return nil
+}
+
+var useStructUserListFunction = func(srcJSON string, src *interface{}) error {
+	users := []testStructUser{}
+	if err := json.Unmarshal([]byte(srcJSON), &users); err != nil {
+		return err
+	}
+	*src = users
+	return nil
+}
+
+func TestRetrieve_struct(t *testing.T) {
+	testGroups := TestGroup{
+		`identifier`: []TestCase{
+			{
+				jsonpath:      `$.name`,
+				inputJSON:     `{"name":"alice","age":20}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["alice"]`,
+			},
+			{
+				jsonpath:      `$.name`,
+				inputJSON:     `{"name":"alice","age":20}`,
+				unmarshalFunc: useStructUserValueFunction,
+				expectedJSON:  `["alice"]`,
+			},
+			{
+				jsonpath:      `$['name','age']`,
+				inputJSON:     `{"name":"alice","age":20}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["alice",20]`,
+			},
+			{
+				jsonpath:      `$.Plain`,
+				inputJSON:     `{"Plain":"plain"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["plain"]`,
+			},
+			{
+				jsonpath:      `$.id`,
+				inputJSON:     `{"id":1}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `[1]`,
+			},
+			{
+				jsonpath:      `$.Name`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`.Name`),
+			},
+			{
+				jsonpath:      `$.nickname`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`.nickname`),
+			},
+			{
+				jsonpath:      `$.nickname`,
+				inputJSON:     `{"nickname":"al"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["al"]`,
+			},
+			{
+				jsonpath:      `$.Password`,
+				inputJSON:     `{}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`.Password`),
+			},
+			{
+				jsonpath:      `$.secret`,
+				inputJSON:     `{}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`.secret`),
+			},
+			{
+				jsonpath:      `$.address.city`,
+				inputJSON:     `{"address":{"city":"Tokyo"}}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["Tokyo"]`,
+			},
+			{
+				jsonpath:      `$.address`,
+				inputJSON:     `{}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`.address`),
+			},
+			{
+				jsonpath:      `$.address.zip`,
+				inputJSON:     `{"address":{"city":"Tokyo"}}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`.zip`),
+			},
+			{
+				jsonpath:      `$.name.first`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorTypeUnmatched(`.first`, `object`, `string`),
+			},
+			{
+				jsonpath:      `$[0]`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorTypeUnmatched(`[0]`, `array`, `*jsonpath.testStructUser`),
+			},
+		},
+		`array`: []TestCase{
+			{
+				jsonpath:      `$.tags[1]`,
+				inputJSON:     `{"tags":["a","b","c"]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["b"]`,
+			},
+			{
+				jsonpath:      `$.tags[-1,0]`,
+				inputJSON:     `{"tags":["a","b","c"]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["c","a"]`,
+			},
+			{
+				jsonpath:      `$.tags[1:]`,
+				inputJSON:     `{"tags":["a","b","c"]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["b","c"]`,
+			},
+			{
+				jsonpath:      `$.tags[::-1]`,
+				inputJSON:     `{"tags":["a","b","c"]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["c","b","a"]`,
+			},
+			{
+				jsonpath:      `$.scores[*]`,
+				inputJSON:     `{"scores":[1.5,2.5]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `[1.5,2.5]`,
+			},
+			{
+				jsonpath:      `$.tags[3]`,
+				inputJSON:     `{"tags":["a","b","c"]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedErr:   createErrorMemberNotExist(`[3]`),
+			},
+			{
+				jsonpath:      `$[1].name`,
+				inputJSON:     `[{"name":"alice"},{"name":"bob"}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["bob"]`,
+			},
+		},
+		`wildcard`: []TestCase{
+			{
+				jsonpath:      `$.*`,
+				inputJSON:     `{"id":1,"name":"alice","age":20,"tags":["a"],"scores":[1,2],"Plain":"p"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["p",20,1,"alice",[1,2],["a"]]`,
+			},
+			{
+				jsonpath:      `$.address.*`,
+				inputJSON:     `{"address":{"city":"Tokyo"}}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["Tokyo"]`,
+			},
+			{
+				jsonpath:      `$[*].name`,
+				inputJSON:     `[{"name":"alice"},{"name":"bob"}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["alice","bob"]`,
+			},
+		},
+		`recursive`: []TestCase{
+			{
+				jsonpath:      `$..city`,
+				inputJSON:     `[{"address":{"city":"Tokyo"}},{"name":"bob"},{"address":{"city":"Osaka"}}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["Tokyo","Osaka"]`,
+			},
+			{
+				jsonpath:      `$..[0]`,
+				inputJSON:     `{"tags":["a","b"],"scores":[1,2]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `[1,"a"]`,
+			},
+		},
+		`filter`: []TestCase{
+			{
+				jsonpath:      `$[?(@.age > 18)].name`,
+				inputJSON:     `[{"name":"alice","age":20},{"name":"bob","age":10}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["alice"]`,
+			},
+			{
+				jsonpath:      `$[?(@.address.city == 'Osaka')].name`,
+				inputJSON:     `[{"name":"alice","address":{"city":"Tokyo"}},{"name":"bob","address":{"city":"Osaka"}}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["bob"]`,
+			},
+			{
+				jsonpath:      `$[?(@.nickname)].name`,
+				inputJSON:     `[{"name":"alice","nickname":"al"},{"name":"bob"}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["alice"]`,
+			},
+			{
+				jsonpath:      `$.tags[?(@ =~ /^b/)]`,
+				inputJSON:     `{"tags":["a","b","bc"]}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["b","bc"]`,
+			},
+			{
+				jsonpath:      `$[?(length(@.tags) == 2)].name`,
+				inputJSON:     `[{"name":"alice","tags":["a","b"]},{"name":"bob","tags":["a"]}]`,
+				unmarshalFunc: useStructUserListFunction,
+				expectedJSON:  `["alice"]`,
+			},
+			{
+				jsonpath:      `$[?(@ == 'alice')]`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserFunction,
+				expectedJSON:  `["alice"]`,
+			},
+		},
+		`path`: []TestCase{
+			{
+				jsonpath:      `$[*].address.city`,
+				inputJSON:     `[{"address":{"city":"Tokyo"}}]`,
+				unmarshalFunc: useStructUserListFunction,
+				pathMode:      true,
+				expectedJSON:  `[{"Path":"$[0]['address']['city']","Value":"Tokyo"}]`,
+			},
+		},
+		`accessor`: []TestCase{
+			{
+				jsonpath:      `$.name`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserFunction,
+				accessorMode:  true,
+				resultValidator: createAccessorModeValidator(
+					0, `alice`, `bob`, `carol`,
+					func(src interface{}) interface{} {
+						return src.(*testStructUser).Name
+					},
+					func(src, value interface{}) {
+						src.(*testStructUser).Name = value.(string)
+					}),
+			},
+			{
+				jsonpath:      `$.address.city`,
+				inputJSON:     `{"address":{"city":"Tokyo"}}`,
+				unmarshalFunc: useStructUserFunction,
+				accessorMode:  true,
+				resultValidator: createAccessorModeValidator(
+					0, `Tokyo`, `Osaka`, `Nagoya`,
+					func(src interface{}) interface{} {
+						return src.(*testStructUser).Address.City
+					},
+					func(src, value interface{}) {
+						src.(*testStructUser).Address.City = value.(string)
+					}),
+			},
+			{
+				jsonpath:      `$.tags[1]`,
+				inputJSON:     `{"tags":["a","b"]}`,
+				unmarshalFunc: useStructUserFunction,
+				accessorMode:  true,
+				resultValidator: createAccessorModeValidator(
+					0, `b`, `c`, `d`,
+					func(src interface{}) interface{} {
+						return src.(*testStructUser).Tags[1]
+					},
+					func(src, value interface{}) {
+						src.(*testStructUser).Tags[1] = value.(string)
+					}),
+			},
+			{
+				jsonpath:      `$.scores[0]`,
+				inputJSON:     `{"scores":[1,2]}`,
+				unmarshalFunc: useStructUserFunction,
+				accessorMode:  true,
+				resultValidator: createAccessorModeValidator(
+					0, 1.0, 3.0, 4.0,
+					func(src interface{}) interface{} {
+						return src.(*testStructUser).Scores[0]
+					},
+					func(src, value interface{}) {
+						src.(*testStructUser).Scores[0] = value.(float64)
+					}),
+			},
+			{
+				jsonpath:      `$.age`,
+				inputJSON:     `{"age":20}`,
+				unmarshalFunc: useStructUserFunction,
+				accessorMode:  true,
+				resultValidator: func(src interface{}, actualObject []interface{}) error {
+					actualObject[0].(Accessor).Set(21.0)
+					if age := src.(*testStructUser).Age; age != 21 {
+						return fmt.Errorf(`Set : expect<21> != actual<%d>`, age)
+					}
+					return nil
+				},
+			},
+			{
+				jsonpath:      `$.name`,
+				inputJSON:     `{"name":"alice"}`,
+				unmarshalFunc: useStructUserValueFunction,
+				accessorMode:  true,
+				resultValidator: func(src interface{}, actualObject []interface{}) error {
+					accessor := actualObject[0].(Accessor)
+					if accessor.Set != nil {
+						return fmt.Errorf(`Set != nil`)
+					}
+					if accessor.Get() != `alice` {
+						return fmt.Errorf(`Get : expect<alice> != actual<%v>`, accessor.Get())
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+type testTypedKey string
+
+func createTypedUnmarshalFunction(newValue func() interface{}) func(string, *interface{}) error {
+	return func(srcJSON string, src *interface{}) error {
+		value := newValue()
+		if err := json.Unmarshal([]byte(srcJSON), value); err != nil {
+			return err
+		}
+		*src = reflect.ValueOf(value).Elem().Interface()
+		return nil
+	}
+}
+
+var useStringMapFunction = createTypedUnmarshalFunction(
+	func() interface{} { return &map[string]string{} })
+var useStringListFunction = createTypedUnmarshalFunction(
+	func() interface{} { return &[]string{} })
+var useMapListFunction = createTypedUnmarshalFunction(
+	func() interface{} { return &[]map[string]interface{}{} })
+var useIntListMapFunction = createTypedUnmarshalFunction(
+	func() interface{} { return &map[string][]int{} })
+var useTypedKeyMapFunction = createTypedUnmarshalFunction(
+	func() interface{} { return &map[testTypedKey]float64{} })
+
+func TestRetrieve_typedContainer(t *testing.T) {
+	testGroups := TestGroup{
+		`map[string]string`: []TestCase{
+			{
+				jsonpath:      `$.a`,
+				inputJSON:     `{"a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedJSON:  `["x"]`,
+			},
+			{
+				jsonpath:      `$['b','a','c']`,
+				inputJSON:     `{"a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedJSON:  `["y","x"]`,
+			},
+			{
+				jsonpath:      `$.*`,
+				inputJSON:     `{"c":"z","a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedJSON:  `["x","y","z"]`,
+			},
+			{
+				jsonpath:      `$..*`,
+				inputJSON:     `{"b":"y","a":"x"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedJSON:  `["x","y"]`,
+			},
+			{
+				jsonpath:      `$.c`,
+				inputJSON:     `{"a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedErr:   createErrorMemberNotExist(`.c`),
+			},
+			{
+				jsonpath:      `$[0]`,
+				inputJSON:     `{"a":"x"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedErr:   createErrorTypeUnmatched(`[0]`, `array`, `map[string]string`),
+			},
+			{
+				jsonpath:      `$[?(@ == 'y')]`,
+				inputJSON:     `{"a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				expectedJSON:  `["y"]`,
+			},
+			{
+				jsonpath:      `$.b`,
+				inputJSON:     `{"a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				pathMode:      true,
+				expectedJSON:  `[{"Path":"$['b']","Value":"y"}]`,
+			},
+		},
+		`[]string`: []TestCase{
+			{
+				jsonpath:      `$[1]`,
+				inputJSON:     `["a","b","c"]`,
+				unmarshalFunc: useStringListFunction,
+				expectedJSON:  `["b"]`,
+			},
+			{
+				jsonpath:      `$[0:2]`,
+				inputJSON:     `["a","b","c"]`,
+				unmarshalFunc: useStringListFunction,
+				expectedJSON:  `["a","b"]`,
+			},
+			{
+				jsonpath:      `$[?(@ != 'a')]`,
+				inputJSON:     `["a","b","c"]`,
+				unmarshalFunc: useStringListFunction,
+				expectedJSON:  `["b","c"]`,
+			},
+			{
+				jsonpath:      `$.a`,
+				inputJSON:     `["a"]`,
+				unmarshalFunc: useStringListFunction,
+				expectedErr:   createErrorTypeUnmatched(`.a`, `object`, `[]string`),
+			},
+		},
+		`[]map[string]interface{}`: []TestCase{
+			{
+				jsonpath:      `$[?(@.a == 1)].b`,
+				inputJSON:     `[{"a":1,"b":"x"},{"a":2,"b":"y"}]`,
+				unmarshalFunc: useMapListFunction,
+				expectedJSON:  `["x"]`,
+			},
+			{
+				jsonpath:      `$..b`,
+				inputJSON:     `[{"a":1,"b":"x"},{"a":{"b":"z"},"b":"y"}]`,
+				unmarshalFunc: useMapListFunction,
+				expectedJSON:  `["x","y","z"]`,
+			},
+		},
+		`map[string][]int`: []TestCase{
+			{
+				jsonpath:      `$.a[1]`,
+				inputJSON:     `{"a":[1,2],"b":[3]}`,
+				unmarshalFunc: useIntListMapFunction,
+				expectedJSON:  `[2]`,
+			},
+			{
+				jsonpath:      `$..[0]`,
+				inputJSON:     `{"a":[1,2],"b":[3]}`,
+				unmarshalFunc: useIntListMapFunction,
+				expectedJSON:  `[1,3]`,
+			},
+			{
+				jsonpath:      `$[?(@[0] > 1)]`,
+				inputJSON:     `{"a":[1,2],"b":[3]}`,
+				unmarshalFunc: useIntListMapFunction,
+				expectedJSON:  `[[3]]`,
+			},
+			{
+				jsonpath:      `$.*[?(@ >= 2)]`,
+				inputJSON:     `{"a":[1,2],"b":[3]}`,
+				unmarshalFunc: useIntListMapFunction,
+				expectedJSON:  `[2,3]`,
+			},
+			{
+				jsonpath:      `$[?(length(@) == 2)]`,
+				inputJSON:     `{"a":[1,2],"b":[3]}`,
+				unmarshalFunc: useIntListMapFunction,
+				expectedJSON:  `[[1,2]]`,
+			},
+			{
+				jsonpath:      `$.a[(@.length-1)]`,
+				inputJSON:     `{"a":[1,2],"b":[3]}`,
+				unmarshalFunc: useIntListMapFunction,
+				expectedJSON:  `[2]`,
+			},
+		},
+		`map[testTypedKey]float64`: []TestCase{
+			{
+				jsonpath:      `$.a`,
+				inputJSON:     `{"a":1.5}`,
+				unmarshalFunc: useTypedKeyMapFunction,
+				expectedJSON:  `[1.5]`,
+			},
+		},
+		`accessor`: []TestCase{
+			{
+				jsonpath:      `$.b`,
+				inputJSON:     `{"a":"x","b":"y"}`,
+				unmarshalFunc: useStringMapFunction,
+				accessorMode:  true,
+				resultValidator: createAccessorModeValidator(
+					0, `y`, `z`, `w`,
+					func(src interface{}) interface{} {
+						return src.(map[string]string)[`b`]
+					},
+					func(src, value interface{}) {
+						src.(map[string]string)[`b`] = value.(string)
+					}),
+			},
+			{
+				jsonpath:      `$.a[1]`,
+				inputJSON:     `{"a":[1,2]}`,
+				unmarshalFunc: useIntListMapFunction,
+				accessorMode:  true,
+				resultValidator: func(src interface{}, actualObject []interface{}) error {
+					actualObject[0].(Accessor).Set(5.0)
+					if value := src.(map[string][]int)[`a`][1]; value != 5 {
+						return fmt.Errorf(`Set : expect<5> != actual<%d>`, value)
+					}
+					return nil
+				},
+			},
+			{
+				jsonpath:      `$[1].b`,
+				inputJSON:     `[{"b":1},{"b":2}]`,
+				unmarshalFunc: useMapListFunction,
+				accessorMode:  true,
+				resultValidator: createAccessorModeValidator(
+					0, 2.0, 3.0, 4.0,
+					func(src interface{}) interface{} {
+						return src.([]map[string]interface{})[1][`b`]
+					},
+					func(src, value interface{}) {
+						src.([]map[string]interface{})[1][`b`] = value
+					}),
+			},
+		},
+	}
+
+	execTestRetrieveTestGroups(t, testGroups)
+}
+
+func execTestRetrieveReaderTestGroups(t *testing.T, testGroup TestGroup) {
+	for testGroupName, testCases := range testGroup {
+		for _, testCase := range testCases {
+			testCase := testCase
+			t.Run(
+				fmt.Sprintf(`%s <%s> <%s>`, testGroupName, testCase.jsonpath, testCase.inputJSON),
+				func(t *testing.T) {
+					t.Parallel()
+
+					config := Config{}
+					if testCase.pathMode {
+						config.SetPathMode()
+					}
+					if testCase.accessorMode {
+						config.SetAccessorMode()
+					}
+
+					actualObject := []interface{}{}
+					err := RetrieveReader(
+						testCase.jsonpath, strings.NewReader(testCase.inputJSON),
+						func(value interface{}) error {
+							actualObject = append(actualObject, value)
+							return nil
+						}, config)
+
+					if err != nil || testCase.expectedErr != nil {
+						if reflect.TypeOf(testCase.expectedErr) != reflect.TypeOf(err) ||
+							fmt.Sprintf(`%s`, testCase.expectedErr) != fmt.Sprintf(`%s`, err) {
+							t.Errorf("expected error<%s> != actual error<%s>\n", testCase.expectedErr, err)
+						}
+						return
+					}
+
+					actualOutputJSON, err := json.Marshal(actualObject)
+					if err != nil {
+						t.Errorf("%w", err)
+						return
+					}
+					if string(actualOutputJSON) != testCase.expectedJSON {
+						t.Errorf("expectedOutputJSON<%s> != actualOutputJSON<%s>\n",
+							testCase.expectedJSON, actualOutputJSON)
+					}
+				})
+		}
+	}
+}
+
+func TestRetrieveReader(t *testing.T) {
+	testGroups := TestGroup{
+		`streaming`: []TestCase{
+			{
+				jsonpath:     `$`,
+				inputJSON:    `{"a":1}`,
+				expectedJSON: `[{"a":1}]`,
+			},
+			{
+				jsonpath:     `$.a.b`,
+				inputJSON:    `{"x":{"b":[1,2]},"a":{"c":3,"b":1}}`,
+				expectedJSON: `[1]`,
+			},
+			{
+				jsonpath:     `$['b','a']`,
+				inputJSON:    `{"a":1,"b":2,"c":3}`,
+				expectedJSON: `[1,2]`,
+			},
+			{
+				jsonpath:     `$.*`,
+				inputJSON:    `{"b":1,"a":[2]}`,
+				expectedJSON: `[1,[2]]`,
+			},
+			{
+				jsonpath:     `$[*]`,
+				inputJSON:    `[1,{"a":2}]`,
+				expectedJSON: `[1,{"a":2}]`,
+			},
+			{
+				jsonpath:     `$[1]`,
+				inputJSON:    `[1,{"a":2},3]`,
+				expectedJSON: `[{"a":2}]`,
+			},
+			{
+				jsonpath:     `$[2,0]`,
+				inputJSON:    `[1,2,3]`,
+				expectedJSON: `[1,3]`,
+			},
+			{
+				jsonpath:     `$[1:]`,
+				inputJSON:    `[1,2,3]`,
+				expectedJSON: `[2,3]`,
+			},
+			{
+				jsonpath:     `$[::2]`,
+				inputJSON:    `[1,2,3,4,5]`,
+				expectedJSON: `[1,3,5]`,
+			},
+			{
+				jsonpath:     `$[1:3]`,
+				inputJSON:    `[1,2,3,4,5]`,
+				expectedJSON: `[2,3]`,
+			},
+			{
+				jsonpath:     `$..b`,
+				inputJSON:    `{"b":1,"a":{"b":{"b":2}},"c":[{"b":3}]}`,
+				expectedJSON: `[1,{"b":2},2,3]`,
+			},
+			{
+				jsonpath:     `$..[0]`,
+				inputJSON:    `{"a":[1,[2,3]],"b":{"c":[4]}}`,
+				expectedJSON: `[1,2,4]`,
+			},
+			{
+				jsonpath:     `$..*`,
+				inputJSON:    `{"a":[1],"b":2}`,
+				expectedJSON: `[[1],1,2]`,
+			},
+			{
+				jsonpath:     `$.a[*].b`,
+				inputJSON:    `{"a":[{"b":1},{"c":2},{"b":3}]}`,
+				expectedJSON: `[1,3]`,
+			},
+			{
+				jsonpath:     `$.x`,
+				inputJSON:    `{"a":1}`,
+				expectedJSON: `[]`,
+			},
+			{
+				jsonpath:     `$.a.b`,
+				inputJSON:    `{"a":"b"}`,
+				expectedJSON: `[]`,
+			},
+		},
+		`buffering`: []TestCase{
+			{
+				jsonpath:     `$.a[-1]`,
+				inputJSON:    `{"a":[1,2,3],"b":[4]}`,
+				expectedJSON: `[3]`,
+			},
+			{
+				jsonpath:     `$.a[::-1]`,
+				inputJSON:    `{"a":[1,2,3]}`,
+				expectedJSON: `[3,2,1]`,
+			},
+			{
+				jsonpath:     `$.a[?(@.b > 1)].c`,
+				inputJSON:    `{"x":[1],"a":[{"b":1,"c":"x"},{"b":2,"c":"y"}]}`,
+				expectedJSON: `["y"]`,
+			},
+			{
+				jsonpath:     `$..[?(@.b)].b`,
+				inputJSON:    `[{"b":1},{"a":{"b":2}}]`,
+				expectedJSON: `[1,2]`,
+			},
+			{
+				jsonpath:     `$.a[?(@ > 1)]`,
+				inputJSON:    `{"a":{"x":2,"y":1,"z":3}}`,
+				expectedJSON: `[2,3]`,
+			},
+		},
+		`path`: []TestCase{
+			{
+				jsonpath:     `$..b`,
+				inputJSON:    `{"b":1,"a":[{"b":2}]}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['b']","Value":1},{"Path":"$['a'][0]['b']","Value":2}]`,
+			},
+			{
+				jsonpath:     `$.a[?(@ > 1)]`,
+				inputJSON:    `{"a":[1,2]}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['a'][1]","Value":2}]`,
+			},
+		},
+		`error`: []TestCase{
+			{
+				jsonpath:    `$.a[`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `[`},
+			},
+			{
+				jsonpath:    `$[?(@.a == $.b)]`,
+				inputJSON:   `[{"a":1}]`,
+				expectedErr: ErrorNotSupported{feature: `root reference with reader`, path: `$[?(@.a == $.b)]`},
+			},
+			{
+				jsonpath:     `$.a`,
+				inputJSON:    `{"a":1}`,
+				accessorMode: true,
+				expectedErr:  ErrorNotSupported{feature: `accessor mode with reader`, path: `$.a`},
+			},
+		},
+	}
+
+	execTestRetrieveReaderTestGroups(t, testGroups)
+}
+
+func TestRetrieveReader_callbackError(t *testing.T) {
+	expectedError := fmt.Errorf(`stop`)
+	var count int
+	err := RetrieveReader(`$[*]`, strings.NewReader(`[1,2,3]`), func(value interface{}) error {
+		count++
+		return expectedError
+	})
+	if err != expectedError {
+		t.Errorf("expected error<%s> != actual error<%s>\n", expectedError, err)
+	}
+	if count != 1 {
+		t.Errorf("expected count<1> != actual count<%d>\n", count)
+	}
+}
+
+func TestRetrieveReader_invalidJSON(t *testing.T) {
+	inputs := []string{`{"a":}`, `{"a":1} x`, `{"a":1} {"a":2}`, `{"a":1}]`}
+	for _, input := range inputs {
+		err := RetrieveReader(`$.a`, strings.NewReader(input), func(value interface{}) error {
+			return nil
+		})
+		if _, ok := err.(*json.SyntaxError); !ok {
+			t.Errorf("input<%s> : expected error<*json.SyntaxError> != actual error<%s>\n", input, err)
+		}
+	}
+}
+
+func TestRetrieveReader_filterElement(t *testing.T) {
+	var results []interface{}
+	err := RetrieveReader(`$[?(@ > 1)]`, strings.NewReader(`[2,1,3,x`), func(value interface{}) error {
+		results = append(results, value)
+		return nil
+	})
+	if _, ok := err.(*json.SyntaxError); !ok {
+		t.Errorf("expected error<*json.SyntaxError> != actual error<%s>\n", err)
+	}
+	if !reflect.DeepEqual(results, []interface{}{2., 3.}) {
+		t.Errorf("expected results<[2 3]> != actual results<%v>\n", results)
+	}
+}
+
+func execTestRetrieveBytesTestGroups(t *testing.T, testGroup TestGroup) {
+	for testGroupName, testCases := range testGroup {
+		for _, testCase := range testCases {
+			testCase := testCase
+			t.Run(
+				fmt.Sprintf(`%s <%s> <%s>`, testGroupName, testCase.jsonpath, testCase.inputJSON),
+				func(t *testing.T) {
+					t.Parallel()
+
+					config := Config{}
+					if testCase.pathMode {
+						config.SetPathMode()
+					}
+					if testCase.accessorMode {
+						config.SetAccessorMode()
+					}
+
+					actualObject, err := RetrieveBytes(testCase.jsonpath, []byte(testCase.inputJSON), config)
+
+					if err != nil || testCase.expectedErr != nil {
+						if reflect.TypeOf(testCase.expectedErr) != reflect.TypeOf(err) ||
+							fmt.Sprintf(`%s`, testCase.expectedErr) != fmt.Sprintf(`%s`, err) {
+							t.Errorf("expected error<%s> != actual error<%s>\n", testCase.expectedErr, err)
+						}
+						return
+					}
+
+					actualOutputJSON, err := json.Marshal(actualObject)
+					if err != nil {
+						t.Errorf("%w", err)
+						return
+					}
+					if string(actualOutputJSON) != testCase.expectedJSON {
+						t.Errorf("expectedOutputJSON<%s> != actualOutputJSON<%s>\n",
+							testCase.expectedJSON, actualOutputJSON)
+					}
+				})
+		}
+	}
+}
+
+func TestRetrieveBytes(t *testing.T) {
+	testGroups := TestGroup{
+		`scanning`: []TestCase{
+			{
+				jsonpath:     `$`,
+				inputJSON:    ` {"a" : 1} `,
+				expectedJSON: `[{"a":1}]`,
+			},
+			{
+				jsonpath:     `$.a.b`,
+				inputJSON:    `{"x":{"b":"}]\"["},"a":{"c":[3,{"b":4}],"b":1}}`,
+				expectedJSON: `[1]`,
+			},
+			{
+				jsonpath:     `$['a\'b']`,
+				inputJSON:    `{"a\u0027b":1,"ab":2}`,
+				expectedJSON: `[1]`,
+			},
+			{
+				jsonpath:     `$[1:]`,
+				inputJSON:    `[true,null,-1.5e3,"x"]`,
+				expectedJSON: `[null,-1500,"x"]`,
+			},
+			{
+				jsonpath:     `$..b`,
+				inputJSON:    `{"b":1,"a":{"b":{"b":2}},"c":[{"b":3}]}`,
+				expectedJSON: `[1,{"b":2},2,3]`,
+			},
+			{
+				jsonpath:     `$.*`,
+				inputJSON:    `{"a":{},"b":[]}`,
+				expectedJSON: `[{},[]]`,
+			},
+			{
+				jsonpath:     `$.x`,
+				inputJSON:    `{"a":1}`,
+				expectedJSON: `[]`,
+			},
+		},
+		`buffering`: []TestCase{
+			{
+				jsonpath:     `$.a[-1]`,
+				inputJSON:    `{"a":[1,2,3],"b":[4]}`,
+				expectedJSON: `[3]`,
+			},
+			{
+				jsonpath:     `$.a[?(@.b > 1)].c`,
+				inputJSON:    `{"x":[1],"a":[{"b":1,"c":"x"},{"b":2,"c":"y"}]}`,
+				expectedJSON: `["y"]`,
+			},
+			{
+				jsonpath:     `$.a[?(@.b == $.c)].b`,
+				inputJSON:    `{"a":[{"b":1},{"b":2}],"c":2}`,
+				expectedJSON: `[2]`,
+			},
+		},
+		`path`: []TestCase{
+			{
+				jsonpath:     `$..b`,
+				inputJSON:    `{"b":1,"a":[{"b":2}]}`,
+				pathMode:     true,
+				expectedJSON: `[{"Path":"$['b']","Value":1},{"Path":"$['a'][0]['b']","Value":2}]`,
+			},
+		},
+		`error`: []TestCase{
+			{
+				jsonpath:    `$.a[`,
+				inputJSON:   `{"a":1}`,
+				expectedErr: ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `[`},
+			},
+			{
+				jsonpath:     `$.a`,
+				inputJSON:    `{"a":1}`,
+				accessorMode: true,
+				expectedErr:  ErrorNotSupported{feature: `accessor mode with bytes`, path: `$.a`},
+			},
+		},
+	}
+
+	execTestRetrieveBytesTestGroups(t, testGroups)
+}
+
+func TestRetrieveBytes_invalidJSON(t *testing.T) {
+	inputs := []string{
+		``, `{"a":}`, `{"a":1`, `{"a":1} x`, `["a]`,
+		`{"a":1 "b":2}`, `{"a" 1}`, `{,"a":1}`, `{"a":1,}`, `{"a":1]`,
+		`{"b":{"c" 1},"a":1}`, `{"b":[1 2],"a":1}`, `{"b":[1,,2],"a":1}`, `{"b":[1,2},"a":1}`,
+	}
+	for _, input := range inputs {
+		_, err := RetrieveBytes(`$.a`, []byte(input))
+		if _, ok := err.(*json.SyntaxError); !ok {
+			t.Errorf("input<%s> : expected error<*json.SyntaxError> != actual error<%s>\n", input, err)
+		}
+	}
+}
+
+func TestRetrieveRawBytes(t *testing.T) {
+	testCases := []struct {
+		jsonpath     string
+		inputJSON    string
+		pathMode     bool
+		expectedJSON string
+	}{
+		{
+			jsonpath:     `$.a[*]`,
+			inputJSON:    `{"a":[ 1.0 , {"b" : "x"} ]}`,
+			expectedJSON: `[1.0,{"b" : "x"}]`,
+		},
+		{
+			jsonpath:     `$.a[?(@ > 1)]`,
+			inputJSON:    `{"a":[1,2.0]}`,
+			expectedJSON: `[2.0]`,
+		},
+		{
+			jsonpath:     `$.a.max()`,
+			inputJSON:    `{"a":[1,2.0]}`,
+			expectedJSON: `[2]`,
+		},
+		{
+			jsonpath:     `$.a`,
+			inputJSON:    `{"a":1.0}`,
+			pathMode:     true,
+			expectedJSON: `[1.0]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		config := Config{}
+		if testCase.pathMode {
+			config.SetPathMode()
+		}
+		config.SetAggregateFunction(`max`, func(params []interface{}) (interface{}, error) {
+			var result float64
+			for _, param := range params {
+				if number, ok := param.(float64); ok && number > result {
+					result = number
+				}
+			}
+			return result, nil
+		})
+
+		actualObject, err := RetrieveRawBytes(testCase.jsonpath, []byte(testCase.inputJSON), config)
+		if err != nil {
+			t.Errorf("jsonpath<%s> : %s\n", testCase.jsonpath, err)
+			continue
+		}
+		actualValues := make([]string, len(actualObject))
+		for index, value := range actualObject {
+			if pathValue, ok := value.(PathValue); ok {
+				value = pathValue.Value
+			}
+			rawValue, ok := value.(json.RawMessage)
+			if !ok {
+				t.Errorf("jsonpath<%s> : expected type<json.RawMessage> != actual type<%T>\n",
+					testCase.jsonpath, value)
+			}
+			actualValues[index] = string(rawValue)
+		}
+		actualOutputJSON := `[` + strings.Join(actualValues, `,`) + `]`
+		if actualOutputJSON != testCase.expectedJSON {
+			t.Errorf("expectedOutputJSON<%s> != actualOutputJSON<%s>\n",
+				testCase.expectedJSON, actualOutputJSON)
+		}
+	}
+}
+
+type modifyTestCase struct {
+	jsonpath      string
+	inputJSON     string
+	modifyFunc    func(string, interface{}) (interface{}, int, error)
+	expectedJSON  string
+	expectedCount int
+	expectedErr   error
+}
+
+func execTestModifyTestCases(t *testing.T, testCases []modifyTestCase) {
+	for _, testCase := range testCases {
+		var src interface{}
+		if err := json.Unmarshal([]byte(testCase.inputJSON), &src); err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		actualRoot, actualCount, err := testCase.modifyFunc(testCase.jsonpath, src)
+		if err != nil || testCase.expectedErr != nil {
+			if reflect.TypeOf(testCase.expectedErr) != reflect.TypeOf(err) ||
+				fmt.Sprintf(`%s`, testCase.expectedErr) != fmt.Sprintf(`%s`, err) {
+				t.Errorf("jsonpath<%s> : expected error<%s> != actual error<%s>\n",
+					testCase.jsonpath, testCase.expectedErr, err)
+			}
+		}
+
+		if actualCount != testCase.expectedCount {
+			t.Errorf("jsonpath<%s> : expected count<%d> != actual count<%d>\n",
+				testCase.jsonpath, testCase.expectedCount, actualCount)
+		}
+
+		actualOutputJSON, _ := json.Marshal(actualRoot)
+		if string(actualOutputJSON) != testCase.expectedJSON {
+			t.Errorf("jsonpath<%s> : expectedOutputJSON<%s> != actualOutputJSON<%s>\n",
+				testCase.jsonpath, testCase.expectedJSON, actualOutputJSON)
+		}
+	}
+}
+
+func createSetFunction(value interface{}) func(string, interface{}) (interface{}, int, error) {
+	return func(jsonPath string, src interface{}) (interface{}, int, error) {
+		return Set(jsonPath, src, value)
+	}
+}
+
+func createUpdateFunction(function func(interface{}) (interface{}, error)) func(
+	string, interface{}) (interface{}, int, error) {
+
+	return func(jsonPath string, src interface{}) (interface{}, int, error) {
+		return Update(jsonPath, src, function)
+	}
+}
+
+var deleteFunction = func(jsonPath string, src interface{}) (interface{}, int, error) {
+	return Delete(jsonPath, src)
+}
+
+var incrementFunction = func(value interface{}) (interface{}, error) {
+	number, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf(`not a number`)
+	}
+	return number + 1, nil
+}
+
+func TestSet(t *testing.T) {
+	execTestModifyTestCases(t, []modifyTestCase{
+		{
+			jsonpath:      `$.a`,
+			inputJSON:     `{"a":1,"b":2}`,
+			modifyFunc:    createSetFunction(`x`),
+			expectedJSON:  `{"a":"x","b":2}`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$[*].a`,
+			inputJSON:     `[{"a":1},{"b":2},{"a":3}]`,
+			modifyFunc:    createSetFunction(`x`),
+			expectedJSON:  `[{"a":"x"},{"b":2},{"a":"x"}]`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:      `$.a.b[1].c`,
+			inputJSON:     `{}`,
+			modifyFunc:    createSetFunction(`x`),
+			expectedJSON:  `{"a":{"b":[null,{"c":"x"}]}}`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$`,
+			inputJSON:     `{"a":1}`,
+			modifyFunc:    createSetFunction(`x`),
+			expectedJSON:  `"x"`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$[2]`,
+			inputJSON:     `[1]`,
+			modifyFunc:    createSetFunction(`x`),
+			expectedJSON:  `[1,null,"x"]`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:     `$.a.b`,
+			inputJSON:    `{"a":1}`,
+			modifyFunc:   createSetFunction(`x`),
+			expectedJSON: `{"a":1}`,
+			expectedErr:  createErrorTypeUnmatched(`.b`, `object`, `float64`),
+		},
+		{
+			jsonpath:     `$.*.b`,
+			inputJSON:    `{"a":{}}`,
+			modifyFunc:   createSetFunction(`x`),
+			expectedJSON: `{"a":{}}`,
+			expectedErr:  createErrorMemberNotExist(`.b`),
+		},
+		{
+			jsonpath:     `$.a[`,
+			inputJSON:    `{}`,
+			modifyFunc:   createSetFunction(`x`),
+			expectedJSON: `{}`,
+			expectedErr:  ErrorInvalidSyntax{position: 3, reason: `unrecognized input`, near: `[`},
+		},
+		{
+			jsonpath:     `$.a[65537]`,
+			inputJSON:    `{"a":[1]}`,
+			modifyFunc:   createSetFunction(`x`),
+			expectedJSON: `{"a":[1]}`,
+			expectedErr:  createErrorUpsertIndexExceeded(`[65537]`),
+		},
+		{
+			jsonpath:     `$.a.b[1000000000]`,
+			inputJSON:    `{}`,
+			modifyFunc:   createSetFunction(`x`),
+			expectedJSON: `{}`,
+			expectedErr:  createErrorUpsertIndexExceeded(`[1000000000]`),
+		},
+	})
+}
+
+func TestModify_effectiveCount(t *testing.T) {
+	testCases := []struct {
+		inputJSON     string
+		modifyFunc    func(interface{}, Config) (interface{}, int, error)
+		expectedJSON  string
+		expectedCount int
+	}{
+		{
+			inputJSON: `{"a":{"a":1}}`,
+			modifyFunc: func(src interface{}, config Config) (interface{}, int, error) {
+				return Set(`$..a`, src, 0, config)
+			},
+			expectedJSON:  `{"a":0}`,
+			expectedCount: 1,
+		},
+		{
+			inputJSON: `{"a":[{"b":1}],"b":2}`,
+			modifyFunc: func(src interface{}, config Config) (interface{}, int, error) {
+				return Update(`$..*`, src, func(value interface{}) (interface{}, error) {
+					return `x`, nil
+				}, config)
+			},
+			expectedJSON:  `{"a":"x","b":"x"}`,
+			expectedCount: 2,
+		},
+		{
+			inputJSON: `{"a":[1,{"b":2}],"c":[3]}`,
+			modifyFunc: func(src interface{}, config Config) (interface{}, int, error) {
+				return Delete(`$..*`, src, config)
+			},
+			expectedJSON:  `{}`,
+			expectedCount: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var src, original interface{}
+		json.Unmarshal([]byte(testCase.inputJSON), &src)
+		json.Unmarshal([]byte(testCase.inputJSON), &original)
+
+		recorder := &PatchRecorder{}
+		config := Config{}
+		config.SetPatchRecorder(recorder)
+		src, count, err := testCase.modifyFunc(src, config)
+		if err != nil {
+			t.Errorf("input<%s> : %s\n", testCase.inputJSON, err)
+			continue
+		}
+
+		actualJSON, _ := json.Marshal(src)
+		if string(actualJSON) != testCase.expectedJSON {
+			t.Errorf("input<%s> : expected<%s> != actual<%s>\n", testCase.inputJSON, testCase.expectedJSON, actualJSON)
+		}
+		if count != testCase.expectedCount || count != len(recorder.Operations()) {
+			t.Errorf("input<%s> : expected count<%d> != actual count<%d>, operations<%d>\n",
+				testCase.inputJSON, testCase.expectedCount, count, len(recorder.Operations()))
+		}
+
+		patched, err := ApplyPatch(original, recorder.Operations())
+		if patchedJSON, _ := json.Marshal(patched); err != nil || string(patchedJSON) != testCase.expectedJSON {
+			t.Errorf("input<%s> : ApplyPatch expected<%s> != actual<%s, %v>\n",
+				testCase.inputJSON, testCase.expectedJSON, patchedJSON, err)
+		}
+	}
+}
+
+func TestSet_notSettable(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[1,2],"b":3}`), &src)
+
+	config := Config{}
+	config.SetAggregateFunction(`max`, func(params []interface{}) (interface{}, error) {
+		return params[0], nil
+	})
+
+	_, count, err := Set(`$['b','a'].max()`, src, 0, config)
+	expectedErr := ErrorNotSettable{operation: `set`, path: `$`}
+	if err != expectedErr {
+		t.Errorf("expected error<%s> != actual error<%s>\n", expectedErr, err)
+	}
+	if count != 0 {
+		t.Errorf("expected count<0> != actual count<%d>\n", count)
+	}
+}
+
+func TestSet_goValue(t *testing.T) {
+	type testValue struct {
+		A int
+		B []int8
+		C uint
+	}
+	testCases := []struct {
+		jsonpath      string
+		value         interface{}
+		expected      testValue
+		expectedCount int
+		expectedErr   error
+	}{
+		{
+			jsonpath:      `$.A`,
+			value:         2.,
+			expected:      testValue{A: 2, B: []int8{1}},
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$.B[0]`,
+			value:         127,
+			expected:      testValue{A: 1, B: []int8{127}},
+			expectedCount: 1,
+		},
+		{
+			jsonpath: `$.A`,
+			value:    `x`,
+			expected: testValue{A: 1, B: []int8{1}},
+			expectedErr: ErrorNotSettable{
+				operation: `set`, path: `$['A']`, reason: `string cannot be assigned to int`},
+		},
+		{
+			jsonpath: `$.A`,
+			value:    1.5,
+			expected: testValue{A: 1, B: []int8{1}},
+			expectedErr: ErrorNotSettable{
+				operation: `set`, path: `$['A']`, reason: `1.5 cannot be converted into int without loss`},
+		},
+		{
+			jsonpath: `$.B[0]`,
+			value:    -129,
+			expected: testValue{A: 1, B: []int8{1}},
+			expectedErr: ErrorNotSettable{
+				operation: `set`, path: `$['B'][0]`, reason: `-129 cannot be converted into int8 without loss`},
+		},
+		{
+			jsonpath: `$.B[0]`,
+			value:    128,
+			expected: testValue{A: 1, B: []int8{1}},
+			expectedErr: ErrorNotSettable{
+				operation: `set`, path: `$['B'][0]`, reason: `128 cannot be converted into int8 without loss`},
+		},
+		{
+			jsonpath: `$.C`,
+			value:    -1,
+			expected: testValue{A: 1, B: []int8{1}},
+			expectedErr: ErrorNotSettable{
+				operation: `set`, path: `$['C']`, reason: `-1 cannot be converted into uint without loss`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		src := &testValue{A: 1, B: []int8{1}}
+		_, count, err := Set(testCase.jsonpath, src, testCase.value)
+		if !reflect.DeepEqual(*src, testCase.expected) || count != testCase.expectedCount ||
+			!reflect.DeepEqual(err, testCase.expectedErr) {
+			t.Errorf("jsonpath<%s> value<%v>: expected<%v, %d, %v> != actual<%v, %d, %v>\n",
+				testCase.jsonpath, testCase.value,
+				testCase.expected, testCase.expectedCount, testCase.expectedErr, *src, count, err)
+		}
+	}
+
+	src := &testValue{A: 1}
+	_, count, err := Update(`$.A`, src, func(value interface{}) (interface{}, error) {
+		return fmt.Sprint(value), nil
+	})
+	expectedErr := ErrorNotSettable{operation: `update`, path: `$['A']`, reason: `string cannot be assigned to int`}
+	if src.A != 1 || count != 0 || err != expectedErr {
+		t.Errorf("expected<1, 0, %s> != actual<%d, %d, %s>\n", expectedErr, src.A, count, err)
+	}
+
+	srcMap := map[string]string{`a`: `x`}
+	_, count, err = Set(`$.a`, srcMap, 1)
+	expectedErr = ErrorNotSettable{operation: `set`, path: `$['a']`, reason: `int cannot be assigned to string`}
+	if srcMap[`a`] != `x` || count != 0 || err != expectedErr {
+		t.Errorf("expected<x, 0, %s> != actual<%s, %d, %s>\n", expectedErr, srcMap[`a`], count, err)
+	}
+
+	_, count, err = Set(`$.A`, testValue{A: 1}, 2)
+	expectedErr = ErrorNotSettable{operation: `set`, path: `$['A']`, reason: `the Go value is not addressable`}
+	if count != 0 || err != expectedErr {
+		t.Errorf("expected<0, %s> != actual<%d, %s>\n", expectedErr, count, err)
+	}
+
+	config := Config{}
+	config.SetAccessorMode()
+	results, _ := Retrieve(`$.A`, src, config)
+	results[0].(Accessor).Set(`x`)
+	if src.A != 1 {
+		t.Errorf("expected<1> != actual<%d>\n", src.A)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	execTestModifyTestCases(t, []modifyTestCase{
+		{
+			jsonpath:      `$..price`,
+			inputJSON:     `{"a":{"price":1},"b":[{"price":2}]}`,
+			modifyFunc:    createUpdateFunction(incrementFunction),
+			expectedJSON:  `{"a":{"price":2},"b":[{"price":3}]}`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:      `$`,
+			inputJSON:     `1`,
+			modifyFunc:    createUpdateFunction(incrementFunction),
+			expectedJSON:  `2`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$[*]`,
+			inputJSON:     `[1,"a",3]`,
+			modifyFunc:    createUpdateFunction(incrementFunction),
+			expectedJSON:  `[2,"a",3]`,
+			expectedCount: 1,
+			expectedErr:   fmt.Errorf(`not a number`),
+		},
+		{
+			jsonpath:      `$[0,0,*]`,
+			inputJSON:     `[1,2]`,
+			modifyFunc:    createUpdateFunction(incrementFunction),
+			expectedJSON:  `[2,3]`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:     `$.b`,
+			inputJSON:    `{"a":1}`,
+			modifyFunc:   createUpdateFunction(incrementFunction),
+			expectedJSON: `{"a":1}`,
+			expectedErr:  createErrorMemberNotExist(`.b`),
+		},
+	})
+}
+
+func TestDelete(t *testing.T) {
+	execTestModifyTestCases(t, []modifyTestCase{
+		{
+			jsonpath:      `$..password`,
+			inputJSON:     `{"password":1,"users":[{"name":"a","password":2}]}`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `{"users":[{"name":"a"}]}`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:      `$[0,2]`,
+			inputJSON:     `[1,2,3]`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `[2]`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:      `$[0,0]`,
+			inputJSON:     `[1,2,3,4]`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `[2,3,4]`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$[0,-3,1]`,
+			inputJSON:     `[1,2,3]`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `[3]`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:      `$[?(@.a > 1)]`,
+			inputJSON:     `[{"a":1},{"a":2},{"a":3}]`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `[{"a":1}]`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:      `$..*`,
+			inputJSON:     `{"a":[1,{"b":2}]}`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `{}`,
+			expectedCount: 1,
+		},
+		{
+			jsonpath:      `$..[0]`,
+			inputJSON:     `{"a":[[1,2],3],"b":[4]}`,
+			modifyFunc:    deleteFunction,
+			expectedJSON:  `{"a":[3],"b":[]}`,
+			expectedCount: 2,
+		},
+		{
+			jsonpath:     `$`,
+			inputJSON:    `{"a":1}`,
+			modifyFunc:   deleteFunction,
+			expectedJSON: `{"a":1}`,
+			expectedErr:  ErrorNotSettable{operation: `delete`, path: `$`},
+		},
+		{
+			jsonpath:     `$.b`,
+			inputJSON:    `{"a":1}`,
+			modifyFunc:   deleteFunction,
+			expectedJSON: `{"a":1}`,
+			expectedErr:  createErrorMemberNotExist(`.b`),
+		},
+	})
+}
+
+func TestDelete_goValue(t *testing.T) {
+	src := &struct {
+		M map[string]int
+		S []int
+	}{M: map[string]int{`a`: 1, `b`: 2}, S: []int{1, 2}}
+
+	_, count, err := Delete(`$.M.a`, src)
+	if !reflect.DeepEqual(src.M, map[string]int{`b`: 2}) || count != 1 || err != nil {
+		t.Errorf("expected<map[b:2], 1, <nil>> != actual<%v, %d, %v>\n", src.M, count, err)
+	}
+
+	_, count, err = Delete(`$.S[0]`, src)
+	expectedErr := ErrorNotSettable{
+		operation: `delete`,
+		path:      `$['S'][0]`,
+		reason:    `the Go value cannot be removed from the struct, the slice or the array`,
+	}
+	if !reflect.DeepEqual(src.S, []int{1, 2}) || count != 0 || err != expectedErr {
+		t.Errorf("expected<[1 2], 0, %s> != actual<%v, %d, %s>\n", expectedErr, src.S, count, err)
+	}
+}
+
+func TestPatchRecorder(t *testing.T) {
+	testCases := []struct {
+		inputJSON         string
+		modifyFunc        func(interface{}, Config) interface{}
+		expectedPatchJSON string
+	}{
+		{
+			inputJSON: `{"a":1,"b":[1,2]}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				config.SetAccessorMode()
+				results, _ := Retrieve(`$.*`, src, config)
+				results[0].(Accessor).Set(2)
+				results[1].(Accessor).Delete()
+				return src
+			},
+			expectedPatchJSON: `[{"op":"replace","path":"/a","value":2},{"op":"remove","path":"/b"}]`,
+		},
+		{
+			inputJSON: `{"a":[1,2,3,4]}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Delete(`$.a[3,0,2]`, src, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"remove","path":"/a/3"},{"op":"remove","path":"/a/0"},{"op":"remove","path":"/a/1"}]`,
+		},
+		{
+			inputJSON: `[[1,2],[3,4]]`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Delete(`$..[0]`, src, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"remove","path":"/0"},{"op":"remove","path":"/0/0"}]`,
+		},
+		{
+			inputJSON: `[[1,2],[3,4],[5]]`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Delete(`$[0,2]`, src, config)
+				src, _, _ = Delete(`$[0][1]`, src, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"remove","path":"/0"},{"op":"remove","path":"/1"},{"op":"remove","path":"/0/1"}]`,
+		},
+		{
+			inputJSON: `{"a":[1,{"b":2}],"c":3}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Delete(`$..*`, src, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"remove","path":"/a"},{"op":"remove","path":"/c"}]`,
+		},
+		{
+			inputJSON: `{"a":[1,{"b":2}],"c":3}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				config.SetAccessorMode()
+				results, _ := Retrieve(`$..*`, src, config)
+				for _, result := range results {
+					result.(Accessor).Delete()
+				}
+				return src
+			},
+			expectedPatchJSON: `[{"op":"remove","path":"/a"},{"op":"remove","path":"/c"}]`,
+		},
+		{
+			inputJSON: `{"a~b":{"c/d":1}}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$['a~b']['c/d']`, src, 2, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"replace","path":"/a~0b/c~1d","value":2}]`,
+		},
+		{
+			inputJSON: `{"a":{},"b":null,"c":[1]}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$.a.x.y`, src, 1, config)
+				src, _, _ = Set(`$.b.x`, src, 2, config)
+				src, _, _ = Set(`$.c[2]`, src, 3, config)
+				src, _, _ = Set(`$.a.z`, src, nil, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"add","path":"/a/x","value":{"y":1}},` +
+				`{"op":"replace","path":"/b","value":{"x":2}},` +
+				`{"op":"add","path":"/c/1","value":null},{"op":"add","path":"/c/2","value":3},` +
+				`{"op":"add","path":"/a/z","value":null}]`,
+		},
+		{
+			inputJSON: `[1]`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$[2]`, src, 2, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"add","path":"/1","value":null},{"op":"add","path":"/2","value":2}]`,
+		},
+		{
+			inputJSON: `[]`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$[0]`, src, 1, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"add","path":"/0","value":1}]`,
+		},
+		{
+			inputJSON: `[]`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$[0].a`, src, 1, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"add","path":"/0","value":{"a":1}}]`,
+		},
+		{
+			inputJSON: `null`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$.a`, src, 1, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"replace","path":"","value":{"a":1}}]`,
+		},
+		{
+			inputJSON: `{"a":1}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Set(`$`, src, []interface{}{1}, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"replace","path":"","value":[1]}]`,
+		},
+		{
+			inputJSON: `{"a":[{"b":1},{"b":2}]}`,
+			modifyFunc: func(src interface{}, config Config) interface{} {
+				src, _, _ = Update(`$.a[*].b`, src, func(value interface{}) (interface{}, error) {
+					return value.(float64) * 10, nil
+				}, config)
+				return src
+			},
+			expectedPatchJSON: `[{"op":"replace","path":"/a/0/b","value":10},{"op":"replace","path":"/a/1/b","value":20}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var src, original interface{}
+		json.Unmarshal([]byte(testCase.inputJSON), &src)
+		json.Unmarshal([]byte(testCase.inputJSON), &original)
+
+		recorder := &PatchRecorder{}
+		config := Config{}
+		config.SetPatchRecorder(recorder)
+		src = testCase.modifyFunc(src, config)
+
+		actualPatchJSON, _ := json.Marshal(recorder.Operations())
+		if string(actualPatchJSON) != testCase.expectedPatchJSON {
+			t.Errorf("expectedPatchJSON<%s> != actualPatchJSON<%s>\n", testCase.expectedPatchJSON, actualPatchJSON)
+			continue
+		}
+
+		patched, err := ApplyPatch(original, recorder.Operations())
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		expectedJSON, _ := json.Marshal(src)
+		actualJSON, _ := json.Marshal(patched)
+		if string(actualJSON) != string(expectedJSON) {
+			t.Errorf("ApplyPatch : expect<%s> != actual<%s>\n", expectedJSON, actualJSON)
+		}
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	testCases := []struct {
+		inputJSON    string
+		patchJSON    string
+		expectedJSON string
+		expectedErr  error
+	}{
+		{
+			inputJSON:    `{"a":1}`,
+			patchJSON:    `[{"op":"add","path":"/b","value":[1]},{"op":"add","path":"/b/0","value":0},{"op":"add","path":"/b/-","value":2}]`,
+			expectedJSON: `{"a":1,"b":[0,1,2]}`,
+		},
+		{
+			inputJSON:    `{"a":[1,2,3],"b":1}`,
+			patchJSON:    `[{"op":"remove","path":"/a/1"},{"op":"remove","path":"/b"}]`,
+			expectedJSON: `{"a":[1,3]}`,
+		},
+		{
+			inputJSON:    `{"a":[1,2],"b":1}`,
+			patchJSON:    `[{"op":"replace","path":"/a/1","value":3},{"op":"replace","path":"/b","value":null}]`,
+			expectedJSON: `{"a":[1,3],"b":null}`,
+		},
+		{
+			inputJSON:    `{"a":{"b":1},"c":[]}`,
+			patchJSON:    `[{"op":"move","from":"/a/b","path":"/c/0"},{"op":"copy","from":"/c","path":"/d"}]`,
+			expectedJSON: `{"a":{},"c":[1],"d":[1]}`,
+		},
+		{
+			inputJSON:    `{"a":{"b":[1]}}`,
+			patchJSON:    `[{"op":"test","path":"/a","value":{"b":[1]}},{"op":"replace","path":"","value":2}]`,
+			expectedJSON: `2`,
+		},
+		{
+			inputJSON:   `{"a":1}`,
+			patchJSON:   `[{"op":"test","path":"/a","value":2}]`,
+			expectedErr: ErrorPatchFailed{op: `test`, path: `/a`, reason: `test failed`},
+		},
+		{
+			inputJSON:   `{"a":1}`,
+			patchJSON:   `[{"op":"replace","path":"/b","value":2}]`,
+			expectedErr: ErrorPatchFailed{op: `replace`, path: `/b`, reason: `member did not exist`},
+		},
+		{
+			inputJSON:   `{"a":[1]}`,
+			patchJSON:   `[{"op":"add","path":"/a/2","value":2}]`,
+			expectedErr: ErrorPatchFailed{op: `add`, path: `/a/2`, reason: `index out of range`},
+		},
+		{
+			inputJSON:   `{"a":[1]}`,
+			patchJSON:   `[{"op":"remove","path":"/a/01"}]`,
+			expectedErr: ErrorPatchFailed{op: `remove`, path: `/a/01`, reason: `index out of range`},
+		},
+		{
+			inputJSON:   `{"a":{"b":1}}`,
+			patchJSON:   `[{"op":"add","path":"/x/y","value":2}]`,
+			expectedErr: ErrorPatchFailed{op: `add`, path: `/x/y`, reason: `member did not exist`},
+		},
+		{
+			inputJSON:   `{"a":{"b":1}}`,
+			patchJSON:   `[{"op":"move","from":"/a","path":"/a/c"}]`,
+			expectedErr: ErrorPatchFailed{op: `move`, path: `/a/c`, reason: `moved into its child`},
+		},
+		{
+			inputJSON:   `{"a":1}`,
+			patchJSON:   `[{"op":"add","path":"a","value":2}]`,
+			expectedErr: ErrorPatchFailed{op: `add`, path: `a`, reason: `invalid pointer`},
+		},
+		{
+			inputJSON:   `{"a":1}`,
+			patchJSON:   `[{"op":"remove","path":"/~2"}]`,
+			expectedErr: ErrorPatchFailed{op: `remove`, path: `/~2`, reason: `invalid pointer`},
+		},
+		{
+			inputJSON:   `{"a":1}`,
+			patchJSON:   `[{"op":"remove","path":""}]`,
+			expectedErr: ErrorPatchFailed{op: `remove`, path: ``, reason: `root could not be removed`},
+		},
+		{
+			inputJSON:   `{"a":1}`,
+			patchJSON:   `[{"op":"merge","path":"/a"}]`,
+			expectedErr: ErrorPatchFailed{op: `merge`, path: `/a`, reason: `unknown op`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var src interface{}
+		var operations []PatchOperation
+		json.Unmarshal([]byte(testCase.inputJSON), &src)
+		if err := json.Unmarshal([]byte(testCase.patchJSON), &operations); err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		actual, err := ApplyPatch(src, operations)
+		if err != nil || testCase.expectedErr != nil {
+			if err != testCase.expectedErr {
+				t.Errorf("expected error<%s> != actual error<%s>\n", testCase.expectedErr, err)
+			}
+			continue
+		}
+
+		actualJSON, _ := json.Marshal(actual)
+		if string(actualJSON) != testCase.expectedJSON {
+			t.Errorf("expectedJSON<%s> != actualJSON<%s>\n", testCase.expectedJSON, actualJSON)
+		}
+	}
+}
+
+func TestToPointer(t *testing.T) {
+	testCases := []struct {
+		jsonpath        string
+		expectedPointer string
+		expectedErr     error
+	}{
+		{jsonpath: `$`, expectedPointer: ``},
+		{jsonpath: `$.a.b`, expectedPointer: `/a/b`},
+		{jsonpath: `$['a/b']["c~d"]`, expectedPointer: `/a~1b/c~0d`},
+		{jsonpath: `$[0].a[12]`, expectedPointer: `/0/a/12`},
+		{jsonpath: `$['']`, expectedPointer: `/`},
+		{jsonpath: `$[-1]`, expectedErr: ErrorNotDefinite{path: `$[-1]`, near: `[-1]`}},
+		{jsonpath: `$.a[0,1]`, expectedErr: ErrorNotDefinite{path: `$.a[0,1]`, near: `[0,1]`}},
+		{jsonpath: `$.a[0:1]`, expectedErr: ErrorNotDefinite{path: `$.a[0:1]`, near: `[0:1]`}},
+		{jsonpath: `$['a','b']`, expectedErr: ErrorNotDefinite{path: `$['a','b']`, near: `['a','b']`}},
+		{jsonpath: `$.*`, expectedErr: ErrorNotDefinite{path: `$.*`, near: `.*`}},
+		{jsonpath: `$..a`, expectedErr: ErrorNotDefinite{path: `$..a`, near: `..`}},
+		{jsonpath: `$[?(@.a)]`, expectedErr: ErrorNotDefinite{path: `$[?(@.a)]`, near: `[?(@.a)]`}},
+		{jsonpath: `$.a[*]`, expectedErr: ErrorNotDefinite{path: `$.a[*]`, near: `[*]`}},
+		{jsonpath: `$[`, expectedErr: ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[`}},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := ToPointer(testCase.jsonpath)
+		if err != nil || testCase.expectedErr != nil {
+			if err != testCase.expectedErr {
+				t.Errorf("jsonpath<%s>: expected error<%s> != actual error<%s>\n",
+					testCase.jsonpath, testCase.expectedErr, err)
+			}
+			continue
+		}
+		if actual != testCase.expectedPointer {
+			t.Errorf("jsonpath<%s>: expected pointer<%s> != actual pointer<%s>\n",
+				testCase.jsonpath, testCase.expectedPointer, actual)
+		}
+	}
+}
+
+func TestFromPointer(t *testing.T) {
+	testCases := []struct {
+		pointer          string
+		expectedJSONPath string
+		expectedErr      error
+	}{
+		{pointer: ``, expectedJSONPath: `$`},
+		{pointer: `/a/0/b`, expectedJSONPath: `$['a'][0]['b']`},
+		{pointer: `/a~1b/c~0d`, expectedJSONPath: `$['a/b']['c~d']`},
+		{pointer: `/01/-/`, expectedJSONPath: `$['01']['-']['']`},
+		{pointer: `/it's\`, expectedJSONPath: `$['it\'s\\']`},
+		{pointer: `/~01/~10`, expectedJSONPath: `$['~1']['/0']`},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := FromPointer(testCase.pointer)
+		if err != nil {
+			t.Errorf("pointer<%s>: unexpected error<%s>\n", testCase.pointer, err)
+			continue
+		}
+		if actual != testCase.expectedJSONPath {
+			t.Errorf("pointer<%s>: expected jsonpath<%s> != actual jsonpath<%s>\n",
+				testCase.pointer, testCase.expectedJSONPath, actual)
+			continue
+		}
+		if pointer, err := ToPointer(actual); err != nil || pointer != testCase.pointer {
+			t.Errorf("jsonpath<%s>: expected pointer<%s> != actual pointer<%s>, error<%v>\n",
+				actual, testCase.pointer, pointer, err)
+		}
+	}
+
+	for _, pointer := range []string{`a`, `/~2`, `/a/b~`, `/~~0`} {
+		if _, err := FromPointer(pointer); err == nil {
+			t.Errorf("expected error for the pointer<%s>\n", pointer)
+		} else if _, ok := err.(ErrorInvalidArgument); !ok {
+			t.Errorf("pointer<%s>: expected ErrorInvalidArgument != actual error<%s>\n", pointer, err)
+		}
+	}
+}
+
+func TestPathValue_Pointer(t *testing.T) {
+	config := Config{}
+	config.SetPathMode()
+	var src interface{}
+	json.Unmarshal([]byte(`{"a/b":[1,{"c~":2}]}`), &src)
+	results, err := Retrieve(`$..*`, src, config)
+	if err != nil {
+		t.Errorf("%s", err)
+		return
+	}
+	expectedPointers := []string{`/a~1b`, `/a~1b/0`, `/a~1b/1`, `/a~1b/1/c~0`}
+	if len(results) != len(expectedPointers) {
+		t.Errorf("expected length<%d> != actual length<%d>\n", len(expectedPointers), len(results))
+		return
+	}
+	for index, result := range results {
+		if pointer := result.(PathValue).Pointer(); pointer != expectedPointers[index] {
+			t.Errorf("expected pointer<%s> != actual pointer<%s>\n", expectedPointers[index], pointer)
+		}
+	}
+}
+
+func TestQuerySet(t *testing.T) {
+	jsonPaths := []string{
+		`$`,
+		`$.a`,
+		`$.a.b`,
+		`$.a.b[0]`,
+		`$.a.b[1].c`,
+		`$.a.b[5]`,
+		`$.a.b[-1]`,
+		`$.a.b[*]`,
+		`$.a.x`,
+		`$.a.x.y`,
+		`$.a.b.c`,
+		`$.a['b','d']`,
+		`$.a..c`,
+		`$.a.d[?(@.e>1)].e`,
+		`$.a.d[0].e.f`,
+		`$.s.name`,
+		`$.s.missing`,
+		`$[0]`,
+		`@.a`,
+	}
+
+	configFuncs := []func(config *Config){
+		func(config *Config) {},
+		func(config *Config) { config.SetPathMode() },
+		func(config *Config) { config.SetStrictMode() },
+	}
+
+	for _, configFunc := range configFuncs {
+		config := Config{}
+		configFunc(&config)
+
+		var src interface{}
+		json.Unmarshal([]byte(`{"a":{"b":[1,{"c":2}],"d":[{"e":1},{"e":2}]}}`), &src)
+		src.(map[string]interface{})["s"] = struct {
+			Name string `json:"name"`
+		}{Name: `x`}
+
+		var querySetPaths []string
+		for _, jsonPath := range jsonPaths {
+			if _, err := Parse(jsonPath, config); err == nil {
+				querySetPaths = append(querySetPaths, jsonPath)
+			}
+		}
+
+		querySet, err := NewQuerySet(querySetPaths, config)
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		if len(querySet.root.members) != 2 || len(querySet.root.indexes) != 1 {
+			t.Errorf("expected shared prefix != actual members<%d>, indexes<%d>\n",
+				len(querySet.root.members), len(querySet.root.indexes))
+		}
+		results, errs := querySet.Retrieve(src)
+		if len(results) != len(querySetPaths) || len(errs) != len(querySetPaths) {
+			t.Errorf("expected length<%d> != actual length<%d, %d>\n",
+				len(querySetPaths), len(results), len(errs))
+			continue
+		}
+
+		for index, jsonPath := range querySetPaths {
+			expected, expectedErr := Retrieve(jsonPath, src, config)
+			if !reflect.DeepEqual(errs[index], expectedErr) {
+				t.Errorf("jsonpath<%s>: expected error<%v> != actual error<%v>\n",
+					jsonPath, expectedErr, errs[index])
+			}
+			if !reflect.DeepEqual(results[index], expected) {
+				t.Errorf("jsonpath<%s>: expected result<%v> != actual result<%v>\n",
+					jsonPath, expected, results[index])
+			}
+		}
+	}
+}
+
+func TestQuerySet_error(t *testing.T) {
+	if _, err := NewQuerySet([]string{`$.a`, `$.b[`}); err == nil {
+		t.Errorf("expected syntax error\n")
+	} else if _, ok := err.(ErrorInvalidSyntax); !ok {
+		t.Errorf("expected ErrorInvalidSyntax != actual error<%s>\n", err)
+	}
+
+	config := Config{}
+	config.SetAccessorMode()
+	expectedErr := ErrorNotSupported{feature: `accessor mode with query set`, path: `$.a`}
+	if _, err := NewQuerySet([]string{`$.a`}, config); err != expectedErr {
+		t.Errorf("expected error<%s> != actual error<%s>\n", expectedErr, err)
+	}
+}
+
+func formatTestAST(node *ASTNode) string {
+	text := node.Kind().String()
+	if node.Value() != nil {
+		text += fmt.Sprintf(`<%v>`, node.Value())
+	}
+	var children []string
+	for _, child := range node.Children() {
+		children = append(children, formatTestAST(child))
+	}
+	if len(children) > 0 {
+		text += `(` + strings.Join(children, `, `) + `)`
+	}
+	return text
+}
+
+func TestParseQuery(t *testing.T) {
+	config := Config{}
+	config.SetFilterFunction(`twice`, func(value interface{}) (interface{}, error) {
+		return value.(float64) * 2, nil
+	})
+	config.SetAggregateFunction(`max`, func(values []interface{}) (interface{}, error) {
+		return values[0], nil
+	})
+	strictConfig := Config{}
+	strictConfig.SetStrictMode()
+
+	testCases := []struct {
+		jsonpath          string
+		config            Config
+		expectedDefinite  bool
+		expectedFunctions []string
+		expectedAST       string
+	}{
+		{
+			jsonpath:         `$`,
+			expectedDefinite: true,
+			expectedAST:      `Path(Root)`,
+		},
+		{
+			jsonpath:         `a['b'][0]`,
+			expectedDefinite: true,
+			expectedAST:      `Path(Root, Child<a>, Child<b>, Index<0>)`,
+		},
+		{
+			jsonpath:    `$..a[0,1:3,::-1,*]['x','y'].*`,
+			expectedAST: `Path(Root, Descendant, Child<a>, Union(Index<0>, Slice(Index<1>, Index<3>, Index<1>), Slice(Index, Index, Index<-1>), Wildcard), Union(Child<x>, Child<y>), Wildcard)`,
+		},
+		{
+			jsonpath:    `$[?(@.a<1 && $.b!=@.c || !@.d)]`,
+			expectedAST: `Path(Root, Filter(Or(And(Comparison<<>(Path(Current, Child<a>), Literal<1>), Comparison<!=>(Path(Root, Child<b>), Path(Current, Child<c>))), Not(Path(Current, Child<d>)))))`,
+		},
+		{
+			jsonpath:    `$[?(@.a>=1 && @.b=~/ab+/)]`,
+			expectedAST: `Path(Root, Filter(And(Comparison<>=>(Path(Current, Child<a>), Literal<1>), Comparison<=~>(Path(Current, Child<b>), Literal<ab+>))))`,
+		},
+		{
+			jsonpath:         `$[(@.length-1)]`,
+			expectedDefinite: true,
+			expectedAST:      `Path(Root, Script(Arithmetic<->(Path(Current, Length), Literal<1>)))`,
+		},
+		{
+			jsonpath:          `$[?(@.a.max()>1)].b.twice().max().twice()`,
+			config:            config,
+			expectedDefinite:  true,
+			expectedFunctions: []string{`max`, `twice`},
+			expectedAST:       `Path(Root, Filter(Comparison<>>(Path(Current, Child<a>, AggregateFunction<max>), Literal<1>)), Child<b>, FilterFunction<twice>, AggregateFunction<max>, FilterFunction<twice>)`,
+		},
+		{
+			jsonpath:    `$[?@.a<=1 && !(@.b==2) && match(@.c, 'x.*')]`,
+			config:      strictConfig,
+			expectedAST: `Path(Root, Filter(And(And(Comparison<<=>(Path(Current, Child<a>), Literal<1>), Comparison<!=>(Path(Current, Child<b>), Literal<2>)), FunctionExtension<match>(Path(Current, Child<c>), Literal<x.*>))))`,
+		},
+		{
+			jsonpath:    `$['a',1,?@.b]`,
+			config:      strictConfig,
+			expectedAST: `Path(Root, Union(Child<a>, Index<1>, Filter(Path(Current, Child<b>))))`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		query, err := ParseQuery(testCase.jsonpath, testCase.config)
+		if err != nil {
+			t.Errorf("jsonpath<%s>: %s\n", testCase.jsonpath, err)
+			continue
+		}
+		if query.String() != testCase.jsonpath {
+			t.Errorf("expected string<%s> != actual string<%s>\n", testCase.jsonpath, query.String())
+		}
+		if query.IsDefinite() != testCase.expectedDefinite {
+			t.Errorf("jsonpath<%s>: expected definite<%t> != actual definite<%t>\n",
+				testCase.jsonpath, testCase.expectedDefinite, query.IsDefinite())
+		}
+		if !reflect.DeepEqual(query.Functions(), testCase.expectedFunctions) {
+			t.Errorf("jsonpath<%s>: expected functions<%v> != actual functions<%v>\n",
+				testCase.jsonpath, testCase.expectedFunctions, query.Functions())
+		}
+		if actualAST := formatTestAST(query.AST()); actualAST != testCase.expectedAST {
+			t.Errorf("jsonpath<%s>: expected AST<%s> != actual AST<%s>\n",
+				testCase.jsonpath, testCase.expectedAST, actualAST)
+		}
+	}
+}
+
+func TestQuery_Execute(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[1,2,{"b":3}]}`), &src)
+
+	for _, jsonPath := range []string{`$.a[*]`, `$.a[2].b`, `$.x`, `$.a.b`} {
+		query, err := ParseQuery(jsonPath)
+		if err != nil {
+			t.Errorf("jsonpath<%s>: %s\n", jsonPath, err)
+			continue
+		}
+		expected, expectedErr := Retrieve(jsonPath, src)
+		actual, err := query.Execute(src)
+		if !reflect.DeepEqual(actual, expected) || !reflect.DeepEqual(err, expectedErr) {
+			t.Errorf("jsonpath<%s>: expected<%v, %v> != actual<%v, %v>\n",
+				jsonPath, expected, expectedErr, actual, err)
+		}
+	}
+
+	config := Config{}
+	config.SetUpsertMode()
+	expectedErr := ErrorNotSupported{feature: `upsert mode with value group`, path: `$.a[*]`}
+	if _, err := ParseQuery(`$.a[*]`, config); err != expectedErr {
+		t.Errorf("expected error<%s> != actual error<%s>\n", expectedErr, err)
+	}
+}
+
+type testASTVisitor struct {
+	jsonPath []rune
+	texts    *[]string
+	skip     ASTKind
+}
+
+func (v testASTVisitor) Visit(node *ASTNode) Visitor {
+	if node == nil {
+		*v.texts = append(*v.texts, `)`)
+		return nil
+	}
+	text := node.Kind().String()
+	if node.Position() >= 0 {
+		text += `:` + string(v.jsonPath[node.Position():node.End()])
+	}
+	*v.texts = append(*v.texts, text)
+	if node.Kind() == v.skip {
+		return nil
+	}
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	config := Config{}
+	config.SetFilterFunction(`twice`, func(value interface{}) (interface{}, error) {
+		return value.(float64) * 2, nil
+	})
+	strictConfig := Config{}
+	strictConfig.SetStrictMode()
+
+	testCases := []struct {
+		jsonpath string
+		config   Config
+		skip     ASTKind
+		expected []string
+	}{
+		{
+			jsonpath: `$.a`,
+			skip:     -1,
+			expected: []string{`Path:$.a`, `Root:$`, `)`, `Child:.a`, `)`, `)`},
+		},
+		{
+			jsonpath: ` a[0] `,
+			skip:     -1,
+			expected: []string{`Path:a[0]`, `Root`, `)`, `Child:a`, `)`, `Index:[0]`, `)`, `)`},
+		},
+		{
+			jsonpath: `$..['a','b'][1:]`,
+			skip:     -1,
+			expected: []string{`Path:$..['a','b'][1:]`, `Root:$`, `)`, `Descendant:..`, `)`,
+				`Union:['a','b']`, `Child:'a'`, `)`, `Child:'b'`, `)`, `)`,
+				`Slice:[1:]`, `Index:1`, `)`, `Index:`, `)`, `Index`, `)`, `)`, `)`},
+		},
+		{
+			jsonpath: `$[?(@.a!=1 || @.b=~/x/)].twice()`,
+			config:   config,
+			skip:     ASTPath,
+			expected: []string{`Path:$[?(@.a!=1 || @.b=~/x/)].twice()`},
+		},
+		{
+			jsonpath: `$[?(@.a!=1 || @.b=~/x/)].twice()`,
+			config:   config,
+			skip:     ASTComparison,
+			expected: []string{`Path:$[?(@.a!=1 || @.b=~/x/)].twice()`, `Root:$`, `)`,
+				`Filter:[?(@.a!=1 || @.b=~/x/)]`, `Or:@.a!=1 || @.b=~/x/`,
+				`Comparison:@.a!=1`, `Comparison:@.b=~/x/`, `)`, `)`,
+				`FilterFunction:.twice()`, `)`, `)`},
+		},
+		{
+			jsonpath: `$[(@.length-1)]`,
+			skip:     -1,
+			expected: []string{`Path:$[(@.length-1)]`, `Root:$`, `)`,
+				`Script:[(@.length-1)]`, `Arithmetic:@.length-1`,
+				`Path:@.length`, `Current:@`, `)`, `Length:.length`, `)`, `)`, `Literal:1`, `)`, `)`, `)`, `)`},
+		},
+		{
+			jsonpath: `$[?!(@.a == 1) && length(@.b) > 2]`,
+			config:   strictConfig,
+			skip:     ASTPath,
+			expected: []string{`Path:$[?!(@.a == 1) && length(@.b) > 2]`},
+		},
+		{
+			jsonpath: `$[?!(@.a == 1) && length(@.b) > 2]`,
+			config:   strictConfig,
+			skip:     ASTFunctionExtension,
+			expected: []string{`Path:$[?!(@.a == 1) && length(@.b) > 2]`, `Root:$`, `)`,
+				`Filter:[?!(@.a == 1) && length(@.b) > 2]`, `And:!(@.a == 1) && length(@.b) > 2`,
+				`Comparison:!(@.a == 1)`, `Path:@.a`, `Current:@`, `)`, `Child:.a`, `)`, `)`, `Literal:1`, `)`, `)`,
+				`Comparison:length(@.b) > 2`, `FunctionExtension:length(@.b)`, `Literal:2`, `)`, `)`, `)`, `)`, `)`},
 		},
 	}
 
-	execTestRetrieveTestGroups(t, testGroups)
+	for _, testCase := range testCases {
+		query, err := ParseQuery(testCase.jsonpath, testCase.config)
+		if err != nil {
+			t.Errorf("jsonpath<%s>: %s\n", testCase.jsonpath, err)
+			continue
+		}
+		var texts []string
+		Walk(query.AST(), testASTVisitor{jsonPath: []rune(testCase.jsonpath), texts: &texts, skip: testCase.skip})
+		if !reflect.DeepEqual(texts, testCase.expected) {
+			t.Errorf("jsonpath<%s>: expected<%v> != actual<%v>\n", testCase.jsonpath, testCase.expected, texts)
+		}
+	}
 }
 
-func TestRetrieveExecTwice(t *testing.T) {
-	jsonpath1 := `$.a`
-	srcJSON1 := `{"a":123}`
-	expectedOutput1 := "[123]"
-	jsonpath2 := `$[1].b`
-	srcJSON2 := `[123,{"b":456}]`
-	expectedOutput2 := "[456]"
+func TestFormat(t *testing.T) {
+	config := Config{}
+	config.SetFilterFunction(`twice`, func(value interface{}) (interface{}, error) {
+		return value.(float64) * 2, nil
+	})
+	config.SetAggregateFunction(`max`, func(values []interface{}) (interface{}, error) {
+		return values[0], nil
+	})
+	strictConfig := Config{}
+	strictConfig.SetStrictMode()
+
+	testCases := []struct {
+		jsonpath string
+		config   Config
+		expected string
+	}{
+		{jsonpath: `$`, expected: `$`},
+		{jsonpath: ` a.b `, expected: `$['a']['b']`},
+		{jsonpath: `$.a['b']`, expected: `$['a']['b']`},
+		{jsonpath: `$["a"].b`, expected: `$['a']['b']`},
+		{jsonpath: `$["a'\n"]`, expected: `$['a\'\n']`},
+		{jsonpath: `$..a..*`, expected: `$..['a']..[*]`},
+		{jsonpath: `$[ 0 , 1:3:1 , *, +2 ]`, expected: `$[0,1:3,*,2]`},
+		{jsonpath: `$[::][1::1][:-1:-1]`, expected: `$[:][1:][:-1:-1]`},
+		{jsonpath: `$['a' , "b",*]`, expected: `$['a','b',*]`},
+		{jsonpath: `$[?( @.a < 1 && $.b != "x" || !@.c )]`, expected: `$[?(@['a']<1 && $['b']!='x' || !@['c'])]`},
+		{jsonpath: `$[?(@.a && (@.b || @.c))]`, expected: `$[?(@['a'] && (@['b'] || @['c']))]`},
+		{jsonpath: `$[?(@.a=='a\\b\'c')]`, expected: `$[?(@['a']=='a\\b\'c')]`},
+		{jsonpath: `$[?(@.a=~/a\/b/)]`, expected: `$[?(@['a']=~/a\/b/)]`},
+		{jsonpath: `$[?(1e21>=@.a.max() || @.b==True || @.c==NULL)]`, config: config,
+			expected: `$[?(1e+21>=@['a'].max() || @['b']==true || @['c']==null)]`},
+		{jsonpath: `$.a.twice().max()`, config: config, expected: `$['a'].twice().max()`},
+		{jsonpath: `$[( -$.a.length * (2+1.5) )]`, expected: `$[(-$['a'].length*(2+1.5))]`},
+		{jsonpath: `$[(1-(2-3))]`, expected: `$[(1-(2-3))]`},
+		{jsonpath: `$[((1-2)-3)]`, expected: `$[(1-2-3)]`},
+		{jsonpath: `$.a[?@.b]`, config: strictConfig, expected: `$['a'][?@['b']]`},
+		{jsonpath: `$[ 'a', 1, ?@.b ]`, config: strictConfig, expected: `$['a',1,?@['b']]`},
+		{jsonpath: `$[?!(@.a == 1) && "a\n" == @.b]`, config: strictConfig, expected: `$[?@['a']!=1 && 'a\n'==@['b']]`},
+		{jsonpath: `$[?!(@.a<1 || @.b)]`, config: strictConfig, expected: `$[?!(@['a']<1 || @['b'])]`},
+		{jsonpath: `$[?match( @.a , 'x.*' ) && length(value(@..b)) >= 2]`, config: strictConfig,
+			expected: `$[?match(@['a'],'x.*') && length(value(@..['b']))>=2]`},
+	}
 
-	var src1 interface{}
-	if err := json.Unmarshal([]byte(srcJSON1), &src1); err != nil {
-		t.Errorf("%w", err)
-		return
+	for _, testCase := range testCases {
+		actual, err := Format(testCase.jsonpath, testCase.config)
+		if err != nil {
+			t.Errorf("jsonpath<%s>: %s\n", testCase.jsonpath, err)
+			continue
+		}
+		if actual != testCase.expected {
+			t.Errorf("jsonpath<%s>: expected<%s> != actual<%s>\n", testCase.jsonpath, testCase.expected, actual)
+			continue
+		}
+		if reformatted, err := Format(actual, testCase.config); reformatted != actual || err != nil {
+			t.Errorf("jsonpath<%s>: reformatted<%s, %v> != formatted<%s>\n", testCase.jsonpath, reformatted, err, actual)
+		}
 	}
-	var src2 interface{}
-	if err := json.Unmarshal([]byte(srcJSON2), &src2); err != nil {
-		t.Errorf("%w", err)
-		return
+
+	expectedErr := ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[`}
+	if _, err := Format(`$[`); err != expectedErr {
+		t.Errorf("expected error<%s> != actual error<%s>\n", expectedErr, err)
 	}
+}
 
-	actualObject1, err := Retrieve(jsonpath1, src1)
-	if err != nil {
-		t.Errorf("expected error<nil> != actual error<%s>\n", err)
-		return
+func TestQueryCache(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":1,"b":2,"c":3}`), &src)
+
+	cache := NewQueryCache(2)
+	for _, jsonPath := range []string{`$.a`, `$.b`, `$.a`, `$.c`, `$.b`, `$.a`} {
+		expected, expectedErr := Retrieve(jsonPath, src)
+		actual, err := cache.Retrieve(jsonPath, src)
+		if !reflect.DeepEqual(actual, expected) || !reflect.DeepEqual(err, expectedErr) {
+			t.Errorf("jsonpath<%s>: expected<%v, %v> != actual<%v, %v>\n",
+				jsonPath, expected, expectedErr, actual, err)
+		}
 	}
-	actualObject2, err := Retrieve(jsonpath2, src2)
-	if err != nil {
-		t.Errorf("expected error<nil> != actual error<%s>\n", err)
-		return
+	expectedStats := QueryCacheStats{Hits: 1, Misses: 5, Evictions: 3, Len: 2, Capacity: 2}
+	if stats := cache.Stats(); stats != expectedStats {
+		t.Errorf("expected<%+v> != actual<%+v>\n", expectedStats, stats)
 	}
 
-	actualOutputJSON1, err := json.Marshal(actualObject1)
-	if err != nil {
-		t.Errorf("%w", err)
-		return
+	query1, _ := cache.ParseQuery(`$.a`)
+	query2, _ := cache.ParseQuery(`$.a`)
+	if query1 != query2 {
+		t.Errorf("the cached query is not returned\n")
 	}
-	actualOutputJSON2, err := json.Marshal(actualObject2)
-	if err != nil {
-		t.Errorf("%w", err)
-		return
+
+	pathConfig := Config{}
+	pathConfig.SetPathMode()
+	if query3, _ := cache.ParseQuery(`$.a`, pathConfig); query3 == query1 {
+		t.Errorf("the query of another config is returned\n")
+	}
+	config1, config2 := Config{}, Config{}
+	config1.SetFilterFunction(`f`, func(value interface{}) (interface{}, error) { return 1, nil })
+	config2.SetFilterFunction(`f`, func(value interface{}) (interface{}, error) { return 2, nil })
+	if actual, _ := cache.Retrieve(`$.a.f()`, src, config1); !reflect.DeepEqual(actual, []interface{}{1}) {
+		t.Errorf("expected<[1]> != actual<%v>\n", actual)
+	}
+	if actual, _ := cache.Retrieve(`$.a.f()`, src, config2); !reflect.DeepEqual(actual, []interface{}{2}) {
+		t.Errorf("expected<[2]> != actual<%v>\n", actual)
 	}
 
-	if string(actualOutputJSON1) != string(expectedOutput1) || string(actualOutputJSON2) != string(expectedOutput2) {
-		t.Errorf("actualOutputJSON1<%s> != expectedOutput1<%s> || actualOutputJSON2<%s> != expectedOutput2<%s>\n",
-			string(actualOutputJSON1), string(expectedOutput1), string(actualOutputJSON2), string(expectedOutput2))
-		return
+	expectedErr := ErrorInvalidSyntax{position: 1, reason: `unrecognized input`, near: `[`}
+	for i := 0; i < 2; i++ {
+		if _, err := cache.ParseQuery(`$[`); err != expectedErr {
+			t.Errorf("expected error<%s> != actual error<%s>\n", expectedErr, err)
+		}
+	}
+
+	cache.Purge()
+	expectedStats = QueryCacheStats{Hits: 3, Misses: 10, Evictions: 6, Len: 0, Capacity: 2}
+	if stats := cache.Stats(); stats != expectedStats {
+		t.Errorf("expected<%+v> != actual<%+v>\n", expectedStats, stats)
+	}
+
+	noCache := NewQueryCache(0)
+	noCache.ParseQuery(`$.a`)
+	noCache.ParseQuery(`$.a`)
+	expectedStats = QueryCacheStats{Misses: 2}
+	if stats := noCache.Stats(); stats != expectedStats {
+		t.Errorf("expected<%+v> != actual<%+v>\n", expectedStats, stats)
 	}
 }
 
-func TestParserFuncExecTwice(t *testing.T) {
-	jsonpath := `$.a`
-	srcJSON1 := `{"a":1}`
-	expectedOutput1 := "[1]"
-	srcJSON2 := `{"a":2}`
-	expectedOutput2 := "[2]"
+func TestQueryCache_concurrent(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[1,2,3]}`), &src)
+
+	cache := NewQueryCache(4)
+	jsonPaths := []string{`$.a[0]`, `$.a[1]`, `$.a[2]`, `$.a[*]`, `$.a[0:2]`, `$.a[-1]`}
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				jsonPath := jsonPaths[j%len(jsonPaths)]
+				expected, _ := Retrieve(jsonPath, src)
+				if actual, err := cache.Retrieve(jsonPath, src); !reflect.DeepEqual(actual, expected) || err != nil {
+					t.Errorf("jsonpath<%s>: expected<%v> != actual<%v, %v>\n", jsonPath, expected, actual, err)
+				}
+			}
+		}()
+	}
+	waitGroup.Wait()
 
-	var src1 interface{}
-	if err := json.Unmarshal([]byte(srcJSON1), &src1); err != nil {
-		t.Errorf("%w", err)
-		return
+	if stats := cache.Stats(); stats.Hits+stats.Misses != 800 || stats.Len != 4 {
+		t.Errorf("unexpected stats<%+v>\n", stats)
 	}
-	var src2 interface{}
-	if err := json.Unmarshal([]byte(srcJSON2), &src2); err != nil {
-		t.Errorf("%w", err)
-		return
+}
+
+func TestExecutionLimits(t *testing.T) {
+	srcJSON := `{"a":[{"b":[{"c":1},{"c":2}]},{"b":[{"c":3}]}],"d":{"e":{"f":{"g":4}}}}`
+	var src interface{}
+	json.Unmarshal([]byte(srcJSON), &src)
+
+	testCases := []struct {
+		jsonpath    string
+		setLimit    func(*Config)
+		expected    []interface{}
+		expectedErr string
+	}{
+		{
+			jsonpath: `$.a[*].b[*].c`,
+			setLimit: func(config *Config) { config.SetMaxVisitedNodes(11) },
+			expected: []interface{}{1., 2., 3.},
+		},
+		{
+			jsonpath:    `$.a[*].b[*].c`,
+			setLimit:    func(config *Config) { config.SetMaxVisitedNodes(10) },
+			expectedErr: `visited nodes exceeded (limit=10)`,
+		},
+		{
+			jsonpath:    `$..*`,
+			setLimit:    func(config *Config) { config.SetMaxVisitedNodes(5) },
+			expectedErr: `visited nodes exceeded (limit=5)`,
+		},
+		{
+			jsonpath:    `$.a[?(@.b[?(@.c>1)])]`,
+			setLimit:    func(config *Config) { config.SetMaxVisitedNodes(5) },
+			expectedErr: `visited nodes exceeded (limit=5)`,
+		},
+		{
+			jsonpath: `$..c`,
+			setLimit: func(config *Config) { config.SetMaxResults(3) },
+			expected: []interface{}{1., 2., 3.},
+		},
+		{
+			jsonpath:    `$..c`,
+			setLimit:    func(config *Config) { config.SetMaxResults(2) },
+			expectedErr: `results exceeded (limit=2)`,
+		},
+		{
+			jsonpath: `$.a[?(@.b[?(@.c>1)])].b[0].c`,
+			setLimit: func(config *Config) { config.SetMaxResults(2) },
+			expected: []interface{}{1., 3.},
+		},
+		{
+			jsonpath: `$.d..g`,
+			setLimit: func(config *Config) { config.SetMaxRecursiveDepth(2) },
+			expected: []interface{}{4.},
+		},
+		{
+			jsonpath:    `$..g`,
+			setLimit:    func(config *Config) { config.SetMaxRecursiveDepth(2) },
+			expectedErr: `recursive depth exceeded (limit=2, path=..)`,
+		},
+		{
+			jsonpath: `$.a[?(@.b[?(@.c>1)])].b[0].c`,
+			setLimit: func(config *Config) { config.SetMaxFilterNesting(2) },
+			expected: []interface{}{1., 3.},
+		},
+		{
+			jsonpath: `$.a[?(@.b)].b[?(@.c>1)].c`,
+			setLimit: func(config *Config) { config.SetMaxFilterNesting(1) },
+			expected: []interface{}{2., 3.},
+		},
+		{
+			jsonpath:    `$.a[?(@.b[?(@.c>1)])].b[0].c`,
+			setLimit:    func(config *Config) { config.SetMaxFilterNesting(1) },
+			expectedErr: `filter nesting exceeded (limit=1, path=[?(@.c>1)])`,
+		},
+		{
+			jsonpath:    `$.x`,
+			setLimit:    func(config *Config) { config.SetMaxResults(1) },
+			expectedErr: `member did not exist (path=.x)`,
+		},
+		{
+			jsonpath: `$.a[($.d.e.f.g-3)].b[0].c`,
+			setLimit: func(config *Config) { config.SetMaxVisitedNodes(9) },
+			expected: []interface{}{3.},
+		},
+		{
+			jsonpath:    `$.a[($.d.e.f.g-3)].b[0].c`,
+			setLimit:    func(config *Config) { config.SetMaxVisitedNodes(8) },
+			expectedErr: `visited nodes exceeded (limit=8)`,
+		},
 	}
 
-	parserFunc, err := Parse(jsonpath)
-	if err != nil {
-		t.Errorf("expected error<nil> != actual error<%s>\n", err)
-		return
+	for _, testCase := range testCases {
+		config := Config{}
+		testCase.setLimit(&config)
+		actual, err := Retrieve(testCase.jsonpath, src, config)
+		if testCase.expectedErr != `` {
+			if err == nil || err.Error() != testCase.expectedErr {
+				t.Errorf("jsonpath<%s>: expected error<%s> != actual<%v, %v>\n",
+					testCase.jsonpath, testCase.expectedErr, actual, err)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(actual, testCase.expected) || err != nil {
+			t.Errorf("jsonpath<%s>: expected<%v> != actual<%v, %v>\n", testCase.jsonpath, testCase.expected, actual, err)
+		}
 	}
 
-	actualObject1, err := parserFunc(src1)
-	if err != nil {
-		t.Errorf("expected error<nil> != actual error<%s>\n", err)
-		return
+	strictConfig := Config{}
+	strictConfig.SetStrictMode()
+	strictConfig.SetMaxResults(1)
+	if _, err := Retrieve(`$..c`, src, strictConfig); reflect.TypeOf(err) != reflect.TypeOf(ErrorResultsExceeded{}) {
+		t.Errorf("expected error<ErrorResultsExceeded> != actual error<%v>\n", err)
 	}
-	actualObject2, err := parserFunc(src2)
-	if err != nil {
-		t.Errorf("expected error<nil> != actual error<%s>\n", err)
-		return
+
+	config := Config{}
+	config.SetMaxResults(1)
+	if _, count, err := Set(`$..c`, src, 0, config); count != 0 || err != (ErrorResultsExceeded{limit: 1}) {
+		t.Errorf("expected error<ErrorResultsExceeded> != actual<%d, %v>\n", count, err)
+	}
+	if actual, _ := Retrieve(`$..c`, src); !reflect.DeepEqual(actual, []interface{}{1., 2., 3.}) {
+		t.Errorf("the source is updated<%v>\n", actual)
 	}
 
-	actualOutputJSON1, err := json.Marshal(actualObject1)
-	if err != nil {
-		t.Errorf("%w", err)
-		return
+	expectedErrs := []error{
+		ErrorNotSupported{feature: `execution limits with reader`, path: `$.a`},
+		ErrorNotSupported{feature: `execution limits with bytes`, path: `$.a`},
+		ErrorNotSupported{feature: `execution limits with query set`, path: `$.a`},
 	}
-	actualOutputJSON2, err := json.Marshal(actualObject2)
-	if err != nil {
-		t.Errorf("%w", err)
-		return
+	_, err1 := ParseReader(`$.a`, config)
+	_, err2 := ParseBytes(`$.a`, config)
+	_, err3 := NewQuerySet([]string{`$.a`}, config)
+	if actualErrs := []error{err1, err2, err3}; !reflect.DeepEqual(actualErrs, expectedErrs) {
+		t.Errorf("expected errors<%v> != actual errors<%v>\n", expectedErrs, actualErrs)
 	}
+}
 
-	if string(actualOutputJSON1) != string(expectedOutput1) || string(actualOutputJSON2) != string(expectedOutput2) {
-		t.Errorf("actualOutputJSON1<%s> != expectedOutput1<%s> || actualOutputJSON2<%s> != expectedOutput2<%s>\n",
-			string(actualOutputJSON1), string(expectedOutput1), string(actualOutputJSON2), string(expectedOutput2))
-		return
+func TestQuery_ExecuteContext(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[1,2,3]}`), &src)
+
+	query, _ := ParseQuery(`$.a[*]`)
+	actual, err := query.ExecuteContext(context.Background(), src)
+	if !reflect.DeepEqual(actual, []interface{}{1., 2., 3.}) || err != nil {
+		t.Errorf("expected<[1 2 3]> != actual<%v, %v>\n", actual, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, jsonPath := range []string{`$`, `$.a[*]`} {
+		query, _ := ParseQuery(jsonPath)
+		if actual, err := query.ExecuteContext(ctx, src); actual != nil || err != context.Canceled {
+			t.Errorf("jsonpath<%s>: expected error<%v> != actual<%v, %v>\n", jsonPath, context.Canceled, actual, err)
+		}
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	config := Config{}
+	config.SetFilterFunction(`cancel`, func(value interface{}) (interface{}, error) {
+		cancel()
+		return value, nil
+	})
+	query, _ = ParseQuery(`$.a[?(@.cancel()>1)]`, config)
+	if actual, err := query.ExecuteContext(ctx, src); actual != nil || err != context.Canceled {
+		t.Errorf("expected error<%v> != actual<%v, %v>\n", context.Canceled, actual, err)
+	}
+}
+
+func TestQuery_Cost(t *testing.T) {
+	config := Config{}
+	config.SetAggregateFunction(`max`, func(values []interface{}) (interface{}, error) {
+		return values[0], nil
+	})
+	strictConfig := Config{}
+	strictConfig.SetStrictMode()
+
+	testCases := []struct {
+		jsonpath string
+		config   Config
+		expected QueryCost
+	}{
+		{jsonpath: `$`, expected: QueryCost{Class: CostDefinite}},
+		{jsonpath: `$.a[0]['b']`, expected: QueryCost{Class: CostDefinite}},
+		{jsonpath: `$[(@.length-1)]`, expected: QueryCost{Class: CostDefinite}},
+		{jsonpath: `$.a[*].b`, expected: QueryCost{Class: CostLinear}},
+		{jsonpath: `$['a','b'][0:2]`, expected: QueryCost{Class: CostLinear}},
+		{jsonpath: `$.a[*].max()`, config: config, expected: QueryCost{Class: CostLinear}},
+		{
+			jsonpath: `$.a[?(@.b=~/x/ && @.c[?(@.d==$.e || @.f==$.g)])]`,
+			expected: QueryCost{Class: CostLinear, Filters: 2, MaxFilterNesting: 2, MaxRootQueriesPerFilter: 2, Regexes: 1},
+		},
+		{
+			jsonpath: `$[?match(@.a, 'x') && search(@.b, 'y') && $.c == @.d]`,
+			config:   strictConfig,
+			expected: QueryCost{Class: CostLinear, Filters: 1, MaxFilterNesting: 1, MaxRootQueriesPerFilter: 1, Regexes: 2},
+		},
+		{jsonpath: `$..a`, expected: QueryCost{Class: CostRecursive, RecursiveDescents: 1}},
+		{jsonpath: `$..a.max()`, config: config, expected: QueryCost{Class: CostRecursive, RecursiveDescents: 1}},
+		{
+			jsonpath: `$[?(@..a)]`,
+			expected: QueryCost{Class: CostRecursive, RecursiveDescents: 1, RecursiveDescentsInFilters: 1,
+				Filters: 1, MaxFilterNesting: 1},
+		},
+		{
+			jsonpath: `$.a[?($..b)]`,
+			expected: QueryCost{Class: CostRecursive, RecursiveDescents: 1, RecursiveDescentsInFilters: 1,
+				Filters: 1, MaxFilterNesting: 1, MaxRootQueriesPerFilter: 1},
+		},
+		{jsonpath: `$..a..b`, expected: QueryCost{Class: CostSuperlinear, RecursiveDescents: 2}},
+		{
+			jsonpath: `$..[?(@..b)]`,
+			expected: QueryCost{Class: CostSuperlinear, RecursiveDescents: 2, RecursiveDescentsInFilters: 1,
+				Filters: 1, MaxFilterNesting: 1},
+		},
+		{
+			jsonpath: `$..['a',?($..b)]`,
+			config:   strictConfig,
+			expected: QueryCost{Class: CostSuperlinear, RecursiveDescents: 2, RecursiveDescentsInFilters: 1,
+				Filters: 1, MaxFilterNesting: 1, MaxRootQueriesPerFilter: 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		query, err := ParseQuery(testCase.jsonpath, testCase.config)
+		if err != nil {
+			t.Errorf("jsonpath<%s>: %s\n", testCase.jsonpath, err)
+			continue
+		}
+		if actual := query.Cost(); actual != testCase.expected {
+			t.Errorf("jsonpath<%s>: expected<%+v> != actual<%+v>\n", testCase.jsonpath, testCase.expected, actual)
+		}
+	}
+
+	if CostSuperlinear.String() != `Superlinear` || CostClass(-1).String() != `Unknown` {
+		t.Errorf("unexpected names<%s, %s>\n", CostSuperlinear, CostClass(-1))
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	errFunction := errors.New(`function error`)
+	config := Config{}
+	config.SetFilterFunction(`fail`, func(param interface{}) (interface{}, error) {
+		return nil, errFunction
+	})
+
+	_, err := Retrieve(`$.`, map[string]interface{}{})
+	var invalidSyntax ErrorInvalidSyntax
+	if !errors.As(err, &invalidSyntax) || !errors.Is(err, ErrInvalidSyntax) {
+		t.Fatalf(`expected ErrorInvalidSyntax, got %v`, err)
+	}
+	if invalidSyntax.Position() != 1 || invalidSyntax.Reason() != `unrecognized input` || invalidSyntax.Near() != `.` {
+		t.Errorf(`unexpected details: %d, %s, %s`, invalidSyntax.Position(), invalidSyntax.Reason(), invalidSyntax.Near())
+	}
+
+	_, err = Retrieve(`$[?(1.0.0>0)]`, map[string]interface{}{})
+	var invalidArgument ErrorInvalidArgument
+	var numError *strconv.NumError
+	if !errors.As(err, &invalidArgument) || !errors.Is(err, ErrInvalidArgument) || !errors.As(err, &numError) {
+		t.Fatalf(`expected ErrorInvalidArgument wrapping strconv.NumError, got %v`, err)
+	}
+	if invalidArgument.Argument() != `1.0.0` {
+		t.Errorf(`unexpected argument: %s`, invalidArgument.Argument())
+	}
+
+	_, err = Retrieve(`$.unknown()`, map[string]interface{}{})
+	if !errors.Is(err, ErrFunctionNotFound) || err.(ErrorFunctionNotFound).Function() != `.unknown()` {
+		t.Errorf(`unexpected error: %v`, err)
+	}
+
+	_, err = Retrieve(`$[(command)]`, map[string]interface{}{})
+	if !errors.Is(err, ErrNotSupported) ||
+		err.(ErrorNotSupported).Feature() != `script` || err.(ErrorNotSupported).Path() != `[(command)]` {
+		t.Errorf(`unexpected error: %v`, err)
+	}
+
+	_, err = Retrieve(`$.a.none`, map[string]interface{}{`a`: map[string]interface{}{}})
+	if !errors.Is(err, ErrMemberNotExist) || errors.Is(err, ErrTypeUnmatched) || err.(ErrorMemberNotExist).Path() != `.none` {
+		t.Errorf(`unexpected error: %v`, err)
+	}
+
+	_, err = Retrieve(`$.a`, []interface{}{})
+	var typeUnmatched ErrorTypeUnmatched
+	if !errors.As(err, &typeUnmatched) || !errors.Is(err, ErrTypeUnmatched) {
+		t.Fatalf(`expected ErrorTypeUnmatched, got %v`, err)
+	}
+	if typeUnmatched.ExpectedType() != `object` || typeUnmatched.FoundType() != `[]interface {}` || typeUnmatched.Path() != `.a` {
+		t.Errorf(`unexpected details: %s, %s, %s`,
+			typeUnmatched.ExpectedType(), typeUnmatched.FoundType(), typeUnmatched.Path())
+	}
+
+	_, err = Retrieve(`$.fail()`, map[string]interface{}{}, config)
+	var functionFailed ErrorFunctionFailed
+	if !errors.As(err, &functionFailed) || !errors.Is(err, ErrFunctionFailed) || !errors.Is(err, errFunction) {
+		t.Fatalf(`expected ErrorFunctionFailed wrapping the function error, got %v`, err)
+	}
+	if functionFailed.Function() != `.fail()` || errors.Unwrap(err) != errFunction {
+		t.Errorf(`unexpected details: %s, %v`, functionFailed.Function(), errors.Unwrap(err))
+	}
+
+	limitConfig := Config{}
+	limitConfig.SetMaxResults(1)
+	_, err = Retrieve(`$[*]`, []interface{}{1, 2}, limitConfig)
+	if !errors.Is(err, ErrResultsExceeded) || err.(ErrorResultsExceeded).Limit() != 1 {
+		t.Errorf(`unexpected error: %v`, err)
+	}
+
+	_, _, err = Set(`$[100000]`, []interface{}{}, 1)
+	if !errors.Is(err, ErrUpsertIndexExceeded) ||
+		err.(ErrorUpsertIndexExceeded).Limit() != 65536 || err.(ErrorUpsertIndexExceeded).Path() != `[100000]` {
+		t.Errorf(`unexpected error: %v`, err)
 	}
 }
 
-type UnsupportedStruct struct {
-	A string
-	B int
+func TestErrorNormalizedPath(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[{"b":{"c":1}},{"b":[1]},{"x":1},{"b":"s"}],"d":{"e":[{"f":1},{"g":{"h":2}}]}}`), &src)
+
+	testCases := []struct {
+		jsonpath     string
+		expectedPath string
+	}{
+		{jsonpath: `$.x`, expectedPath: `$['x']`},
+		{jsonpath: `$.a[1].b.c`, expectedPath: `$['a'][1]['b']`},
+		{jsonpath: `$.a[2].b`, expectedPath: `$['a'][2]['b']`},
+		{jsonpath: `$.a[3:].b.c`, expectedPath: `$['a'][3]['b']`},
+		{jsonpath: `$.a[1,3].b.c`, expectedPath: `$['a'][3]['b']`},
+		{jsonpath: `$.a[?(@.x)].y`, expectedPath: `$['a'][2]['y']`},
+		{jsonpath: `$.a[9].b`, expectedPath: `$['a'][9]`},
+		{jsonpath: `$.a[-9].b`, expectedPath: `$['a']`},
+		{jsonpath: `$.a[(4+5)].b`, expectedPath: `$['a'][9]`},
+		{jsonpath: `$.a[0][('x')]`, expectedPath: `$['a'][0]['x']`},
+		{jsonpath: `$..g.h.i`, expectedPath: `$['d']['e'][1]['g']['h']`},
+		{jsonpath: `$.d..g.h.i`, expectedPath: `$['d']['e'][1]['g']['h']`},
+		{jsonpath: `$.d..x`, expectedPath: `$['d']['x']`},
+	}
+
+	pathConfig := Config{}
+	pathConfig.SetPathMode()
+	accessorConfig := Config{}
+	accessorConfig.SetAccessorMode()
+
+	for _, testCase := range testCases {
+		for _, config := range []Config{{}, pathConfig, accessorConfig} {
+			_, err := Retrieve(testCase.jsonpath, src, config)
+			var actualPath string
+			switch typedErr := err.(type) {
+			case ErrorMemberNotExist:
+				actualPath = typedErr.NormalizedPath()
+			case ErrorTypeUnmatched:
+				actualPath = typedErr.NormalizedPath()
+			default:
+				t.Errorf("jsonpath<%s>: unexpected error<%v>\n", testCase.jsonpath, err)
+				continue
+			}
+			if actualPath != testCase.expectedPath {
+				t.Errorf("jsonpath<%s>: expected path<%s> != actual path<%s>\n",
+					testCase.jsonpath, testCase.expectedPath, actualPath)
+			}
+		}
+	}
+
+	var recursiveSrc interface{}
+	json.Unmarshal([]byte(`{"a":{"b":{"d":1}}}`), &recursiveSrc)
+	query, _ := ParseQuery(`$..b.c`, Config{})
+	_, err := query.Execute(recursiveSrc)
+	_, failures := query.ExecuteLenient(recursiveSrc)
+	if len(failures) != 1 || err.(ErrorMemberNotExist).NormalizedPath() != `$['a']['b']['c']` ||
+		failures[0].(ErrorMemberNotExist).NormalizedPath() != `$['a']['b']['c']` {
+		t.Errorf("unexpected paths of the errors of the recursive descent<%v, %v>\n", err, failures)
+	}
+
+	querySet, _ := NewQuerySet([]string{`$.a[1].b.c`, `$.a[1].x`})
+	if _, errs := querySet.Retrieve(src); errs[0].(ErrorTypeUnmatched).NormalizedPath() != `$['a'][1]['b']` ||
+		errs[1].(ErrorMemberNotExist).NormalizedPath() != `$['a'][1]['x']` {
+		t.Errorf("unexpected paths of the errors of QuerySet<%v>\n", errs)
+	}
 }
 
-func TestRetrieve_unsupportedStruct(t *testing.T) {
-	inputJSON := UnsupportedStruct{A: `test`, B: 123}
-	jsonpath := `$.A`
-	expectedError := createErrorTypeUnmatched(`.A`, `object/array`, `jsonpath.UnsupportedStruct`)
-	_, err := Retrieve(jsonpath, inputJSON)
+func TestQuery_ExecuteLenient(t *testing.T) {
+	var src interface{}
+	json.Unmarshal([]byte(`{"a":[{"b":{"c":1}},{"b":[1]},{"x":1},{"b":"s"}],"d":{"e":[{"f":1},{"g":{"h":2}}]}}`), &src)
 
-	if reflect.TypeOf(expectedError) != reflect.TypeOf(err) {
-		t.Errorf("expected error<%s> != actual error<%s>\n",
-			expectedError, err)
+	config := Config{}
+	config.SetFilterFunction(`upper`, func(param interface{}) (interface{}, error) {
+		if text, ok := param.(string); ok {
+			return strings.ToUpper(text), nil
+		}
+		return nil, fmt.Errorf(`not string`)
+	})
+
+	testCases := []struct {
+		jsonpath         string
+		expected         []interface{}
+		expectedFailures []string
+	}{
+		{
+			jsonpath: `$.a[*].b.c`,
+			expected: []interface{}{1.},
+			expectedFailures: []string{
+				`$['a'][1]['b'] type unmatched (expected=object, found=[]interface {}, path=.c)`,
+				`$['a'][2]['b'] member did not exist (path=.b)`,
+				`$['a'][3]['b'] type unmatched (expected=object, found=string, path=.c)`,
+			},
+		},
+		{
+			jsonpath: `$.a[*].b.upper()`,
+			expected: []interface{}{`S`},
+			expectedFailures: []string{
+				`$['a'][0]['b'] function failed (function=.upper(), error=not string)`,
+				`$['a'][1]['b'] function failed (function=.upper(), error=not string)`,
+				`$['a'][2]['b'] member did not exist (path=.b)`,
+			},
+		},
+		{
+			jsonpath:         `$.a[1].b.c`,
+			expected:         []interface{}{},
+			expectedFailures: []string{`$['a'][1]['b'] type unmatched (expected=object, found=[]interface {}, path=.c)`},
+		},
+		{
+			jsonpath:         `$.d.e[*].f`,
+			expected:         []interface{}{1.},
+			expectedFailures: []string{`$['d']['e'][1]['f'] member did not exist (path=.f)`},
+		},
+		{
+			jsonpath:         `$.a[?(@.x)].y`,
+			expected:         []interface{}{},
+			expectedFailures: []string{`$['a'][2]['y'] member did not exist (path=.y)`},
+		},
+		{jsonpath: `$..h`, expected: []interface{}{2.}},
+		{
+			jsonpath:         `$..g.h.i`,
+			expected:         []interface{}{},
+			expectedFailures: []string{`$['d']['e'][1]['g']['h'] type unmatched (expected=object, found=float64, path=.i)`},
+		},
+		{
+			jsonpath:         `$..none`,
+			expected:         []interface{}{},
+			expectedFailures: []string{`$['none'] member did not exist (path=none)`},
+		},
+		{
+			jsonpath:         `$.a[0,1,5].b`,
+			expected:         []interface{}{map[string]interface{}{`c`: 1.}, []interface{}{1.}},
+			expectedFailures: []string{`$['a'][5] member did not exist (path=[0,1,5])`},
+		},
+		{
+			jsonpath: `$.a[5,-9,0:0].b`,
+			expected: []interface{}{},
+			expectedFailures: []string{
+				`$['a'][5] member did not exist (path=[5,-9,0:0])`,
+				`$['a'] member did not exist (path=[5,-9,0:0])`,
+			},
+		},
+		{
+			jsonpath:         `$.a[5].b`,
+			expected:         []interface{}{},
+			expectedFailures: []string{`$['a'][5] member did not exist (path=[5])`},
+		},
+		{
+			jsonpath:         `$.d['e','z'][0].f`,
+			expected:         []interface{}{1.},
+			expectedFailures: []string{`$['d']['z'] member did not exist (path=z)`},
+		},
+		{
+			jsonpath: `$.a[0:3]['b','x']`,
+			expected: []interface{}{map[string]interface{}{`c`: 1.}, []interface{}{1.}, 1.},
+			expectedFailures: []string{
+				`$['a'][0]['x'] member did not exist (path=x)`,
+				`$['a'][1]['x'] member did not exist (path=x)`,
+				`$['a'][2]['b'] member did not exist (path=b)`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		query, err := ParseQuery(testCase.jsonpath, config)
+		if err != nil {
+			t.Errorf("jsonpath<%s>: %s\n", testCase.jsonpath, err)
+			continue
+		}
+		actual, failures := query.ExecuteLenient(src)
+		var actualFailures []string
+		for _, failure := range failures {
+			var path string
+			switch typedFailure := failure.(type) {
+			case ErrorMemberNotExist:
+				path = typedFailure.NormalizedPath()
+			case ErrorTypeUnmatched:
+				path = typedFailure.NormalizedPath()
+			case ErrorFunctionFailed:
+				path = typedFailure.NormalizedPath()
+			}
+			actualFailures = append(actualFailures, path+` `+failure.Error())
+		}
+		if !reflect.DeepEqual(actual, testCase.expected) ||
+			!reflect.DeepEqual(actualFailures, testCase.expectedFailures) {
+			t.Errorf("jsonpath<%s>: expected<%v, %q> != actual<%v, %q>\n",
+				testCase.jsonpath, testCase.expected, testCase.expectedFailures, actual, actualFailures)
+		}
+	}
+
+	pathConfig := Config{}
+	pathConfig.SetPathMode()
+	query, _ := ParseQuery(`$.a[0:2].b.c`, pathConfig)
+	actual, failures := query.ExecuteLenient(src)
+	if expected := []interface{}{PathValue{Path: `$['a'][0]['b']['c']`, Value: 1.}}; !reflect.DeepEqual(actual, expected) ||
+		len(failures) != 1 {
+		t.Errorf("expected<%v> != actual<%v, %v>\n", expected, actual, failures)
+	}
+
+	err := RetrieveReader(`$.a[*].b.upper()`, strings.NewReader(`{"a":[{"b":"s"},{"b":[1]}]}`),
+		func(interface{}) error { return nil }, config)
+	if functionErr, ok := err.(ErrorFunctionFailed); !ok || functionErr.NormalizedPath() != `$['a'][1]['b']` {
+		t.Errorf("unexpected error<%v> of the reader\n", err)
+	}
+
+	limitConfig := Config{}
+	limitConfig.SetMaxResults(1)
+	query, _ = ParseQuery(`$.a[*]`, limitConfig)
+	if actual, failures := query.ExecuteLenient(src); actual != nil ||
+		!reflect.DeepEqual(failures, []error{ErrorResultsExceeded{limit: 1}}) {
+		t.Errorf("unexpected result<%v, %v> with the limit\n", actual, failures)
 	}
 }
 